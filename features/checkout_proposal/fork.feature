@@ -0,0 +1,51 @@
+Feature: check out the branch of a proposal from a fork
+
+  Background:
+    Given a Git repo with origin
+    And an upstream repo
+    And the branches
+      | NAME    | TYPE   | PARENT | LOCATIONS |
+      | feature | (none) | main   | upstream  |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE         | AUTHOR                          |
+      | feature | upstream | coworker commit | coworker <coworker@example.com> |
+    And the current branch is "main"
+    And Git Town setting "github-token" is "token"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the proposals
+      | FROM    | TO   | FORK  |
+      | feature | main | alice |
+    When I run "git-town checkout-proposal 1" and enter into the dialog:
+      | DIALOG      | KEYS       |
+      | branch type | down enter |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                |
+      | main   | git fetch --prune --tags               |
+      | <none> | loading proposal 1 online ... ok       |
+      | main   | git remote add alice ../upstream       |
+      |        | git fetch alice feature                |
+      |        | git branch alice/feature alice/feature |
+      |        | git checkout alice/feature             |
+    And it prints:
+      """
+      Branch type: observed
+      """
+    And the current branch is now "alice/feature"
+    And branch "alice/feature" is now observed
+    And this lineage exists now
+      | BRANCH        | PARENT |
+      | alice/feature | main   |
+    And the remotes are now "alice, origin, upstream"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH        | COMMAND                     |
+      | alice/feature | git checkout main           |
+      | main          | git branch -D alice/feature |
+      |               | git remote remove alice     |
+    And the current branch is now "main"
+    And there are now no observed branches
+    And the remotes are now "origin, upstream"
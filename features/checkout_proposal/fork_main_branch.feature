@@ -0,0 +1,44 @@
+Feature: check out a proposal from the main branch of a fork
+
+  Background:
+    Given a Git repo with origin
+    And an upstream repo
+    And the commits
+      | BRANCH | LOCATION | MESSAGE         | AUTHOR                          |
+      | main   | upstream | coworker commit | coworker <coworker@example.com> |
+    And the current branch is "main"
+    And Git Town setting "github-token" is "token"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the proposals
+      | FROM | TO   | FORK  |
+      | main | main | alice |
+    When I run "git-town checkout-proposal 1" and enter into the dialog:
+      | DIALOG      | KEYS       |
+      | branch type | down enter |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                          |
+      | main   | git fetch --prune --tags         |
+      | <none> | loading proposal 1 online ... ok |
+      | main   | git remote add alice ../upstream |
+      |        | git fetch alice main             |
+      |        | git branch alice/main alice/main |
+      |        | git checkout alice/main          |
+    And the current branch is now "alice/main"
+    And branch "alice/main" is now observed
+    And this lineage exists now
+      | BRANCH     | PARENT |
+      | alice/main | main   |
+    And the remotes are now "alice, origin, upstream"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH     | COMMAND                  |
+      | alice/main | git checkout main        |
+      | main       | git branch -D alice/main |
+      |            | git remote remove alice  |
+    And the current branch is now "main"
+    And there are now no observed branches
+    And the remotes are now "origin, upstream"
@@ -0,0 +1,86 @@
+Feature: check out the branch of a proposal on GitHub
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE   | PARENT | LOCATIONS |
+      | feature | (none) | main   | origin    |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE         | AUTHOR                          |
+      | feature | origin   | coworker commit | coworker <coworker@example.com> |
+    And the current branch is "main"
+    And Git Town setting "github-token" is "token"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the proposals
+      | FROM    | TO   |
+      | feature | main |
+
+  Scenario: contribute to the branch
+    When I run "git-town checkout-proposal 1" and enter into the dialog:
+      | DIALOG      | KEYS  |
+      | branch type | enter |
+    Then it runs the commands
+      | BRANCH | COMMAND                           |
+      | main   | git fetch --prune --tags          |
+      | <none> | loading proposal 1 online ... ok  |
+      | main   | git branch feature origin/feature |
+      |        | git checkout feature              |
+    And it prints:
+      """
+      Branch type: contribution
+      """
+    And the current branch is now "feature"
+    And branch "feature" is now a contribution branch
+    And this lineage exists now
+      | BRANCH  | PARENT |
+      | feature | main   |
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE         |
+      | feature | local, origin | coworker commit |
+
+  Scenario: observe the branch
+    When I run "git-town checkout-proposal 1" and enter into the dialog:
+      | DIALOG      | KEYS       |
+      | branch type | down enter |
+    Then it prints:
+      """
+      Branch type: observed
+      """
+    And the current branch is now "feature"
+    And branch "feature" is now observed
+    And this lineage exists now
+      | BRANCH  | PARENT |
+      | feature | main   |
+
+  Scenario: unknown proposal
+    When I run "git-town checkout-proposal 2"
+    Then it runs the commands
+      | BRANCH | COMMAND                          |
+      | main   | git fetch --prune --tags         |
+      | <none> | loading proposal 2 online ... ok |
+    And it prints the error:
+      """
+      there is no proposal 2
+      """
+    And the current branch is still "main"
+
+  Scenario: invalid proposal number
+    When I run "git-town checkout-proposal one"
+    Then it runs no commands
+    And it prints the error:
+      """
+      invalid proposal number: "one"
+      """
+
+  Scenario: undo
+    Given I ran "git-town checkout-proposal 1" and enter into the dialog:
+      | DIALOG      | KEYS  |
+      | branch type | enter |
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND               |
+      | feature | git checkout main     |
+      | main    | git branch -D feature |
+    And the current branch is now "main"
+    And there are now no contribution branches
+    And the initial branches and lineage exist
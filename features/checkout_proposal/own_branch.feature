@@ -0,0 +1,34 @@
+Feature: check out the branch of my own proposal
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE   | PARENT | LOCATIONS |
+      | parent  | (none) | main   | origin    |
+      | feature | (none) | main   | origin    |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE        |
+      | parent  | origin   | parent commit  |
+      | feature | origin   | feature commit |
+    And the current branch is "main"
+    And Git Town setting "github-token" is "token"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the proposals
+      | FROM    | TO     |
+      | parent  | main   |
+      | feature | parent |
+    When I run "git-town checkout-proposal 2"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                           |
+      | main   | git fetch --prune --tags          |
+      | <none> | loading proposal 2 online ... ok  |
+      | main   | git branch feature origin/feature |
+      |        | git checkout feature              |
+    And it does not print "Branch type"
+    And the current branch is now "feature"
+    And branch "feature" is now a feature branch
+    And this lineage exists now
+      | BRANCH  | PARENT |
+      | feature | parent |
@@ -0,0 +1,47 @@
+Feature: check out the branch of a proposal at a separate proposal remote
+
+  Background:
+    Given a Git repo with origin
+    And an upstream repo
+    And the branches
+      | NAME    | TYPE   | PARENT | LOCATIONS |
+      | feature | (none) | main   | upstream  |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE         | AUTHOR                          |
+      | feature | upstream | coworker commit | coworker <coworker@example.com> |
+    And the current branch is "main"
+    And Git Town setting "proposal-remote" is "upstream"
+    And Git Town setting "github-token" is "token"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the proposals
+      | FROM    | TO   |
+      | feature | main |
+    When I run "git-town checkout-proposal 1" and enter into the dialog:
+      | DIALOG      | KEYS  |
+      | branch type | enter |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                             |
+      | main   | git fetch --prune --tags --multiple upstream origin |
+      | <none> | loading proposal 1 online ... ok                    |
+      | main   | git branch feature upstream/feature                 |
+      |        | git checkout feature                                |
+    And it prints:
+      """
+      Branch type: contribution
+      """
+    And the current branch is now "feature"
+    And branch "feature" is now a contribution branch
+    And this lineage exists now
+      | BRANCH  | PARENT |
+      | feature | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND               |
+      | feature | git checkout main     |
+      | main    | git branch -D feature |
+    And the current branch is now "main"
+    And there are now no contribution branches
@@ -0,0 +1,54 @@
+Feature: set the parent branches from the proposals on the hosting platform
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME   | TYPE   | LOCATIONS     |
+      | parent | (none) | local, origin |
+      | child  | (none) | local, origin |
+      | other  | (none) | local, origin |
+    And the current branch is "child"
+    And Git Town setting "github-token" is "token"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the proposals
+      | FROM   | TO     |
+      | parent | main   |
+      | child  | parent |
+
+  Scenario: accept the proposed lineage
+    When I run "git-town config import-lineage" and enter into the dialog:
+      | DIALOG         | KEYS  |
+      | import lineage | enter |
+    Then it runs the commands
+      | BRANCH | COMMAND                            |
+      |        | looking for proposal online ... ok |
+      |        | looking for proposal online ... ok |
+      |        | looking for proposal online ... ok |
+    And it prints:
+      """
+      Import lineage: yes
+      """
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | parent |
+      | parent | main   |
+    And the current branch is still "child"
+
+  Scenario: decline the proposed lineage
+    When I run "git-town config import-lineage" and enter into the dialog:
+      | DIALOG         | KEYS       |
+      | import lineage | down enter |
+    Then it prints:
+      """
+      Import lineage: no
+      """
+    And the initial branches and lineage exist
+
+  Scenario: undo
+    Given I ran "git-town config import-lineage" and enter into the dialog:
+      | DIALOG         | KEYS  |
+      | import lineage | enter |
+    When I run "git-town undo"
+    Then it runs no commands
+    And the current branch is still "child"
+    And the initial branches and lineage exist
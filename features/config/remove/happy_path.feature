@@ -12,6 +12,7 @@ Feature: reset the configuration
     And global Git setting "alias.hack" is "town hack"
     And global Git setting "alias.sync" is "town sync"
     And global Git setting "alias.append" is "commit --amend"
+    And Git Town sync-strategy setting for branch "feature" is "rebase"
     When I run "git-town config remove"
     Then it runs the commands
       | COMMAND                                |
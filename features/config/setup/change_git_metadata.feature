@@ -19,6 +19,7 @@ Feature: change existing information in Git metadata
       | change the perennial branches             | space down space enter |
       | enter a perennial regex                   | 3 3 6 6 enter          |
       | set github as hosting service             | up up enter            |
+      | api token source                          | down enter             |
       | github token                              | 1 2 3 4 5 6 enter      |
       | origin hostname                           | c o d e enter          |
       | sync-feature-strategy                     | down enter             |
@@ -28,7 +29,7 @@ Feature: change existing information in Git metadata
       | enable push-new-branches                  | down enter             |
       | disable the push hook                     | down enter             |
       | create-prototype-branches                 | down enter             |
-      | set ship-strategy to "fast-forward"       | down enter             |
+      | set ship-strategy to "fast-forward"       | down down down enter   |
       | disable ship-delete-tracking-branch       | down enter             |
       | save config to Git metadata               | down enter             |
 
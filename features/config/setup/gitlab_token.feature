@@ -14,6 +14,7 @@ Feature: enter the GitLab API token
       | perennial branches            |                   | no input here since the dialog doesn't show |
       | perennial regex               | enter             |                                             |
       | hosting platform: auto-detect | enter             |                                             |
+      | api token source              | down enter        |                                             |
       | gitlab token                  | 1 2 3 4 5 6 enter |                                             |
       | origin hostname               | enter             |                                             |
       | sync-feature-strategy         | enter             |                                             |
@@ -41,6 +42,7 @@ Feature: enter the GitLab API token
       | perennial branches          |                   | no input here since the dialog doesn't show |
       | perennial regex             | enter             |                                             |
       | hosting platform            | up enter          |                                             |
+      | api token source            | down enter        |                                             |
       | gitlab token                | 1 2 3 4 5 6 enter |                                             |
       | origin hostname             | enter             |                                             |
       | sync-feature-strategy       | enter             |                                             |
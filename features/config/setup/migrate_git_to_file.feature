@@ -93,7 +93,9 @@ Feature: migrate existing configuration in Git metadata to a config file
       #
       # Options:
       #
-      # - api: merge the proposal on your code hosting platform via the code hosting API
+      # - api: squash-merge the proposal on your code hosting platform via the code hosting API
+      # - api-merge: merge the proposal with a merge commit on your code hosting platform via the code hosting API
+      # - api-rebase: rebase-merge the proposal on your code hosting platform via the code hosting API
       # - fast-forward: in your local repo, fast-forward the parent branch to point to the commits on the feature branch
       # - squash-merge: in your local repo, squash-merge the feature branch into its parent branch
       #
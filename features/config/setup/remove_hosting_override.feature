@@ -5,24 +5,24 @@ Feature: remove an existing code hosting override
     Given a Git repo with origin
     And local Git Town setting "hosting-platform" is "github"
     When I run "git-town config setup" and enter into the dialog:
-      | DIALOG                      | KEYS           | DESCRIPTION                                 |
-      | welcome                     | enter          |                                             |
-      | aliases                     | enter          |                                             |
-      | main branch                 | down enter     |                                             |
-      | perennial branches          |                | no input here since the dialog doesn't show |
-      | perennial regex             | enter          |                                             |
-      | hosting platform            | up up up enter |                                             |
-      | origin hostname             | enter          |                                             |
-      | sync-feature-strategy       | enter          |                                             |
-      | sync-perennial-strategy     | enter          |                                             |
-      | sync-upstream               | enter          |                                             |
-      | sync-tags                   | enter          |                                             |
-      | push-new-branches           | enter          |                                             |
-      | push-hook                   | enter          |                                             |
-      | create-prototype-branches   | enter          |                                             |
-      | ship-strategy               | enter          |                                             |
-      | ship-delete-tracking-branch | enter          |                                             |
-      | save config to Git metadata | down enter     |                                             |
+      | DIALOG                      | KEYS                 | DESCRIPTION                                 |
+      | welcome                     | enter                |                                             |
+      | aliases                     | enter                |                                             |
+      | main branch                 | down enter           |                                             |
+      | perennial branches          |                      | no input here since the dialog doesn't show |
+      | perennial regex             | enter                |                                             |
+      | hosting platform            | up up up up up enter |                                             |
+      | origin hostname             | enter                |                                             |
+      | sync-feature-strategy       | enter                |                                             |
+      | sync-perennial-strategy     | enter                |                                             |
+      | sync-upstream               | enter                |                                             |
+      | sync-tags                   | enter                |                                             |
+      | push-new-branches           | enter                |                                             |
+      | push-hook                   | enter                |                                             |
+      | create-prototype-branches   | enter                |                                             |
+      | ship-strategy               | enter                |                                             |
+      | ship-delete-tracking-branch | enter                |                                             |
+      | save config to Git metadata | down enter           |                                             |
 
   Scenario: result
     Then it runs the commands
@@ -34,24 +34,37 @@ Feature: show the configuration
         offline: no
         run pre-push hook: yes
         push new branches: no
+        push remote: origin
+        proposal body: template
+        proposal remote: origin
+        proposal stack section: yes
         ship strategy: squash-merge
         ship deletes the tracking branch: yes
         sync-feature strategy: merge
         sync-perennial strategy: rebase
+        sync-strategy overrides: (none)
         sync with upstream: yes
         sync tags: yes
 
       Hosting:
         hosting platform override: (not set)
+        API URL override: (not set)
+        CA file: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Bitbucket token: (not set)
+        Azure DevOps token: (not set)
       """
 
   Scenario: all configured in config file
     And the configuration file:
       """
+      proposal-body = "commits"
+      proposal-remote = "upstream"
+      proposal-stack-section = false
       push-new-branches = true
+      push-remote = "fork"
       ship-strategy = "squash-merge"
       ship-delete-tracking-branch = true
       sync-upstream = true
@@ -62,6 +75,9 @@ Feature: show the configuration
       perennials = [ "public", "staging" ]
       perennial-regex = "release-.*"
 
+      [branches.overrides]
+      "shared-.*" = { sync-strategy = "merge" }
+
       [hosting]
       platform = "github"
       origin-hostname = "github.com"
@@ -85,18 +101,27 @@ Feature: show the configuration
         offline: no
         run pre-push hook: yes
         push new branches: yes
+        push remote: fork
+        proposal body: commits
+        proposal remote: upstream
+        proposal stack section: no
         ship strategy: squash-merge
         ship deletes the tracking branch: yes
         sync-feature strategy: rebase
         sync-perennial strategy: merge
+        sync-strategy overrides: shared-.*: merge
         sync with upstream: yes
         sync tags: no
 
       Hosting:
         hosting platform override: github
+        API URL override: (not set)
+        CA file: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Bitbucket token: (not set)
+        Azure DevOps token: (not set)
       """
 
   Scenario: configured in both Git and config file
@@ -119,6 +144,8 @@ Feature: show the configuration
     And Git Town setting "sync-tags" is "false"
     And Git Town setting "sync-perennial-strategy" is "merge"
     And Git Town setting "sync-feature-strategy" is "merge"
+    And Git Town setting "hosting-api-url" is "https://api.git.example.com"
+    And Git Town sync-strategy setting for branch "parked-1" is "rebase"
     And the configuration file:
       """
       push-new-branches = true
@@ -132,9 +159,14 @@ Feature: show the configuration
       perennials = [ "config-perennial-1", "config-perennial-2" ]
       perennial-regex = "config-perennial-.*"
 
+      [branches.overrides]
+      "parked-.*" = { sync-strategy = "compress" }
+
       [hosting]
       platform = "github"
       origin-hostname = "github.com"
+      api-url = "https://api.config.example.com"
+      ca-file = "/etc/ssl/config.pem"
 
       [sync-strategy]
       feature-branches = "merge"
@@ -155,18 +187,27 @@ Feature: show the configuration
         offline: no
         run pre-push hook: yes
         push new branches: no
+        push remote: origin
+        proposal body: template
+        proposal remote: origin
+        proposal stack section: yes
         ship strategy: squash-merge
         ship deletes the tracking branch: no
         sync-feature strategy: merge
         sync-perennial strategy: merge
+        sync-strategy overrides: parked-1: rebase, parked-.*: compress
         sync with upstream: no
         sync tags: no
 
       Hosting:
         hosting platform override: github
+        API URL override: https://api.git.example.com
+        CA file: /etc/ssl/config.pem
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Bitbucket token: (not set)
+        Azure DevOps token: (not set)
       """
 
   Scenario: all configured, with stacked changes
@@ -193,18 +234,27 @@ Feature: show the configuration
         offline: no
         run pre-push hook: yes
         push new branches: no
+        push remote: origin
+        proposal body: template
+        proposal remote: origin
+        proposal stack section: yes
         ship strategy: api
         ship deletes the tracking branch: yes
         sync-feature strategy: merge
         sync-perennial strategy: rebase
+        sync-strategy overrides: (none)
         sync with upstream: yes
         sync tags: yes
 
       Hosting:
         hosting platform override: (not set)
+        API URL override: (not set)
+        CA file: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Bitbucket token: (not set)
+        Azure DevOps token: (not set)
 
       Branch Lineage:
         main
@@ -233,16 +283,25 @@ Feature: show the configuration
         offline: no
         run pre-push hook: yes
         push new branches: no
+        push remote: origin
+        proposal body: template
+        proposal remote: origin
+        proposal stack section: yes
         ship strategy: api
         ship deletes the tracking branch: yes
         sync-feature strategy: merge
         sync-perennial strategy: rebase
+        sync-strategy overrides: (none)
         sync with upstream: yes
         sync tags: yes
 
       Hosting:
         hosting platform override: (not set)
+        API URL override: (not set)
+        CA file: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Bitbucket token: (not set)
+        Azure DevOps token: (not set)
       """
@@ -24,16 +24,25 @@ Feature: show the configuration from a subfolder
         offline: no
         run pre-push hook: yes
         push new branches: no
+        push remote: origin
+        proposal body: template
+        proposal remote: origin
+        proposal stack section: yes
         ship strategy: api
         ship deletes the tracking branch: yes
         sync-feature strategy: merge
         sync-perennial strategy: rebase
+        sync-strategy overrides: (none)
         sync with upstream: yes
         sync tags: yes
 
       Hosting:
         hosting platform override: (not set)
+        API URL override: (not set)
+        CA file: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Bitbucket token: (not set)
+        Azure DevOps token: (not set)
       """
@@ -0,0 +1,38 @@
+Feature: delete a branch that overrides the sync strategy
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | current | feature | main   | local, origin |
+      | other   | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | current | local, origin | current commit |
+    And the current branch is "current" and the previous branch is "other"
+    And Git Town sync-strategy setting for branch "current" is "merge"
+    And Git Town sync-strategy setting for branch "other" is "rebase"
+    When I run "git-town kill"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | current | git fetch --prune --tags |
+      |         | git push origin :current |
+      |         | git checkout other       |
+      | other   | git branch -D current    |
+    And the current branch is now "other"
+    And Git Town sync-strategy setting for branch "current" now doesn't exist
+    And Git Town sync-strategy setting for branch "other" is still "rebase"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                       |
+      | other  | git branch current {{ sha 'current commit' }} |
+      |        | git push -u origin current                    |
+      |        | git checkout current                          |
+    And the current branch is now "current"
+    And Git Town sync-strategy setting for branch "current" is now "merge"
+    And Git Town sync-strategy setting for branch "other" is still "rebase"
+    And the initial branches and lineage exist
@@ -20,7 +20,7 @@ Feature: display all executed Git commands
       |        | backend  | git config -lz --includes --local               |
       |        | backend  | git status --long --ignore-submodules           |
       |        | backend  | git remote                                      |
-      |        | backend  | git rev-parse --abbrev-ref HEAD                 |
+      |        | backend  | git branch --show-current                       |
       | old    | frontend | git fetch --prune --tags                        |
       |        | backend  | git stash list                                  |
       |        | backend  | git branch -vva --sort=refname                  |
@@ -0,0 +1,81 @@
+Feature: display the proposals of all branches in the lineage
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME   | TYPE    | PARENT | LOCATIONS     |
+      | parent | feature | main   | local, origin |
+      | child  | feature | parent | local, origin |
+      | other  | feature | main   | local, origin |
+    And the current branch is "child"
+    And Git Town setting "github-token" is "token"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the proposals
+      | FROM   | TO   | TITLE        | CHECKS  | REVIEW          |
+      | parent | main | parent title | success | approved        |
+      | child  | main | child title  | pending | review-required |
+
+  Scenario: text output
+    When I run "git-town proposals"
+    Then it runs the commands
+      | BRANCH | COMMAND                                    |
+      |        | looking for proposal online ... ok         |
+      |        | loading status of proposal 2 online ... ok |
+      |        | looking for proposal online ... ok         |
+      |        | looking for proposal online ... ok         |
+      |        | loading status of proposal 1 online ... ok |
+    And it prints:
+      """
+      main
+        other  (no proposal)
+        parent  #1 parent title (into main, approved)
+          child  #2 child title (into main, review-required)
+            warning: the proposal targets "main" but the parent branch is "parent"
+      """
+    And the current branch is still "child"
+    And the proposals are still
+      | FROM   | TO   | STATE |
+      | parent | main | open  |
+      | child  | main | open  |
+
+  Scenario: JSON output
+    When I run "git-town proposals --json"
+    Then it runs no commands
+    And it prints:
+      """
+      [
+        {
+          "branch": "child",
+          "parent": "parent",
+          "proposal": {
+            "checks": "pending",
+            "draft": false,
+            "number": 2,
+            "review": "review-required",
+            "target": "main",
+            "targetMismatch": true,
+            "title": "child title",
+            "url": "https://github.com/git-town/git-town/pull/2"
+          }
+        },
+        {
+          "branch": "other",
+          "parent": "main",
+          "proposal": null
+        },
+        {
+          "branch": "parent",
+          "parent": "main",
+          "proposal": {
+            "checks": "success",
+            "draft": false,
+            "number": 1,
+            "review": "approved",
+            "target": "main",
+            "targetMismatch": false,
+            "title": "parent title",
+            "url": "https://github.com/git-town/git-town/pull/1"
+          }
+        }
+      ]
+      """
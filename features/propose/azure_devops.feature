@@ -0,0 +1,25 @@
+@skipWindows
+Feature: Azure DevOps support
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And a proposal for this branch does not exist
+
+  Scenario Outline: normal origin
+    Given the origin is "<ORIGIN>"
+    And tool "open" is installed
+    When I run "git-town propose"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://dev.azure.com/town/git-town/_git/docs/pullrequestcreate?sourceRef=feature&targetRef=main
+      """
+
+    Examples:
+      | ORIGIN                                             |
+      | https://dev.azure.com/town/git-town/_git/docs      |
+      | https://town@dev.azure.com/town/git-town/_git/docs |
+      | git@ssh.dev.azure.com:v3/town/git-town/docs        |
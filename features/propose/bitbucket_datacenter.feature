@@ -0,0 +1,26 @@
+@skipWindows
+Feature: Bitbucket Data Center support
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And a proposal for this branch does not exist
+
+  Scenario Outline: normal origin
+    Given the origin is "<ORIGIN>"
+    And tool "open" is installed
+    When I run "git-town propose"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://bitbucket.example.com/projects/town/repos/git-town/pull-requests?create&sourceBranch=refs%2Fheads%2Ffeature&targetBranch=refs%2Fheads%2Fmain
+      """
+
+    Examples:
+      | ORIGIN                                                   |
+      | https://bitbucket.example.com/scm/town/git-town.git      |
+      | https://username@bitbucket.example.com/scm/town/git-town |
+      | ssh://git@bitbucket.example.com:7999/town/git-town.git   |
+      | ssh://git@bitbucket.example.com:7999/town/git-town       |
@@ -0,0 +1,102 @@
+@skipWindows
+Feature: create proposals via the API
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+
+  Scenario: a proposal for this branch exists already
+    Given the origin is "git@github.com:git-town/git-town.git"
+    And a proposal for this branch exists at "https://github.com/git-town/git-town/pull/123"
+    When I run "git-town propose --api"
+    Then it runs the commands
+      | BRANCH  | COMMAND                            |
+      | feature | git fetch --prune --tags           |
+      | <none>  | looking for proposal online ... ok |
+    And it prints:
+      """
+      https://github.com/git-town/git-town/pull/123
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist
+
+  Scenario: create a new proposal
+    Given the origin is "git@github.com:git-town/git-town.git"
+    And Git Town setting "github-token" is "token"
+    And the origin has a proposal from "other" into "main"
+    When I run "git-town propose --api --title=Hello"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                   |
+      | feature | git fetch --prune --tags                                  |
+      | <none>  | looking for proposal online ... ok                        |
+      | feature | git checkout main                                         |
+      | main    | git rebase origin/main                                    |
+      |         | git checkout feature                                      |
+      | feature | git merge --no-edit --ff origin/feature                   |
+      |         | git merge --no-edit --ff main                             |
+      | <none>  | GitHub API: creating PR from "feature" into "main" ... ok |
+    And it prints:
+      """
+      https://github.com/git-town/git-town/pull/2
+      """
+    And the proposals are now
+      | NUMBER | FROM    | TO   | TITLE | STATE |
+      | 1      | other   | main | other | open  |
+      | 2      | feature | main | Hello | open  |
+
+  Scenario: no title given
+    Given the origin is "git@github.com:git-town/git-town.git"
+    And Git Town setting "github-token" is "token"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE              |
+      | feature | local, origin | feature commit title |
+    And the origin has a proposal from "other" into "main"
+    When I run "git-town propose --api"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                   |
+      | feature | git fetch --prune --tags                                  |
+      | <none>  | looking for proposal online ... ok                        |
+      | feature | git checkout main                                         |
+      | main    | git rebase origin/main                                    |
+      |         | git checkout feature                                      |
+      | feature | git merge --no-edit --ff origin/feature                   |
+      |         | git merge --no-edit --ff main                             |
+      | <none>  | GitHub API: creating PR from "feature" into "main" ... ok |
+    And the proposals are now
+      | NUMBER | FROM    | TO   | TITLE                | STATE |
+      | 1      | other   | main | other                | open  |
+      | 2      | feature | main | feature commit title | open  |
+
+  Scenario: no title given and no commits in the branch
+    Given the origin is "git@github.com:git-town/git-town.git"
+    And Git Town setting "github-token" is "token"
+    And the origin has a proposal from "other" into "main"
+    When I run "git-town propose --api"
+    Then it runs the commands
+      | BRANCH  | COMMAND                            |
+      | feature | git fetch --prune --tags           |
+      | <none>  | looking for proposal online ... ok |
+    And it prints the error:
+      """
+      cannot create a proposal without a title, please provide one via --title
+      """
+    And the proposals are now
+      | NUMBER | FROM  | TO   | TITLE | STATE |
+      | 1      | other | main | other | open  |
+
+  Scenario: hosting platform without API support for creating proposals
+    Given the origin is "git@bitbucket.org:git-town/git-town.git"
+    And a proposal for this branch does not exist
+    When I run "git-town propose --api"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      creating proposals via the API is not supported for this hosting platform
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist
@@ -0,0 +1,134 @@
+@skipWindows
+Feature: provide reviewers, labels, assignees, and draft state for new proposals
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+
+  Scenario: GitHub API
+    Given the origin is "git@github.com:git-town/git-town.git"
+    And Git Town setting "github-token" is "token"
+    And the origin has a proposal from "other" into "main"
+    When I run "git-town propose --api --title=Hello --draft --label=bug --label=docs --assignee=alice --reviewer=bob,org/team"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                   |
+      | feature | git fetch --prune --tags                                  |
+      | <none>  | looking for proposal online ... ok                        |
+      | feature | git checkout main                                         |
+      | main    | git rebase origin/main                                    |
+      |         | git checkout feature                                      |
+      | feature | git merge --no-edit --ff origin/feature                   |
+      |         | git merge --no-edit --ff main                             |
+      | <none>  | GitHub API: creating PR from "feature" into "main" ... ok |
+    And it prints:
+      """
+      https://github.com/git-town/git-town/pull/2
+      """
+    And the proposals are now
+      | FROM    | TO   | TITLE | DRAFT | LABELS    | ASSIGNEES | REVIEWERS          |
+      | other   | main | other | false |           |           |                    |
+      | feature | main | Hello | true  | bug, docs | alice     | bob, git-town/team |
+
+  Scenario: GitLab API
+    Given the origin is "git@gitlab.com:git-town/git-town.git"
+    And Git Town setting "gitlab-token" is "token"
+    And the origin has a proposal from "other" into "main"
+    When I run "git-town propose --api --title=Hello --draft --label=bug --assignee=alice --reviewer=bob"
+    Then it prints:
+      """
+      https://gitlab.com/git-town/git-town/-/merge_requests/2
+      """
+    And the proposals are now
+      | FROM    | TO   | TITLE        | DRAFT | LABELS | ASSIGNEES | REVIEWERS |
+      | other   | main | other        | false |        |           |           |
+      | feature | main | Draft: Hello | true  | bug    | alice     | bob       |
+
+  Scenario: Gitea API
+    Given the origin is "git@gitea.com:git-town/git-town.git"
+    And Git Town setting "gitea-token" is "token"
+    And the origin has a proposal from "other" into "main"
+    When I run "git-town propose --api --title=Hello --draft --label=enhancement --assignee=alice --reviewer=bob"
+    Then it prints:
+      """
+      https://gitea.com/git-town/git-town/pulls/2
+      """
+    And the proposals are now
+      | FROM    | TO   | TITLE      | DRAFT | LABELS      | ASSIGNEES | REVIEWERS |
+      | other   | main | other      | false |             |           |           |
+      | feature | main | WIP: Hello | true  | enhancement | alice     | bob       |
+
+  Scenario: Gitea label that doesn't exist
+    Given the origin is "git@gitea.com:git-town/git-town.git"
+    And Git Town setting "gitea-token" is "token"
+    And the origin has a proposal from "other" into "main"
+    When I run "git-town propose --api --title=Hello --label=zonk"
+    Then it prints the error:
+      """
+      the Gitea repository has no label "zonk"
+      """
+    And the proposals are now
+      | FROM  | TO   | TITLE | LABELS |
+      | other | main | other |        |
+
+  Scenario: defaults in the configuration file
+    Given the origin is "git@github.com:git-town/git-town.git"
+    And Git Town setting "github-token" is "token"
+    And the origin has a proposal from "other" into "main"
+    And the configuration file:
+      """
+      [propose]
+      draft = true
+      labels = [ "bug" ]
+      reviewers = [ "bob" ]
+      """
+    When I run "git-town propose --api --title=Hello --label=docs"
+    Then it prints:
+      """
+      https://github.com/git-town/git-town/pull/2
+      """
+    And the proposals are now
+      | FROM    | TO   | TITLE | DRAFT | LABELS    | ASSIGNEES | REVIEWERS |
+      | other   | main | other | false |           |           |           |
+      | feature | main | Hello | true  | bug, docs |           | bob       |
+
+  Scenario: browser with labels and assignees on GitHub
+    Given the origin is "git@github.com:git-town/git-town.git"
+    And tool "open" is installed
+    And a proposal for this branch does not exist
+    When I run "git-town propose --label=bug --assignee=alice"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://github.com/git-town/git-town/compare/feature?expand=1&labels=bug&assignees=alice
+      """
+
+  Scenario: browser with metadata on GitLab
+    Given the origin is "git@gitlab.com:git-town/git-town.git"
+    And tool "open" is installed
+    And a proposal for this branch does not exist
+    When I run "git-town propose --title=Hello --draft --label=bug --assignee=alice"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://gitlab.com/git-town/git-town/-/merge_requests/new?merge_request%5Bdescription%5D=%2Flabel+~bug%0A%2Fassign+%40alice&merge_request%5Bsource_branch%5D=feature&merge_request%5Btarget_branch%5D=main&merge_request%5Btitle%5D=Draft%3A+Hello
+      """
+
+  Scenario: prototype branches get proposed as drafts
+    Given the branches
+      | NAME      | TYPE      | PARENT | LOCATIONS |
+      | prototype | prototype | main   | local     |
+    And the current branch is "prototype"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And Git Town setting "github-token" is "token"
+    And the origin has a proposal from "other" into "main"
+    When I run "git-town propose --api --title=Hello"
+    Then it prints:
+      """
+      branch "prototype" is no longer a prototype branch
+      """
+    And the proposals are now
+      | FROM      | TO   | TITLE | DRAFT |
+      | other     | main | other | false |
+      | prototype | main | Hello | true  |
+    And there are now no prototype branches
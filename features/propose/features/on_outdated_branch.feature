@@ -42,6 +42,8 @@ Feature: sync before proposing
       |        | git push                                                                  |
       |        | git stash pop                                                             |
       | <none> | open https://github.com/git-town/git-town/compare/parent...child?expand=1 |
+      |        | looking for proposal online ... ok                                        |
+      |        | looking for proposal online ... ok                                        |
     And "open" launches a new proposal with this url in my browser:
       """
       https://github.com/git-town/git-town/compare/parent...child?expand=1
@@ -0,0 +1,107 @@
+@skipWindows
+Feature: prefill the body of new proposals
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+
+  Scenario: GitHub pull request template via the API
+    Given the commits
+      | BRANCH  | LOCATION      | MESSAGE        | FILE NAME                        | FILE CONTENT         |
+      | main    | local, origin | add template   | .github/pull_request_template.md | Describe the change. |
+      | feature | local, origin | feature commit | feature_file                     | feature content      |
+    And the origin is "git@github.com:git-town/git-town.git"
+    And Git Town setting "github-token" is "token"
+    And the origin has a proposal from "other" into "main"
+    When I run "git-town propose --api --title=Hello"
+    Then it prints:
+      """
+      https://github.com/git-town/git-town/pull/2
+      """
+    And the proposals are now
+      | FROM    | TO   | TITLE | BODY                 |
+      | other   | main | other |                      |
+      | feature | main | Hello | Describe the change. |
+
+  Scenario: GitLab merge request template in the browser
+    Given the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME                                  | FILE CONTENT         |
+      | main   | local, origin | add template | .gitlab/merge_request_templates/Default.md | Describe the change. |
+    And the origin is "git@gitlab.com:git-town/git-town.git"
+    And tool "open" is installed
+    And a proposal for this branch does not exist
+    When I run "git-town propose"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://gitlab.com/git-town/git-town/-/merge_requests/new?merge_request%5Bdescription%5D=Describe+the+change.&merge_request%5Bsource_branch%5D=feature&merge_request%5Btarget_branch%5D=main
+      """
+
+  Scenario: Gitea pull request template with a body given on the command line
+    Given the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME                       | FILE CONTENT         |
+      | main   | local, origin | add template | .gitea/pull_request_template.md | Describe the change. |
+    And the origin is "git@gitea.com:git-town/git-town.git"
+    And Git Town setting "gitea-token" is "token"
+    And the origin has a proposal from "other" into "main"
+    When I run "git-town propose --api --title=Hello --body=Custom"
+    Then the proposals are now
+      | FROM    | TO   | TITLE | BODY   |
+      | other   | main | other |        |
+      | feature | main | Hello | Custom |
+
+  Scenario: commits mode in the browser
+    Given the commits
+      | BRANCH  | LOCATION      | MESSAGE          |
+      | feature | local, origin | feature commit 1 |
+      | feature | local         | feature commit 2 |
+    And Git Town setting "proposal-body" is "commits"
+    And the origin is "git@gitlab.com:git-town/git-town.git"
+    And tool "open" is installed
+    And a proposal for this branch does not exist
+    When I run "git-town propose"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://gitlab.com/git-town/git-town/-/merge_requests/new?merge_request%5Bdescription%5D=-+feature+commit+1%0A-+feature+commit+2&merge_request%5Bsource_branch%5D=feature&merge_request%5Btarget_branch%5D=main&merge_request%5Btitle%5D=feature+commit+1
+      """
+
+  Scenario: commits mode via the API
+    Given the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And Git Town setting "proposal-body" is "commits"
+    And the origin is "git@gitea.com:git-town/git-town.git"
+    And Git Town setting "gitea-token" is "token"
+    And the origin has a proposal from "other" into "main"
+    When I run "git-town propose --api"
+    Then the proposals are now
+      | FROM    | TO   | TITLE          | BODY             |
+      | other   | main | other          |                  |
+      | feature | main | feature commit | - feature commit |
+
+  Scenario: commits mode in the configuration file
+    Given the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the configuration file:
+      """
+      proposal-body = "commits"
+      """
+    And the origin is "git@github.com:git-town/git-town.git"
+    And Git Town setting "github-token" is "token"
+    And the origin has a proposal from "other" into "main"
+    When I run "git-town propose --api"
+    Then the proposals are now
+      | FROM    | TO   | TITLE          | BODY             |
+      | other   | main | other          |                  |
+      | feature | main | feature commit | - feature commit |
+
+  Scenario: unknown proposal body source
+    Given Git Town setting "proposal-body" is "zonk"
+    When I run "git-town propose"
+    Then it prints the error:
+      """
+      unknown proposal body source: "zonk"
+      """
@@ -0,0 +1,163 @@
+@skipWindows
+Feature: propose an entire stack
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+      | beta  | feature | alpha  | local, origin |
+    And the current branch is "beta"
+    And the origin is "git@github.com:git-town/git-town.git"
+
+  Scenario: all branches in the stack have proposals already
+    Given a proposal for this branch exists at "https://github.com/git-town/git-town/pull/123"
+    When I run "git-town propose --stack"
+    Then it runs the commands
+      | BRANCH | COMMAND                                     |
+      | beta   | git fetch --prune --tags                    |
+      |        | git checkout main                           |
+      | main   | git rebase origin/main                      |
+      |        | git checkout alpha                          |
+      | alpha  | git merge --no-edit --ff origin/alpha       |
+      |        | git merge --no-edit --ff main               |
+      |        | git checkout beta                           |
+      | beta   | git merge --no-edit --ff origin/beta        |
+      |        | git merge --no-edit --ff alpha              |
+      | <none> | looking for proposal online ... ok          |
+      |        | looking for proposal online ... ok          |
+      |        | looking for proposal online ... ok          |
+      |        | looking for proposal online ... ok          |
+      |        | looking for proposal online ... ok          |
+      |        | looking for proposal online ... ok          |
+      |        | updating body of proposal 123 online ... ok |
+    And it prints:
+      """
+      BRANCH  PROPOSAL  URL
+      alpha   #123      https://github.com/git-town/git-town/pull/123
+      beta    #123      https://github.com/git-town/git-town/pull/123
+      """
+    And the current branch is still "beta"
+    And the initial branches and lineage exist
+
+  Scenario: create proposals for the branches in the stack
+    Given Git Town setting "github-token" is "token"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | beta commit  |
+    And the proposals
+      | FROM  | TO   |
+      | alpha | main |
+    When I run "git-town propose --stack"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                 |
+      | beta   | git fetch --prune --tags                                |
+      |        | git checkout main                                       |
+      | main   | git rebase origin/main                                  |
+      |        | git checkout alpha                                      |
+      | alpha  | git merge --no-edit --ff origin/alpha                   |
+      |        | git merge --no-edit --ff main                           |
+      |        | git checkout beta                                       |
+      | beta   | git merge --no-edit --ff origin/beta                    |
+      |        | git merge --no-edit --ff alpha                          |
+      |        | git push                                                |
+      | <none> | looking for proposal online ... ok                      |
+      |        | looking for proposal online ... ok                      |
+      |        | looking for proposal online ... ok                      |
+      |        | GitHub API: creating PR from "beta" into "alpha" ... ok |
+      |        | looking for proposal online ... ok                      |
+      |        | looking for proposal online ... ok                      |
+      |        | looking for proposal online ... ok                      |
+      |        | looking for proposal online ... ok                      |
+      |        | updating body of proposal 1 online ... ok               |
+      |        | updating body of proposal 2 online ... ok               |
+    And the proposals are now
+      | NUMBER | FROM  | TO    | TITLE       | STATE |
+      | 1      | alpha | main  | alpha       | open  |
+      | 2      | beta  | alpha | beta commit | open  |
+
+  Scenario: retarget a proposal that targets a different branch
+    Given Git Town setting "github-token" is "token"
+    And the proposals
+      | FROM  | TO   |
+      | alpha | main |
+      | beta  | main |
+    When I run "git-town propose --stack"
+    Then it runs the commands
+      | BRANCH | COMMAND                                   |
+      | beta   | git fetch --prune --tags                  |
+      |        | git checkout main                         |
+      | main   | git rebase origin/main                    |
+      |        | git checkout alpha                        |
+      | alpha  | git merge --no-edit --ff origin/alpha     |
+      |        | git merge --no-edit --ff main             |
+      |        | git checkout beta                         |
+      | beta   | git merge --no-edit --ff origin/beta      |
+      |        | git merge --no-edit --ff alpha            |
+      | <none> | looking for proposal online ... ok        |
+      |        | looking for proposal online ... ok        |
+      |        | looking for proposal online ... ok        |
+      |        | updating proposal target online ...ok     |
+      |        | looking for proposal online ... ok        |
+      |        | looking for proposal online ... ok        |
+      |        | looking for proposal online ... ok        |
+      |        | looking for proposal online ... ok        |
+      |        | updating body of proposal 1 online ... ok |
+      |        | updating body of proposal 2 online ... ok |
+    And the proposals are now
+      | NUMBER | FROM  | TO    | STATE |
+      | 1      | alpha | main  | open  |
+      | 2      | beta  | alpha | open  |
+
+  Scenario: parked branch in the stack
+    Given Git Town setting "github-token" is "token"
+    And Git Town setting "parked-branches" is "alpha"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE      |
+      | alpha  | local    | alpha commit |
+    And the proposals
+      | FROM  | TO    |
+      | alpha | main  |
+      | beta  | alpha |
+    When I run "git-town propose --stack"
+    Then it runs the commands
+      | BRANCH | COMMAND                                   |
+      | beta   | git fetch --prune --tags                  |
+      |        | git checkout main                         |
+      | main   | git rebase origin/main                    |
+      |        | git checkout alpha                        |
+      | alpha  | git checkout beta                         |
+      | beta   | git merge --no-edit --ff origin/beta      |
+      |        | git merge --no-edit --ff alpha            |
+      |        | git push                                  |
+      |        | git checkout alpha                        |
+      | alpha  | git push                                  |
+      |        | git checkout beta                         |
+      | <none> | looking for proposal online ... ok        |
+      |        | looking for proposal online ... ok        |
+      |        | looking for proposal online ... ok        |
+      |        | looking for proposal online ... ok        |
+      |        | looking for proposal online ... ok        |
+      |        | looking for proposal online ... ok        |
+      |        | updating body of proposal 1 online ... ok |
+      |        | updating body of proposal 2 online ... ok |
+    And the current branch is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | alpha commit |
+
+  Scenario: hosting platform without API support for creating proposals
+    Given the origin is "git@bitbucket.org:git-town/git-town.git"
+    And a proposal for this branch does not exist
+    When I run "git-town propose --stack"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | beta   | git fetch --prune --tags |
+    And it prints the error:
+      """
+      creating proposals via the API is not supported for this hosting platform
+      """
+    And the current branch is still "beta"
+    And the initial branches and lineage exist
@@ -19,13 +19,14 @@ Feature: display all executed Git commands
       |         | backend  | git config -lz --includes --local                                  |
       |         | backend  | git status --long --ignore-submodules                              |
       |         | backend  | git remote                                                         |
-      |         | backend  | git rev-parse --abbrev-ref HEAD                                    |
+      |         | backend  | git branch --show-current                                          |
       | feature | frontend | git fetch --prune --tags                                           |
       |         | backend  | git stash list                                                     |
       |         | backend  | git branch -vva --sort=refname                                     |
       |         | backend  | git rev-parse --verify --abbrev-ref @{-1}                          |
       | <none>  | frontend | looking for proposal online ... ok                                 |
       |         | backend  | git log main..feature --format=%h                                  |
+      |         | backend  | git show main:.github/pull_request_template.md                     |
       | feature | frontend | git checkout main                                                  |
       | main    | frontend | git rebase origin/main                                             |
       |         | backend  | git rev-list --left-right main...origin/main                       |
@@ -45,7 +46,7 @@ Feature: display all executed Git commands
       |         | backend  | git stash list                                                     |
     And it prints:
       """
-      Ran 29 shell commands.
+      Ran 30 shell commands.
       """
     And "open" launches a new proposal with this url in my browser:
       """
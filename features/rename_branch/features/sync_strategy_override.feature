@@ -0,0 +1,40 @@
+Feature: rename a branch that overrides the sync strategy
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME | TYPE    | PARENT | LOCATIONS     |
+      | old  | feature | main   | local, origin |
+    And the current branch is "old"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE    |
+      | old    | local, origin | old commit |
+    And Git Town sync-strategy setting for branch "old" is "merge"
+    When I run "git-town rename-branch new"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | old    | git fetch --prune --tags |
+      |        | git branch new old       |
+      |        | git checkout new         |
+      | new    | git push -u origin new   |
+      |        | git push origin :old     |
+      |        | git branch -D old        |
+    And the current branch is now "new"
+    And Git Town sync-strategy setting for branch "new" is now "merge"
+    And Git Town sync-strategy setting for branch "old" now doesn't exist
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                               |
+      | new    | git branch old {{ sha 'old commit' }} |
+      |        | git push -u origin old                |
+      |        | git push origin :new                  |
+      |        | git checkout old                      |
+      | old    | git branch -D new                     |
+    And the current branch is now "old"
+    And Git Town sync-strategy setting for branch "old" is now "merge"
+    And Git Town sync-strategy setting for branch "new" now doesn't exist
+    And the initial branches and lineage exist
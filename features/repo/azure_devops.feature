@@ -0,0 +1,18 @@
+@skipWindows
+Feature: Azure DevOps
+
+  Scenario Outline:
+    Given a Git repo with origin
+    And the origin is "<ORIGIN>"
+    And tool "open" is installed
+    When I run "git-town repo"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://dev.azure.com/town/git-town/_git/docs
+      """
+
+    Examples:
+      | ORIGIN                                             |
+      | https://dev.azure.com/town/git-town/_git/docs      |
+      | https://town@dev.azure.com/town/git-town/_git/docs |
+      | git@ssh.dev.azure.com:v3/town/git-town/docs        |
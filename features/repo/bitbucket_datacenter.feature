@@ -0,0 +1,19 @@
+@skipWindows
+Feature: Bitbucket Data Center
+
+  Scenario Outline:
+    Given a Git repo with origin
+    And the origin is "<ORIGIN>"
+    And tool "open" is installed
+    When I run "git-town repo"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://bitbucket.example.com/projects/town/repos/git-town
+      """
+
+    Examples:
+      | ORIGIN                                                   |
+      | https://bitbucket.example.com/scm/town/git-town.git      |
+      | https://username@bitbucket.example.com/scm/town/git-town |
+      | ssh://git@bitbucket.example.com:7999/town/git-town.git   |
+      | ssh://git@bitbucket.example.com:7999/town/git-town       |
@@ -16,7 +16,7 @@ Feature: display all executed Git commands
       |        | backend  | which garcon-url-handler                  |
       |        | backend  | which xdg-open                            |
       |        | backend  | which open                                |
-      |        | backend  | git rev-parse --abbrev-ref HEAD           |
+      |        | backend  | git branch --show-current                 |
       | <none> | frontend | open https://github.com/git-town/git-town |
     And it prints:
       """
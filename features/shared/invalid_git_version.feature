@@ -13,21 +13,23 @@ Feature: require minimum Git version
       """
 
     Examples:
-      | COMMAND           |
-      | append foo        |
-      | config            |
-      | config setup      |
-      | diff-parent       |
-      | hack foo          |
-      | kill              |
-      | offline           |
-      | propose           |
-      | prepend foo       |
-      | rename-branch foo |
-      | repo              |
-      | set-parent        |
-      | ship              |
-      | sync              |
+      | COMMAND             |
+      | append foo          |
+      | checkout-proposal 1 |
+      | config              |
+      | config setup        |
+      | diff-parent         |
+      | hack foo            |
+      | kill                |
+      | offline             |
+      | proposals           |
+      | propose             |
+      | prepend foo         |
+      | rename-branch foo   |
+      | repo                |
+      | set-parent          |
+      | ship                |
+      | sync                |
 
   Scenario Outline: not requiring Git
     When I run "git-town <COMMAND>"
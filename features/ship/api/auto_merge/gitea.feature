@@ -0,0 +1,32 @@
+Feature: schedule the merge of a pull request via the Gitea API
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "api-merge"
+    And Git Town setting "gitea-token" is "token"
+    And the origin is "git@gitea.com:git-town/git-town.git"
+    And the proposals
+      | FROM    | TO   | CHECKS  |
+      | feature | main | pending |
+    When I run "git-town ship --auto"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                     |
+      | feature | git fetch --prune --tags                    |
+      | <none>  | looking for proposal online ... ok          |
+      |         | loading status of proposal 1 online ... ok  |
+      |         | Gitea API: Scheduling merge of PR #1 ... ok |
+    And the proposals are now
+      | FROM    | TO   | STATE | AUTO-MERGE |
+      | feature | main | open  | merge      |
+    And the current branch is still "feature"
+    And the initial branches and lineage exist
+    And the initial commits exist
@@ -0,0 +1,90 @@
+Feature: enable auto-merge for a proposal via the GitHub API
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "api"
+    And Git Town setting "github-token" is "token"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the proposals
+      | FROM    | TO   | CHECKS  | REVIEW   |
+      | feature | main | pending | required |
+    When I run "git-town ship --auto -m done"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                          |
+      | feature | git fetch --prune --tags                         |
+      | <none>  | looking for proposal online ... ok               |
+      |         | loading status of proposal 1 online ... ok       |
+      |         | GitHub API: enabling auto-merge for PR #1 ... ok |
+    And it prints:
+      """
+      Enabled auto-merge for the proposal of branch "feature".
+      Run "git town sync" after it got merged to remove the branch.
+      """
+    And the proposals are now
+      | FROM    | TO   | STATE | AUTO-MERGE |
+      | feature | main | open  | squash     |
+    And the current branch is still "feature"
+    And the initial branches and lineage exist
+    And the initial commits exist
+
+  Scenario: the hosting platform merges the proposal, then sync removes the branch
+    When the CI checks of the proposal of branch "feature" pass
+    And I run "git-town sync"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                   |
+      | feature | git fetch --prune --tags                  |
+      | <none>  | looking for merged proposal online ... ok |
+      | feature | git checkout main                         |
+      | main    | git rebase origin/main                    |
+      |         | git push origin :feature                  |
+      |         | git checkout feature                      |
+      | feature | git merge --no-edit --ff main             |
+      |         | git checkout main                         |
+      | main    | git branch -D feature                     |
+    And the proposals are now
+      | FROM    | TO   | STATE  | AUTO-MERGE |
+      | feature | main | merged |            |
+    And the current branch is now "main"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE |
+      | main   | local, origin | done    |
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And no lineage exists now
+
+  Scenario: the hosting platform merges the proposal, then sync removes the branch but keeps the tracking branch
+    Given Git Town setting "ship-delete-tracking-branch" is "false"
+    When the CI checks of the proposal of branch "feature" pass
+    And I run "git-town sync"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                   |
+      | feature | git fetch --prune --tags                  |
+      | <none>  | looking for merged proposal online ... ok |
+      | feature | git checkout main                         |
+      | main    | git rebase origin/main                    |
+      |         | git checkout feature                      |
+      | feature | git merge --no-edit --ff main             |
+      |         | git checkout main                         |
+      | main    | git branch -D feature                     |
+    And the branches are now
+      | REPOSITORY | BRANCHES      |
+      | local      | main          |
+      | origin     | main, feature |
+    And no lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And the current branch is still "feature"
+    And the initial branches and lineage exist
+    And the initial commits exist
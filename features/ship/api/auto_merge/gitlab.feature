@@ -0,0 +1,42 @@
+Feature: enable merge when pipeline succeeds for a merge request via the GitLab API
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "api"
+    And Git Town setting "gitlab-token" is "token"
+    And the origin is "git@gitlab.com:git-town/git-town.git"
+    And the proposals
+      | FROM    | TO   | CHECKS  |
+      | feature | main | pending |
+    When I run "git-town ship --auto -m done"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                            |
+      | feature | git fetch --prune --tags                                           |
+      | <none>  | looking for proposal online ... ok                                 |
+      |         | loading status of proposal 1 online ... ok                         |
+      |         | GitLab API: Enabling merge when pipeline succeeds for MR !1 ... ok |
+    And the proposals are now
+      | FROM    | TO   | STATE | AUTO-MERGE |
+      | feature | main | open  | squash     |
+    And the current branch is still "feature"
+    And the initial branches and lineage exist
+    And the initial commits exist
+
+  Scenario: the pipeline succeeds
+    When the CI checks of the proposal of branch "feature" pass
+    Then the proposals are now
+      | FROM    | TO   | STATE  | AUTO-MERGE |
+      | feature | main | merged |            |
+    And the branches are now
+      | REPOSITORY | BRANCHES      |
+      | local      | main, feature |
+      | origin     | main          |
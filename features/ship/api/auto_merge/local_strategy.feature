@@ -0,0 +1,25 @@
+Feature: does not enable auto-merge with a local ship strategy
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "squash-merge"
+    When I run "git-town ship --auto -m done"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      shipping with --auto requires one of the api ship strategies
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist
+    And the initial commits exist
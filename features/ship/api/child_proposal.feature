@@ -0,0 +1,42 @@
+Feature: shipping a branch via the API updates the proposals of its child branches
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME   | TYPE    | PARENT | LOCATIONS     |
+      | parent | feature | main   | local, origin |
+      | child  | feature | parent | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       |
+      | parent | local, origin | parent commit |
+      | child  | local, origin | child commit  |
+    And the current branch is "parent"
+    And Git Town setting "ship-strategy" is "api"
+    And Git Town setting "github-token" is "token"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the proposals
+      | FROM   | TO     |
+      | parent | main   |
+      | child  | parent |
+    When I run "git-town ship -m done"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                    |
+      | parent | git fetch --prune --tags                   |
+      | <none> | looking for proposal online ... ok         |
+      |        | looking for proposal online ... ok         |
+      |        | loading status of proposal 1 online ... ok |
+      |        | updating proposal target online ...ok      |
+      | parent | git checkout main                          |
+      | <none> | GitHub API: merging PR #1 ... ok           |
+      | main   | git push origin :parent                    |
+      |        | git branch -D parent                       |
+    And the proposals are now
+      | FROM   | TO   | STATE  |
+      | parent | main | merged |
+      | child  | main | open   |
+    And the current branch is now "main"
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
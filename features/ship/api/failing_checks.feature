@@ -0,0 +1,51 @@
+Feature: does not ship a proposal with failing CI checks via the API
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "api"
+    And Git Town setting "github-token" is "token"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the proposals
+      | FROM    | TO   | CHECKS  |
+      | feature | main | failure |
+
+  Scenario: result
+    When I run "git-town ship -m done"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                    |
+      | feature | git fetch --prune --tags                   |
+      | <none>  | looking for proposal online ... ok         |
+      |         | loading status of proposal 1 online ... ok |
+    And it prints the error:
+      """
+      cannot ship branch "feature" because the CI checks of its proposal have failed, ship with --force to ignore this
+      """
+    And the proposals are still
+      | FROM    | TO   | STATE |
+      | feature | main | open  |
+    And the initial branches and lineage exist
+    And the initial commits exist
+
+  Scenario: force
+    When I run "git-town ship -m done --force"
+    Then it runs the commands
+      | BRANCH  | COMMAND                            |
+      | feature | git fetch --prune --tags           |
+      | <none>  | looking for proposal online ... ok |
+      | feature | git checkout main                  |
+      | <none>  | GitHub API: merging PR #1 ... ok   |
+      | main    | git push origin :feature           |
+      |         | git branch -D feature              |
+    And the proposals are now
+      | FROM    | TO   | STATE  |
+      | feature | main | merged |
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
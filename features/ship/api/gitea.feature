@@ -0,0 +1,56 @@
+Feature: ship a branch via the Gitea API
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "api"
+    And Git Town setting "gitea-token" is "token"
+    And the origin is "git@gitea.com:git-town/git-town.git"
+    And the origin has a proposal from "feature" into "main"
+    When I run "git-town ship -m done"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                    |
+      | feature | git fetch --prune --tags                   |
+      | <none>  | looking for proposal online ... ok         |
+      |         | loading status of proposal 1 online ... ok |
+      | feature | git checkout main                          |
+      | <none>  | Gitea API: Merging PR #1 ... ok            |
+      |         | looking for proposal online ... ok         |
+      | main    | git push origin :feature                   |
+      |         | git branch -D feature                      |
+    And the proposals are now
+      | FROM    | TO   | STATE  |
+      | feature | main | merged |
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And these commits exist now
+      | BRANCH | LOCATION | MESSAGE |
+      | main   | origin   | done    |
+    And no lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                       |
+      | main   | git branch feature {{ sha 'feature commit' }} |
+      |        | git push -u origin feature                    |
+      |        | git checkout feature                          |
+    And the current branch is now "feature"
+    And the initial branches and lineage exist
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | main    | origin        | done           |
+      | feature | local, origin | feature commit |
+    And the proposals are still
+      | FROM    | TO   | STATE  |
+      | feature | main | merged |
@@ -0,0 +1,32 @@
+Feature: cannot ship with a merge method that the hosting platform connector doesn't support
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "api-merge"
+    And the origin is "git@bitbucket.org:git-town/git-town.git"
+    And a proposal for this branch does not exist
+    When I run "git-town ship"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      the "api-merge" ship strategy is not supported for this hosting platform
+      """
+    And the initial branches and lineage exist
+    And the initial commits exist
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And the initial branches and lineage exist
+    And the initial commits exist
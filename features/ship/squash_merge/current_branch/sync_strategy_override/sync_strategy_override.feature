@@ -0,0 +1,35 @@
+Feature: ship a branch that overrides the sync strategy
+
+  Background:
+    Given a local Git repo
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS |
+      | feature | feature | main   | local     |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE        |
+      | feature | local    | feature commit |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "squash-merge"
+    And Git Town sync-strategy setting for branch "feature" is "merge"
+    When I run "git-town ship -m done"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                         |
+      | feature | git checkout main               |
+      | main    | git merge --squash --ff feature |
+      |         | git commit -m done              |
+      |         | git branch -D feature           |
+    And the current branch is now "main"
+    And Git Town sync-strategy setting for branch "feature" now doesn't exist
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                       |
+      | main   | git reset --hard {{ sha 'initial commit' }}   |
+      |        | git branch feature {{ sha 'feature commit' }} |
+      |        | git checkout feature                          |
+    And the current branch is now "feature"
+    And Git Town sync-strategy setting for branch "feature" is now "merge"
+    And the initial branches and lineage exist
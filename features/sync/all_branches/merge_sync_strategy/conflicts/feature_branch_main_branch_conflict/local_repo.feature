@@ -19,13 +19,12 @@ Feature: handle merge conflicts between feature branch and main branch in a loca
 
   Scenario: result
     Then it runs the commands
-      | BRANCH | COMMAND                       |
-      | main   | git add -A                    |
-      |        | git stash                     |
-      |        | git checkout alpha            |
-      | alpha  | git merge --no-edit --ff main |
-      |        | git checkout beta             |
-      | beta   | git merge --no-edit --ff main |
+      | BRANCH | COMMAND                                                                                             |
+      | main   | git add -A                                                                                          |
+      |        | git stash                                                                                           |
+      |        | git update-ref refs/heads/alpha {{ sha 'Merge branch 'main' into alpha' }} {{ sha 'alpha commit' }} |
+      |        | git checkout beta                                                                                   |
+      | beta   | git merge --no-edit --ff main                                                                       |
     And it prints the error:
       """
       CONFLICT (add/add): Merge conflict in conflicting_file
@@ -57,12 +56,11 @@ Feature: handle merge conflicts between feature branch and main branch in a loca
   Scenario: skip
     When I run "git-town skip"
     Then it runs the commands
-      | BRANCH | COMMAND                       |
-      | beta   | git merge --abort             |
-      |        | git checkout gamma            |
-      | gamma  | git merge --no-edit --ff main |
-      |        | git checkout main             |
-      | main   | git stash pop                 |
+      | BRANCH | COMMAND                                                                                             |
+      | beta   | git merge --abort                                                                                   |
+      |        | git update-ref refs/heads/gamma {{ sha 'Merge branch 'main' into gamma' }} {{ sha 'gamma commit' }} |
+      |        | git checkout main                                                                                   |
+      | main   | git stash pop                                                                                       |
     And the current branch is now "main"
     And the uncommitted file still exists
     And no merge is in progress
@@ -100,12 +98,11 @@ Feature: handle merge conflicts between feature branch and main branch in a loca
     When I resolve the conflict in "conflicting_file"
     And I run "git-town continue"
     Then it runs the commands
-      | BRANCH | COMMAND                       |
-      | beta   | git commit --no-edit          |
-      |        | git checkout gamma            |
-      | gamma  | git merge --no-edit --ff main |
-      |        | git checkout main             |
-      | main   | git stash pop                 |
+      | BRANCH | COMMAND                                                                                             |
+      | beta   | git commit --no-edit                                                                                |
+      |        | git update-ref refs/heads/gamma {{ sha 'Merge branch 'main' into gamma' }} {{ sha 'gamma commit' }} |
+      |        | git checkout main                                                                                   |
+      | main   | git stash pop                                                                                       |
     And all branches are now synchronized
     And the current branch is now "main"
     And the uncommitted file still exists
@@ -124,8 +121,7 @@ Feature: handle merge conflicts between feature branch and main branch in a loca
     And I run "git commit --no-edit"
     And I run "git-town continue"
     Then it runs the commands
-      | BRANCH | COMMAND                       |
-      | beta   | git checkout gamma            |
-      | gamma  | git merge --no-edit --ff main |
-      |        | git checkout main             |
-      | main   | git stash pop                 |
+      | BRANCH | COMMAND                                                                                             |
+      | beta   | git update-ref refs/heads/gamma {{ sha 'Merge branch 'main' into gamma' }} {{ sha 'gamma commit' }} |
+      |        | git checkout main                                                                                   |
+      | main   | git stash pop                                                                                       |
@@ -20,18 +20,16 @@ Feature: handle merge conflicts between feature branch and main branch
 
   Scenario: result
     Then it runs the commands
-      | BRANCH | COMMAND                               |
-      | main   | git fetch --prune --tags              |
-      |        | git add -A                            |
-      |        | git stash                             |
-      |        | git rebase origin/main                |
-      |        | git checkout alpha                    |
-      | alpha  | git merge --no-edit --ff origin/alpha |
-      |        | git merge --no-edit --ff main         |
-      |        | git push                              |
-      |        | git checkout beta                     |
-      | beta   | git merge --no-edit --ff origin/beta  |
-      |        | git merge --no-edit --ff main         |
+      | BRANCH | COMMAND                                                                                             |
+      | main   | git fetch --prune --tags                                                                            |
+      |        | git add -A                                                                                          |
+      |        | git stash                                                                                           |
+      |        | git rebase origin/main                                                                              |
+      |        | git update-ref refs/heads/alpha {{ sha 'Merge branch 'main' into alpha' }} {{ sha 'alpha commit' }} |
+      |        | git push origin alpha                                                                               |
+      |        | git checkout beta                                                                                   |
+      | beta   | git merge --no-edit --ff origin/beta                                                                |
+      |        | git merge --no-edit --ff main                                                                       |
     And it prints the error:
       """
       CONFLICT (add/add): Merge conflict in conflicting_file
@@ -68,16 +66,14 @@ Feature: handle merge conflicts between feature branch and main branch
   Scenario: skip
     When I run "git-town skip"
     Then it runs the commands
-      | BRANCH | COMMAND                                        |
-      | beta   | git merge --abort                              |
-      |        | git reset --hard {{ sha 'local beta commit' }} |
-      |        | git checkout gamma                             |
-      | gamma  | git merge --no-edit --ff origin/gamma          |
-      |        | git merge --no-edit --ff main                  |
-      |        | git push                                       |
-      |        | git checkout main                              |
-      | main   | git push --tags                                |
-      |        | git stash pop                                  |
+      | BRANCH | COMMAND                                                                                               |
+      | beta   | git merge --abort                                                                                     |
+      |        | git reset --hard {{ sha 'local beta commit' }}                                                        |
+      |        | git update-ref refs/heads/gamma {{ sha 'Merge branch 'main' into gamma' }} {{ sha 'initial commit' }} |
+      |        | git push origin gamma                                                                                 |
+      |        | git checkout main                                                                                     |
+      | main   | git push --tags                                                                                       |
+      |        | git stash pop                                                                                         |
     And the current branch is now "main"
     And the uncommitted file still exists
     And no merge is in progress
@@ -116,16 +112,14 @@ Feature: handle merge conflicts between feature branch and main branch
     When I resolve the conflict in "conflicting_file"
     And I run "git-town continue"
     Then it runs the commands
-      | BRANCH | COMMAND                               |
-      | beta   | git commit --no-edit                  |
-      |        | git push                              |
-      |        | git checkout gamma                    |
-      | gamma  | git merge --no-edit --ff origin/gamma |
-      |        | git merge --no-edit --ff main         |
-      |        | git push                              |
-      |        | git checkout main                     |
-      | main   | git push --tags                       |
-      |        | git stash pop                         |
+      | BRANCH | COMMAND                                                                                               |
+      | beta   | git commit --no-edit                                                                                  |
+      |        | git push                                                                                              |
+      |        | git update-ref refs/heads/gamma {{ sha 'Merge branch 'main' into gamma' }} {{ sha 'initial commit' }} |
+      |        | git push origin gamma                                                                                 |
+      |        | git checkout main                                                                                     |
+      | main   | git push --tags                                                                                       |
+      |        | git stash pop                                                                                         |
     And the current branch is now "main"
     And the uncommitted file still exists
     And all branches are now synchronized
@@ -145,12 +139,10 @@ Feature: handle merge conflicts between feature branch and main branch
     And I run "git commit --no-edit"
     And I run "git-town continue"
     Then it runs the commands
-      | BRANCH | COMMAND                               |
-      | beta   | git push                              |
-      |        | git checkout gamma                    |
-      | gamma  | git merge --no-edit --ff origin/gamma |
-      |        | git merge --no-edit --ff main         |
-      |        | git push                              |
-      |        | git checkout main                     |
-      | main   | git push --tags                       |
-      |        | git stash pop                         |
+      | BRANCH | COMMAND                                                                                               |
+      | beta   | git push                                                                                              |
+      |        | git update-ref refs/heads/gamma {{ sha 'Merge branch 'main' into gamma' }} {{ sha 'initial commit' }} |
+      |        | git push origin gamma                                                                                 |
+      |        | git checkout main                                                                                     |
+      | main   | git push --tags                                                                                       |
+      |        | git stash pop                                                                                         |
@@ -20,17 +20,15 @@ Feature: handle merge conflicts between feature branches and their tracking bran
 
   Scenario: result
     Then it runs the commands
-      | BRANCH | COMMAND                               |
-      | main   | git fetch --prune --tags              |
-      |        | git add -A                            |
-      |        | git stash                             |
-      |        | git rebase origin/main                |
-      |        | git checkout alpha                    |
-      | alpha  | git merge --no-edit --ff origin/alpha |
-      |        | git merge --no-edit --ff main         |
-      |        | git push                              |
-      |        | git checkout beta                     |
-      | beta   | git merge --no-edit --ff origin/beta  |
+      | BRANCH | COMMAND                                                                                             |
+      | main   | git fetch --prune --tags                                                                            |
+      |        | git add -A                                                                                          |
+      |        | git stash                                                                                           |
+      |        | git rebase origin/main                                                                              |
+      |        | git update-ref refs/heads/alpha {{ sha 'Merge branch 'main' into alpha' }} {{ sha 'alpha commit' }} |
+      |        | git push origin alpha                                                                               |
+      |        | git checkout beta                                                                                   |
+      | beta   | git merge --no-edit --ff origin/beta                                                                |
     And it prints the error:
       """
       CONFLICT (add/add): Merge conflict in conflicting_file
@@ -64,15 +62,13 @@ Feature: handle merge conflicts between feature branches and their tracking bran
   Scenario: skip
     When I run "git-town skip"
     Then it runs the commands
-      | BRANCH | COMMAND                               |
-      | beta   | git merge --abort                     |
-      |        | git checkout gamma                    |
-      | gamma  | git merge --no-edit --ff origin/gamma |
-      |        | git merge --no-edit --ff main         |
-      |        | git push                              |
-      |        | git checkout main                     |
-      | main   | git push --tags                       |
-      |        | git stash pop                         |
+      | BRANCH | COMMAND                                                                                             |
+      | beta   | git merge --abort                                                                                   |
+      |        | git update-ref refs/heads/gamma {{ sha 'Merge branch 'main' into gamma' }} {{ sha 'gamma commit' }} |
+      |        | git push origin gamma                                                                               |
+      |        | git checkout main                                                                                   |
+      | main   | git push --tags                                                                                     |
+      |        | git stash pop                                                                                       |
     And the current branch is now "main"
     And the uncommitted file still exists
     And these commits exist now
@@ -110,17 +106,15 @@ Feature: handle merge conflicts between feature branches and their tracking bran
     When I resolve the conflict in "conflicting_file"
     And I run "git-town continue"
     Then it runs the commands
-      | BRANCH | COMMAND                               |
-      | beta   | git commit --no-edit                  |
-      |        | git merge --no-edit --ff main         |
-      |        | git push                              |
-      |        | git checkout gamma                    |
-      | gamma  | git merge --no-edit --ff origin/gamma |
-      |        | git merge --no-edit --ff main         |
-      |        | git push                              |
-      |        | git checkout main                     |
-      | main   | git push --tags                       |
-      |        | git stash pop                         |
+      | BRANCH | COMMAND                                                                                             |
+      | beta   | git commit --no-edit                                                                                |
+      |        | git merge --no-edit --ff main                                                                       |
+      |        | git push                                                                                            |
+      |        | git update-ref refs/heads/gamma {{ sha 'Merge branch 'main' into gamma' }} {{ sha 'gamma commit' }} |
+      |        | git push origin gamma                                                                               |
+      |        | git checkout main                                                                                   |
+      | main   | git push --tags                                                                                     |
+      |        | git stash pop                                                                                       |
     And all branches are now synchronized
     And the current branch is now "main"
     And the uncommitted file still exists
@@ -140,13 +134,11 @@ Feature: handle merge conflicts between feature branches and their tracking bran
     And I run "git commit --no-edit"
     And I run "git-town continue"
     Then it runs the commands
-      | BRANCH | COMMAND                               |
-      | beta   | git merge --no-edit --ff main         |
-      |        | git push                              |
-      |        | git checkout gamma                    |
-      | gamma  | git merge --no-edit --ff origin/gamma |
-      |        | git merge --no-edit --ff main         |
-      |        | git push                              |
-      |        | git checkout main                     |
-      | main   | git push --tags                       |
-      |        | git stash pop                         |
+      | BRANCH | COMMAND                                                                                             |
+      | beta   | git merge --no-edit --ff main                                                                       |
+      |        | git push                                                                                            |
+      |        | git update-ref refs/heads/gamma {{ sha 'Merge branch 'main' into gamma' }} {{ sha 'gamma commit' }} |
+      |        | git push origin gamma                                                                               |
+      |        | git checkout main                                                                                   |
+      | main   | git push --tags                                                                                     |
+      |        | git stash pop                                                                                       |
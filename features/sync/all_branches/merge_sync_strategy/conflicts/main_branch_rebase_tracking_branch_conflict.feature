@@ -57,16 +57,13 @@ Feature: handle rebase conflicts between main branch and its tracking branch
     When I resolve the conflict in "conflicting_file"
     And I run "git-town continue" and close the editor
     Then it runs the commands
-      | BRANCH  | COMMAND                                 |
-      | main    | git rebase --continue                   |
-      |         | git push                                |
-      |         | git checkout feature                    |
-      | feature | git merge --no-edit --ff origin/feature |
-      |         | git merge --no-edit --ff main           |
-      |         | git push                                |
-      |         | git checkout main                       |
-      | main    | git push --tags                         |
-      |         | git stash pop                           |
+      | BRANCH | COMMAND                                                                                                   |
+      | main   | git rebase --continue                                                                                     |
+      |        | git push                                                                                                  |
+      |        | git update-ref refs/heads/feature {{ sha 'Merge branch 'main' into feature' }} {{ sha 'feature commit' }} |
+      |        | git push origin feature                                                                                   |
+      |        | git push --tags                                                                                           |
+      |        | git stash pop                                                                                             |
     And all branches are now synchronized
     And the current branch is now "main"
     And the uncommitted file still exists
@@ -82,12 +79,9 @@ Feature: handle rebase conflicts between main branch and its tracking branch
     And I run "git rebase --continue" and close the editor
     And I run "git-town continue"
     Then it runs the commands
-      | BRANCH  | COMMAND                                 |
-      | main    | git push                                |
-      |         | git checkout feature                    |
-      | feature | git merge --no-edit --ff origin/feature |
-      |         | git merge --no-edit --ff main           |
-      |         | git push                                |
-      |         | git checkout main                       |
-      | main    | git push --tags                         |
-      |         | git stash pop                           |
+      | BRANCH | COMMAND                                                                                                   |
+      | main   | git push                                                                                                  |
+      |        | git update-ref refs/heads/feature {{ sha 'Merge branch 'main' into feature' }} {{ sha 'feature commit' }} |
+      |        | git push origin feature                                                                                   |
+      |        | git push --tags                                                                                           |
+      |        | git stash pop                                                                                             |
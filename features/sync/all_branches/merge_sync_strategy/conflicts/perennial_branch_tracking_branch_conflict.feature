@@ -25,8 +25,6 @@ Feature: handle rebase conflicts between perennial branch and its tracking branc
       | main   | git fetch --prune --tags |
       |        | git add -A               |
       |        | git stash                |
-      |        | git checkout alpha       |
-      | alpha  | git rebase origin/alpha  |
       |        | git checkout beta        |
       | beta   | git rebase origin/beta   |
     And it prints the error:
@@ -57,14 +55,12 @@ Feature: handle rebase conflicts between perennial branch and its tracking branc
   Scenario: skip
     When I run "git-town skip"
     Then it runs the commands
-      | BRANCH | COMMAND                 |
-      | beta   | git rebase --abort      |
-      |        | git checkout gamma      |
-      | gamma  | git rebase origin/gamma |
-      |        | git checkout main       |
-      | main   | git rebase origin/main  |
-      |        | git push --tags         |
-      |        | git stash pop           |
+      | BRANCH | COMMAND                |
+      | beta   | git rebase --abort     |
+      |        | git checkout main      |
+      | main   | git rebase origin/main |
+      |        | git push --tags        |
+      |        | git stash pop          |
     And the current branch is now "main"
     And the uncommitted file still exists
     And these commits exist now
@@ -89,15 +85,13 @@ Feature: handle rebase conflicts between perennial branch and its tracking branc
     When I resolve the conflict in "conflicting_file"
     And I run "git-town continue" and close the editor
     Then it runs the commands
-      | BRANCH | COMMAND                 |
-      | beta   | git rebase --continue   |
-      |        | git push                |
-      |        | git checkout gamma      |
-      | gamma  | git rebase origin/gamma |
-      |        | git checkout main       |
-      | main   | git rebase origin/main  |
-      |        | git push --tags         |
-      |        | git stash pop           |
+      | BRANCH | COMMAND                |
+      | beta   | git rebase --continue  |
+      |        | git push               |
+      |        | git checkout main      |
+      | main   | git rebase origin/main |
+      |        | git push --tags        |
+      |        | git stash pop          |
     And all branches are now synchronized
     And the current branch is now "main"
     And the uncommitted file still exists
@@ -108,11 +102,9 @@ Feature: handle rebase conflicts between perennial branch and its tracking branc
     And I run "git rebase --continue" and close the editor
     And I run "git-town continue"
     Then it runs the commands
-      | BRANCH | COMMAND                 |
-      | beta   | git push                |
-      |        | git checkout gamma      |
-      | gamma  | git rebase origin/gamma |
-      |        | git checkout main       |
-      | main   | git rebase origin/main  |
-      |        | git push --tags         |
-      |        | git stash pop           |
+      | BRANCH | COMMAND                |
+      | beta   | git push               |
+      |        | git checkout main      |
+      | main   | git rebase origin/main |
+      |        | git push --tags        |
+      |        | git stash pop          |
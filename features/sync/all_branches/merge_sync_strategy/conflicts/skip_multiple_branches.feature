@@ -0,0 +1,57 @@
+Feature: skip several conflicting branches while syncing all branches
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+      | beta  | feature | main   | local, origin |
+      | delta | feature | main   | local, origin |
+      | gamma | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME        | FILE CONTENT  |
+      | main   | origin        | main commit  | conflicting_file | main content  |
+      | alpha  | local, origin | alpha commit | alpha_file       | alpha content |
+      | beta   | local, origin | beta commit  | conflicting_file | beta content  |
+      | delta  | local, origin | delta commit | delta_file       | delta content |
+      | gamma  | local, origin | gamma commit | conflicting_file | gamma content |
+    And the current branch is "main"
+    When I run "git-town sync --all"
+    And I run "git-town skip"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                                             |
+      | beta   | git merge --abort                                                                                   |
+      |        | git update-ref refs/heads/delta {{ sha 'Merge branch 'main' into delta' }} {{ sha 'delta commit' }} |
+      |        | git push origin delta                                                                               |
+      |        | git checkout gamma                                                                                  |
+      | gamma  | git merge --no-edit --ff origin/gamma                                                               |
+      |        | git merge --no-edit --ff main                                                                       |
+    And it prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in conflicting_file
+      """
+    And the current branch is now "gamma"
+    And a merge is now in progress
+
+  Scenario: skip again
+    When I run "git-town skip"
+    Then it runs the commands
+      | BRANCH | COMMAND           |
+      | gamma  | git merge --abort |
+      |        | git checkout main |
+      | main   | git push --tags   |
+    And the current branch is now "main"
+    And no merge is in progress
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                        |
+      | main   | local, origin | main commit                    |
+      | alpha  | local, origin | alpha commit                   |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into alpha |
+      | beta   | local, origin | beta commit                    |
+      | delta  | local, origin | delta commit                   |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into delta |
+      | gamma  | local, origin | gamma commit                   |
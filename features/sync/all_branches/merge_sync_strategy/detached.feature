@@ -35,9 +35,6 @@ Feature: sync all feature branches
       | alpha        | git fetch --prune --tags              |
       |              | git merge --no-edit --ff origin/alpha |
       |              | git merge --no-edit --ff main         |
-      |              | git checkout beta                     |
-      | beta         | git merge --no-edit --ff origin/beta  |
-      |              | git merge --no-edit --ff main         |
       |              | git checkout contribution             |
       | contribution | git rebase origin/contribution        |
       |              | git push                              |
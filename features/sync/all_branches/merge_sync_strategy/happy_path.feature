@@ -28,28 +28,24 @@ Feature: sync all feature branches
   Scenario: with "merge" sync-feature strategy
     When I run "git-town sync --all"
     Then it runs the commands
-      | BRANCH     | COMMAND                               |
-      | alpha      | git fetch --prune --tags              |
-      |            | git checkout main                     |
-      | main       | git rebase origin/main                |
-      |            | git checkout alpha                    |
-      | alpha      | git merge --no-edit --ff origin/alpha |
-      |            | git merge --no-edit --ff main         |
-      |            | git push                              |
-      |            | git checkout beta                     |
-      | beta       | git merge --no-edit --ff origin/beta  |
-      |            | git merge --no-edit --ff main         |
-      |            | git push                              |
-      |            | git checkout observed                 |
-      | observed   | git rebase origin/observed            |
-      |            | git checkout production               |
-      | production | git rebase origin/production          |
-      |            | git push                              |
-      |            | git checkout qa                       |
-      | qa         | git rebase origin/qa                  |
-      |            | git push                              |
-      |            | git checkout alpha                    |
-      | alpha      | git push --tags                       |
+      | BRANCH     | COMMAND                                                                                          |
+      | alpha      | git fetch --prune --tags                                                                         |
+      |            | git update-ref refs/heads/main {{ sha 'main commit' }} {{ sha 'initial commit' }}                |
+      |            | git merge --no-edit --ff origin/alpha                                                            |
+      |            | git merge --no-edit --ff main                                                                    |
+      |            | git push                                                                                         |
+      |            | git update-ref refs/heads/beta {{ sha 'Merge branch 'main' into beta' }} {{ sha 'beta commit' }} |
+      |            | git push origin beta                                                                             |
+      |            | git checkout observed                                                                            |
+      | observed   | git rebase origin/observed                                                                       |
+      |            | git checkout production                                                                          |
+      | production | git rebase origin/production                                                                     |
+      |            | git push                                                                                         |
+      |            | git checkout qa                                                                                  |
+      | qa         | git rebase origin/qa                                                                             |
+      |            | git push                                                                                         |
+      |            | git checkout alpha                                                                               |
+      | alpha      | git push --tags                                                                                  |
     And the current branch is still "alpha"
     And these commits exist now
       | BRANCH     | LOCATION      | MESSAGE                        |
@@ -73,26 +69,24 @@ Feature: sync all feature branches
     Given Git Town setting "sync-feature-strategy" is "rebase"
     When I run "git-town sync --all"
     Then it runs the commands
-      | BRANCH     | COMMAND                                         |
-      | alpha      | git fetch --prune --tags                        |
-      |            | git checkout main                               |
-      | main       | git rebase origin/main                          |
-      |            | git checkout alpha                              |
-      | alpha      | git rebase main                                 |
-      |            | git push --force-with-lease --force-if-includes |
-      |            | git checkout beta                               |
-      | beta       | git rebase main                                 |
-      |            | git push --force-with-lease --force-if-includes |
-      |            | git checkout observed                           |
-      | observed   | git rebase origin/observed                      |
-      |            | git checkout production                         |
-      | production | git rebase origin/production                    |
-      |            | git push                                        |
-      |            | git checkout qa                                 |
-      | qa         | git rebase origin/qa                            |
-      |            | git push                                        |
-      |            | git checkout alpha                              |
-      | alpha      | git push --tags                                 |
+      | BRANCH     | COMMAND                                                                           |
+      | alpha      | git fetch --prune --tags                                                          |
+      |            | git update-ref refs/heads/main {{ sha 'main commit' }} {{ sha 'initial commit' }} |
+      |            | git rebase main                                                                   |
+      |            | git push --force-with-lease --force-if-includes                                   |
+      |            | git checkout beta                                                                 |
+      | beta       | git rebase main                                                                   |
+      |            | git push --force-with-lease --force-if-includes                                   |
+      |            | git checkout observed                                                             |
+      | observed   | git rebase origin/observed                                                        |
+      |            | git checkout production                                                           |
+      | production | git rebase origin/production                                                      |
+      |            | git push                                                                          |
+      |            | git checkout qa                                                                   |
+      | qa         | git rebase origin/qa                                                              |
+      |            | git push                                                                          |
+      |            | git checkout alpha                                                                |
+      | alpha      | git push --tags                                                                   |
     And the current branch is still "alpha"
     And these commits exist now
       | BRANCH     | LOCATION      | MESSAGE                  |
@@ -16,10 +16,8 @@ Feature: syncs all feature branches (in a local repo)
 
   Scenario: result
     Then it runs the commands
-      | BRANCH | COMMAND                       |
-      | alpha  | git merge --no-edit --ff main |
-      |        | git checkout beta             |
-      | beta   | git merge --no-edit --ff main |
-      |        | git checkout alpha            |
+      | BRANCH | COMMAND                                                                                          |
+      | alpha  | git merge --no-edit --ff main                                                                    |
+      |        | git update-ref refs/heads/beta {{ sha 'Merge branch 'main' into beta' }} {{ sha 'beta commit' }} |
     And the current branch is still "alpha"
     And all branches are now synchronized
@@ -0,0 +1,41 @@
+Feature: sync all branches in a repo with merge hooks
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+      | beta  | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | origin        | main commit  |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | beta commit  |
+    And the current branch is "alpha"
+    And Git hook "commit-msg" exists
+    When I run "git-town sync --all"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                           |
+      | alpha  | git fetch --prune --tags                                                          |
+      |        | git update-ref refs/heads/main {{ sha 'main commit' }} {{ sha 'initial commit' }} |
+      |        | git merge --no-edit --ff origin/alpha                                             |
+      |        | git merge --no-edit --ff main                                                     |
+      |        | git push                                                                          |
+      |        | git checkout beta                                                                 |
+      | beta   | git merge --no-edit --ff origin/beta                                              |
+      |        | git merge --no-edit --ff main                                                     |
+      |        | git push                                                                          |
+      |        | git checkout alpha                                                                |
+      | alpha  | git push --tags                                                                   |
+    And the current branch is still "alpha"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                        |
+      | main   | local, origin | main commit                    |
+      | alpha  | local, origin | alpha commit                   |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into alpha |
+      | beta   | local, origin | beta commit                    |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into beta  |
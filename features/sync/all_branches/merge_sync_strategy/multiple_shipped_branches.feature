@@ -19,23 +19,22 @@ Feature: multiple shipped branches
 
   Scenario: result
     Then it runs the commands
-      | BRANCH    | COMMAND                                   |
-      | feature-3 | git fetch --prune --tags                  |
-      |           | git checkout main                         |
-      | main      | git rebase origin/main                    |
-      |           | git checkout feature-1                    |
-      | feature-1 | git merge --no-edit --ff main             |
-      |           | git checkout main                         |
-      | main      | git branch -D feature-1                   |
-      |           | git checkout feature-2                    |
-      | feature-2 | git merge --no-edit --ff main             |
-      |           | git checkout main                         |
-      | main      | git branch -D feature-2                   |
-      |           | git checkout feature-3                    |
-      | feature-3 | git merge --no-edit --ff origin/feature-3 |
-      |           | git merge --no-edit --ff main             |
-      |           | git push                                  |
-      |           | git push --tags                           |
+      | BRANCH    | COMMAND                                                                                          |
+      | feature-3 | git fetch --prune --tags                                                                         |
+      |           | git update-ref refs/heads/main {{ sha-in-origin 'feature-2 commit' }} {{ sha 'initial commit' }} |
+      |           | git checkout feature-1                                                                           |
+      | feature-1 | git merge --no-edit --ff main                                                                    |
+      |           | git checkout main                                                                                |
+      | main      | git branch -D feature-1                                                                          |
+      |           | git checkout feature-2                                                                           |
+      | feature-2 | git merge --no-edit --ff main                                                                    |
+      |           | git checkout main                                                                                |
+      | main      | git branch -D feature-2                                                                          |
+      |           | git checkout feature-3                                                                           |
+      | feature-3 | git merge --no-edit --ff origin/feature-3                                                        |
+      |           | git merge --no-edit --ff main                                                                    |
+      |           | git push                                                                                         |
+      |           | git push --tags                                                                                  |
     And it prints:
       """
       deleted branch "feature-1"
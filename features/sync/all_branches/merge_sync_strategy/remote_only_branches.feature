@@ -16,15 +16,12 @@ Feature: does not sync branches that exist only on remotes
 
   Scenario: result
     Then it runs the commands
-      | BRANCH | COMMAND                              |
-      | main   | git fetch --prune --tags             |
-      |        | git rebase origin/main               |
-      |        | git checkout mine                    |
-      | mine   | git merge --no-edit --ff origin/mine |
-      |        | git merge --no-edit --ff main        |
-      |        | git push                             |
-      |        | git checkout main                    |
-      | main   | git push --tags                      |
+      | BRANCH | COMMAND                                                                                        |
+      | main   | git fetch --prune --tags                                                                       |
+      |        | git rebase origin/main                                                                         |
+      |        | git update-ref refs/heads/mine {{ sha 'Merge branch 'main' into mine' }} {{ sha 'my commit' }} |
+      |        | git push origin mine                                                                           |
+      |        | git push --tags                                                                                |
     And the current branch is still "main"
     And all branches are now synchronized
     And these commits exist now
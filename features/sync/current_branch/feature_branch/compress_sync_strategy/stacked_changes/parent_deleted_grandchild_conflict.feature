@@ -20,11 +20,8 @@ Feature: syncing a grandchild branch with conflicts using the "compress" strateg
     Then it runs the commands
       | BRANCH     | COMMAND                                    |
       | child      | git fetch --prune --tags                   |
-      |            | git checkout main                          |
-      | main       | git rebase origin/main                     |
-      |            | git push                                   |
-      |            | git checkout child                         |
-      | child      | git merge --no-edit --ff main              |
+      |            | git push origin main                       |
+      |            | git merge --no-edit --ff main              |
       |            | git checkout main                          |
       | main       | git branch -D child                        |
       |            | git checkout grandchild                    |
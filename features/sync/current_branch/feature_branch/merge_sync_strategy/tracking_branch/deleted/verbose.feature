@@ -22,12 +22,16 @@ Feature: display all executed Git commands
       |        | backend  | git config -lz --includes --local             |
       |        | backend  | git status --long --ignore-submodules         |
       |        | backend  | git remote                                    |
-      |        | backend  | git rev-parse --abbrev-ref HEAD               |
+      |        | backend  | git branch --show-current                     |
       | old    | frontend | git fetch --prune --tags                      |
       |        | backend  | git stash list                                |
       |        | backend  | git branch -vva --sort=refname                |
       |        | backend  | git rev-parse --verify --abbrev-ref @{-1}     |
       |        | backend  | git log main..old --format=%h                 |
+      |        | backend  | git remote get-url origin                     |
+      |        | backend  | git rev-parse --short main                    |
+      |        | backend  | git rev-parse --short origin/main             |
+      |        | backend  | git rev-parse --short old                     |
       | old    | frontend | git checkout main                             |
       | main   | frontend | git rebase origin/main                        |
       |        | backend  | git rev-list --left-right main...origin/main  |
@@ -45,7 +49,7 @@ Feature: display all executed Git commands
       |        | backend  | git stash list                                |
     And it prints:
       """
-      Ran 27 shell commands.
+      Ran 31 shell commands.
       """
     And the current branch is now "main"
     And the branches are now
@@ -0,0 +1,63 @@
+Feature: sync a branch whose proposal was merged while its tracking branch still exists
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME      | TYPE    | PARENT | LOCATIONS     |
+      | feature-1 | feature | main   | local, origin |
+      | feature-2 | feature | main   | local, origin |
+    And the commits
+      | BRANCH    | LOCATION      | MESSAGE          |
+      | feature-1 | local, origin | feature-1 commit |
+      | feature-2 | local, origin | feature-2 commit |
+    And the current branch is "feature-2"
+    And Git Town setting "github-token" is "token"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the proposals
+      | FROM      | TO   | TITLE              |
+      | feature-1 | main | feature-1 proposal |
+    And the proposal of branch "feature-1" gets merged online
+    When I run "git-town sync --all"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH    | COMMAND                                                                                       |
+      | feature-2 | git fetch --prune --tags                                                                      |
+      | <none>    | looking for merged proposal online ... ok                                                     |
+      |           | looking for merged proposal online ... ok                                                     |
+      | feature-2 | git update-ref refs/heads/main {{ sha 'feature-1 proposal (#1)' }} {{ sha 'initial commit' }} |
+      |           | git push origin :feature-1                                                                    |
+      |           | git checkout feature-1                                                                        |
+      | feature-1 | git merge --no-edit --ff main                                                                 |
+      |           | git checkout main                                                                             |
+      | main      | git branch -D feature-1                                                                       |
+      |           | git checkout feature-2                                                                        |
+      | feature-2 | git merge --no-edit --ff origin/feature-2                                                     |
+      |           | git merge --no-edit --ff main                                                                 |
+      |           | git push                                                                                      |
+      |           | git push --tags                                                                               |
+    And it prints:
+      """
+      deleted branch "feature-1"
+      """
+    And the current branch is still "feature-2"
+    And the branches are now
+      | REPOSITORY    | BRANCHES        |
+      | local, origin | main, feature-2 |
+    And this lineage exists now
+      | BRANCH    | PARENT |
+      | feature-2 | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH    | COMMAND                                           |
+      | feature-2 | git reset --hard {{ sha 'feature-2 commit' }}     |
+      |           | git push --force-with-lease --force-if-includes   |
+      |           | git branch feature-1 {{ sha 'feature-1 commit' }} |
+      |           | git push -u origin feature-1                      |
+      |           | git checkout main                                 |
+      | main      | git reset --hard {{ sha 'initial commit' }}       |
+      |           | git checkout feature-2                            |
+    And the current branch is still "feature-2"
+    And the initial branches and lineage exist
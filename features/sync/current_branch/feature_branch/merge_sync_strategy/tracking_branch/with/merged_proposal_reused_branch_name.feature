@@ -0,0 +1,45 @@
+Feature: sync a branch whose name was used by a proposal that got merged earlier
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE      |
+      | feature | local, origin | first change |
+    And the current branch is "feature"
+    And Git Town setting "github-token" is "token"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the proposals
+      | FROM    | TO   | TITLE        |
+      | feature | main | first change |
+    And the proposal of branch "feature" gets merged online
+    And I ran "git reset --hard main"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE       |
+      | feature | local    | second change |
+    And I ran "git push --force"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                   |
+      | feature | git fetch --prune --tags                  |
+      | <none>  | looking for merged proposal online ... ok |
+      | feature | git checkout main                         |
+      | main    | git rebase origin/main                    |
+      |         | git checkout feature                      |
+      | feature | git merge --no-edit --ff origin/feature   |
+      |         | git merge --no-edit --ff main             |
+      |         | git push                                  |
+    And the current branch is still "feature"
+    And the branches are now
+      | REPOSITORY    | BRANCHES      |
+      | local, origin | main, feature |
+    And this lineage exists now
+      | BRANCH  | PARENT |
+      | feature | main   |
+    And the proposals are now
+      | FROM    | TO   | STATE  |
+      | feature | main | merged |
@@ -19,11 +19,8 @@ Feature: a grandchild branch has conflicts while its parent was deleted remotely
     Then it runs the commands
       | BRANCH     | COMMAND                  |
       | child      | git fetch --prune --tags |
-      |            | git checkout main        |
-      | main       | git rebase origin/main   |
-      |            | git push                 |
-      |            | git checkout child       |
-      | child      | git rebase main          |
+      |            | git push origin main     |
+      |            | git rebase main          |
       |            | git checkout main        |
       | main       | git branch -D child      |
       |            | git checkout grandchild  |
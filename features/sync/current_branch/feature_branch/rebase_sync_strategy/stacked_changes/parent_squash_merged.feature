@@ -0,0 +1,48 @@
+Feature: sync a branch whose parent was shipped using a squash-merge
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME   | TYPE    | PARENT | LOCATIONS     |
+      | parent | feature | main   | local, origin |
+      | child  | feature | parent | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE         | FILE NAME  | FILE CONTENT     |
+      | parent | local, origin | parent commit 1 | file       | parent content 1 |
+      | parent | local, origin | parent commit 2 | file       | parent content 2 |
+      | child  | local, origin | child commit    | child_file | child content    |
+    And Git Town setting "sync-feature-strategy" is "rebase"
+    And Git Town setting "ship-strategy" is "squash-merge"
+    And the current branch is "child"
+    And I ran "git-town sync"
+    And I ran "git-town ship parent -m 'parent done'"
+    And the current branch is "child"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                            |
+      | child  | git fetch --prune --tags                           |
+      |        | git checkout main                                  |
+      | main   | git rebase origin/main                             |
+      |        | git checkout child                                 |
+      | child  | git rebase --onto main {{ sha 'parent commit 2' }} |
+      |        | git push --force-with-lease --force-if-includes    |
+    And it does not print "Syncing will run into conflicts"
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | local, origin | parent done  |
+      | child  | local, origin | parent done  |
+      |        |               | child commit |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | child  | git reset --hard {{ sha 'child commit' }}       |
+      |        | git push --force-with-lease --force-if-includes |
+    And the current branch is still "child"
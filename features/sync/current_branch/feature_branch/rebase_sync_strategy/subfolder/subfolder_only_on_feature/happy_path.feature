@@ -22,10 +22,7 @@ Feature: sync inside a folder that doesn't exist on the main branch
       | alpha  | git fetch --prune --tags                        |
       |        | git add -A                                      |
       |        | git stash                                       |
-      |        | git checkout main                               |
-      | main   | git rebase origin/main                          |
-      |        | git checkout alpha                              |
-      | alpha  | git rebase main                                 |
+      |        | git rebase main                                 |
       |        | git push --force-with-lease --force-if-includes |
       |        | git checkout beta                               |
       | beta   | git rebase main                                 |
@@ -23,12 +23,16 @@ Feature: display all executed Git commands
       |          | backend  | git config -lz --includes --local                  |
       |          | backend  | git status --long --ignore-submodules              |
       |          | backend  | git remote                                         |
-      |          | backend  | git rev-parse --abbrev-ref HEAD                    |
+      |          | backend  | git branch --show-current                          |
       | branch-2 | frontend | git fetch --prune --tags                           |
       |          | backend  | git stash list                                     |
       |          | backend  | git branch -vva --sort=refname                     |
       |          | backend  | git rev-parse --verify --abbrev-ref @{-1}          |
       |          | backend  | git log main..branch-2 --format=%h                 |
+      |          | backend  | git remote get-url origin                          |
+      |          | backend  | git rev-parse --short main                         |
+      |          | backend  | git rev-parse --short origin/main                  |
+      |          | backend  | git rev-parse --short branch-2                     |
       | branch-2 | frontend | git checkout main                                  |
       | main     | frontend | git rebase origin/main                             |
       |          | backend  | git rev-list --left-right main...origin/main       |
@@ -46,7 +50,7 @@ Feature: display all executed Git commands
       |          | backend  | git stash list                                     |
     And it prints:
       """
-      Ran 27 shell commands.
+      Ran 31 shell commands.
       """
     And the current branch is now "main"
     And the branches are now
@@ -0,0 +1,64 @@
+Feature: sync branches whose names match a sync strategy override in the config file
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [branches.overrides]
+      "shared-.*" = { sync-strategy = "merge" }
+
+      [sync-strategy]
+      feature-branches = "rebase"
+      """
+    And the branches
+      | NAME     | TYPE    | PARENT | LOCATIONS     |
+      | feature  | feature | main   | local, origin |
+      | shared-1 | feature | main   | local, origin |
+    And the commits
+      | BRANCH   | LOCATION | MESSAGE               |
+      | main     | origin   | origin main commit    |
+      | feature  | local    | local feature commit  |
+      | shared-1 | local    | local shared-1 commit |
+    And the current branch is "main"
+    When I run "git-town sync --all"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                                                            |
+      | main    | git fetch --prune --tags                                                                                           |
+      |         | git rebase origin/main                                                                                             |
+      |         | git checkout feature                                                                                               |
+      | feature | git rebase main                                                                                                    |
+      |         | git push --force-with-lease --force-if-includes                                                                    |
+      |         | git update-ref refs/heads/shared-1 {{ sha 'Merge branch 'main' into shared-1' }} {{ sha 'local shared-1 commit' }} |
+      |         | git push origin shared-1                                                                                           |
+      |         | git checkout main                                                                                                  |
+      | main    | git push --tags                                                                                                    |
+    And all branches are now synchronized
+    And the current branch is still "main"
+    And these commits exist now
+      | BRANCH   | LOCATION      | MESSAGE                           |
+      | main     | local, origin | origin main commit                |
+      | feature  | local, origin | origin main commit                |
+      |          |               | local feature commit              |
+      | shared-1 | local, origin | local shared-1 commit             |
+      |          |               | origin main commit                |
+      |          |               | Merge branch 'main' into shared-1 |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH   | COMMAND                                                                       |
+      | main     | git checkout feature                                                          |
+      | feature  | git reset --hard {{ sha 'local feature commit' }}                             |
+      |          | git push --force-with-lease origin {{ sha 'persisted config file' }}:feature  |
+      |          | git checkout shared-1                                                         |
+      | shared-1 | git reset --hard {{ sha 'local shared-1 commit' }}                            |
+      |          | git push --force-with-lease origin {{ sha 'persisted config file' }}:shared-1 |
+      |          | git checkout main                                                             |
+      | main     | git reset --hard {{ sha 'persisted config file' }}                            |
+    And the current branch is still "main"
+    And the initial branches and lineage exist
@@ -0,0 +1,31 @@
+Feature: sync a branch that overrides the sync strategy and was deleted at the remote
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | main    | origin        | main commit    |
+      | feature | local, origin | feature commit |
+    And origin deletes the "feature" branch
+    And the current branch is "feature"
+    And Git Town setting "sync-feature-strategy" is "rebase"
+    And Git Town sync-strategy setting for branch "feature" is "merge"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                       |
+      | feature | git fetch --prune --tags      |
+      |         | git checkout main             |
+      | main    | git rebase origin/main        |
+      |         | git checkout feature          |
+      | feature | git merge --no-edit --ff main |
+    And it prints:
+      """
+      Branch "feature" was deleted at the remote but the local branch contains unshipped changes.
+      """
+    And the current branch is still "feature"
+    And Git Town sync-strategy setting for branch "feature" is still "merge"
@@ -0,0 +1,50 @@
+Feature: sync a feature branch that overrides the sync strategy in the Git metadata
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE              |
+      | main    | origin   | origin main commit   |
+      | feature | local    | local feature commit |
+    And Git Town setting "sync-feature-strategy" is "rebase"
+    And Git Town sync-strategy setting for branch "feature" is "merge"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                 |
+      | feature | git fetch --prune --tags                |
+      |         | git checkout main                       |
+      | main    | git rebase origin/main                  |
+      |         | git checkout feature                    |
+      | feature | git merge --no-edit --ff origin/feature |
+      |         | git merge --no-edit --ff main           |
+      |         | git push                                |
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE                          |
+      | main    | local, origin | origin main commit               |
+      | feature | local, origin | local feature commit             |
+      |         |               | origin main commit               |
+      |         |               | Merge branch 'main' into feature |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                               |
+      | feature | git reset --hard {{ sha 'local feature commit' }}                     |
+      |         | git push --force-with-lease origin {{ sha 'initial commit' }}:feature |
+      |         | git checkout main                                                     |
+      | main    | git reset --hard {{ sha 'initial commit' }}                           |
+      |         | git checkout feature                                                  |
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION | MESSAGE              |
+      | main    | origin   | origin main commit   |
+      | feature | local    | local feature commit |
+    And the initial branches and lineage exist
@@ -0,0 +1,107 @@
+Feature: preview the conflicts that syncing would run into
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+      | beta  | feature | alpha  | local, origin |
+      | gamma | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | main   | local, origin | main commit  | file       | main content  |
+      | alpha  | local, origin | alpha commit | file       | alpha content |
+      | beta   | local, origin | beta commit  | beta_file  | beta content  |
+      | gamma  | local, origin | gamma commit | gamma_file | gamma content |
+
+  Scenario: check a branch that would run into conflicts
+    Given the current branch is "beta"
+    When I run "git-town sync --check"
+    Then it runs no commands
+    And it prints something like:
+      """
+      Syncing will run into conflicts:
+        branch "alpha" conflicts with "main" in file
+      """
+    And it prints the error:
+      """
+      syncing would run into conflicts
+      """
+    And the current branch is still "beta"
+    And the initial commits exist
+    And the initial branches and lineage exist
+
+  Scenario: check a branch that syncs without conflicts
+    Given the current branch is "gamma"
+    When I run "git-town sync --check"
+    Then it runs no commands
+    And it prints:
+      """
+      Syncing runs into no conflicts.
+      """
+    And the current branch is still "gamma"
+    And the initial commits exist
+    And the initial branches and lineage exist
+
+  Scenario: sync previews the conflicts before changing anything
+    Given the current branch is "beta"
+    When I run "git-town sync"
+    Then it prints something like:
+      """
+      Syncing will run into conflicts:
+        branch "alpha" conflicts with "main" in file
+      """
+    And it runs the commands
+      | BRANCH | COMMAND                               |
+      | beta   | git fetch --prune --tags              |
+      |        | git checkout main                     |
+      | main   | git rebase origin/main                |
+      |        | git checkout alpha                    |
+      | alpha  | git merge --no-edit --ff origin/alpha |
+      |        | git merge --no-edit --ff main         |
+    And it prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in file
+      """
+
+  Scenario: Git version without merge-tree support
+    Given the current branch is "beta"
+    And Git has version "2.37.0"
+    When I run "git-town sync --check"
+    Then it runs no commands
+    And it prints the error:
+      """
+      sync --check needs Git 2.38 or higher to simulate merges, you have Git 2.37
+      """
+    And the current branch is still "beta"
+    And the initial commits exist
+    And the initial branches and lineage exist
+
+  Scenario: sync with a Git version without merge-tree support
+    Given the current branch is "gamma"
+    And Git has version "2.37.0"
+    When I run "git-town sync"
+    Then it does not print "Syncing will run into conflicts"
+    And it runs the commands
+      | BRANCH | COMMAND                               |
+      | gamma  | git fetch --prune --tags              |
+      |        | git checkout main                     |
+      | main   | git rebase origin/main                |
+      |        | git checkout gamma                    |
+      | gamma  | git merge --no-edit --ff origin/gamma |
+      |        | git merge --no-edit --ff main         |
+      |        | git push                              |
+
+  Scenario: check a branch without lineage
+    Given the branches
+      | NAME    | TYPE   | LOCATIONS |
+      | unknown | (none) | local     |
+    And the current branch is "unknown"
+    When I run "git-town sync --check"
+    Then it runs no commands
+    And it prints:
+      """
+      Syncing runs into no conflicts.
+      """
+    And the current branch is still "unknown"
+    And the initial branches and lineage exist
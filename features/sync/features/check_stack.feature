@@ -0,0 +1,30 @@
+Feature: preview the conflicts that syncing a stack would run into
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+      | beta  | feature | alpha  | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | main   | local, origin | main commit  | file       | main content  |
+      | alpha  | local, origin | alpha commit | alpha_file | alpha content |
+      | beta   | local, origin | beta commit  | file       | beta content  |
+    And the current branch is "beta"
+    When I run "git-town sync --check"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints something like:
+      """
+      Syncing will run into conflicts:
+        branch "beta" conflicts with "alpha" in file
+      """
+    And it prints the error:
+      """
+      syncing would run into conflicts
+      """
+    And the current branch is still "beta"
+    And the initial commits exist
+    And the initial branches and lineage exist
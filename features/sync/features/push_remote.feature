@@ -0,0 +1,58 @@
+Feature: push new branches to a configured push remote
+
+  Background:
+    Given a Git repo with origin
+    And an upstream repo
+    And Git Town setting "push-remote" is "upstream"
+    And Git Town setting "sync-upstream" is "false"
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS |
+      | feature | feature | main   | local     |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE      |
+      | feature | local    | local commit |
+    And the current branch is "feature"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                             |
+      | feature | git fetch --prune --tags --multiple origin upstream |
+      |         | git checkout main                                   |
+      | main    | git rebase origin/main                              |
+      |         | git checkout feature                                |
+      | feature | git merge --no-edit --ff main                       |
+      |         | git push -u upstream feature                        |
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION        | MESSAGE      |
+      | feature | local, upstream | local commit |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                    |
+      | feature | git push upstream :feature |
+    And the current branch is still "feature"
+    And the initial commits exist
+    And the initial branches and lineage exist
+
+  Scenario: sync again
+    Given the commits
+      | BRANCH  | LOCATION | MESSAGE       |
+      | feature | local    | second commit |
+    When I run "git-town sync"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                             |
+      | feature | git fetch --prune --tags --multiple origin upstream |
+      |         | git checkout main                                   |
+      | main    | git rebase origin/main                              |
+      |         | git checkout feature                                |
+      | feature | git merge --no-edit --ff upstream/feature           |
+      |         | git merge --no-edit --ff main                       |
+      |         | git push                                            |
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION        | MESSAGE       |
+      | feature | local, upstream | local commit  |
+      |         |                 | second commit |
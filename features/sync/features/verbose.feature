@@ -7,46 +7,64 @@ Feature: display all executed Git commands
       | feature | feature | main   | local, origin |
     And the current branch is "feature"
     And the commits
-      | BRANCH  | LOCATION | MESSAGE               |
-      | main    | local    | local main commit     |
-      |         | origin   | origin main commit    |
-      | feature | local    | local feature commit  |
-      |         | origin   | origin feature commit |
+      | BRANCH  | LOCATION | MESSAGE               | FILE NAME           |
+      | main    | local    | local main commit     | local_main_file     |
+      |         | origin   | origin main commit    | origin_main_file    |
+      | feature | local    | local feature commit  | local_feature_file  |
+      |         | origin   | origin feature commit | origin_feature_file |
 
   Scenario: result
     When I run "git-town sync --verbose"
     Then it runs the commands
-      | BRANCH  | TYPE     | COMMAND                                                   |
-      |         | backend  | git version                                               |
-      |         | backend  | git rev-parse --show-toplevel                             |
-      |         | backend  | git config -lz --includes --global                        |
-      |         | backend  | git config -lz --includes --local                         |
-      |         | backend  | git status --long --ignore-submodules                     |
-      |         | backend  | git remote                                                |
-      |         | backend  | git rev-parse --abbrev-ref HEAD                           |
-      | feature | frontend | git fetch --prune --tags                                  |
-      |         | backend  | git stash list                                            |
-      |         | backend  | git branch -vva --sort=refname                            |
-      |         | backend  | git rev-parse --verify --abbrev-ref @{-1}                 |
-      |         | backend  | git log main..feature --format=%h                         |
-      |         | backend  | git log --format=%B -n 1 {{ sha 'local feature commit' }} |
-      | feature | frontend | git checkout main                                         |
-      | main    | frontend | git rebase origin/main                                    |
-      |         | backend  | git rev-list --left-right main...origin/main              |
-      | main    | frontend | git push                                                  |
-      |         | frontend | git checkout feature                                      |
-      | feature | frontend | git merge --no-edit --ff origin/feature                   |
-      |         | frontend | git merge --no-edit --ff main                             |
-      |         | backend  | git rev-list --left-right feature...origin/feature        |
-      | feature | frontend | git push                                                  |
-      |         | backend  | git show-ref --verify --quiet refs/heads/feature          |
-      |         | backend  | git show-ref --verify --quiet refs/heads/main             |
-      |         | backend  | git branch -vva --sort=refname                            |
-      |         | backend  | git config -lz --includes --global                        |
-      |         | backend  | git config -lz --includes --local                         |
-      |         | backend  | git stash list                                            |
+      | BRANCH  | TYPE     | COMMAND                                                                                                                                                                                                     |
+      |         | backend  | git version                                                                                                                                                                                                 |
+      |         | backend  | git rev-parse --show-toplevel                                                                                                                                                                               |
+      |         | backend  | git config -lz --includes --global                                                                                                                                                                          |
+      |         | backend  | git config -lz --includes --local                                                                                                                                                                           |
+      |         | backend  | git status --long --ignore-submodules                                                                                                                                                                       |
+      |         | backend  | git remote                                                                                                                                                                                                  |
+      |         | backend  | git branch --show-current                                                                                                                                                                                   |
+      | feature | frontend | git fetch --prune --tags                                                                                                                                                                                    |
+      |         | backend  | git stash list                                                                                                                                                                                              |
+      |         | backend  | git branch -vva --sort=refname                                                                                                                                                                              |
+      |         | backend  | git rev-parse --verify --abbrev-ref @{-1}                                                                                                                                                                   |
+      |         | backend  | git log main..feature --format=%h                                                                                                                                                                           |
+      |         | backend  | git log --format=%B -n 1 {{ sha 'local feature commit' }}                                                                                                                                                   |
+      |         | backend  | git remote get-url origin                                                                                                                                                                                   |
+      |         | backend  | git rev-parse --short main                                                                                                                                                                                  |
+      |         | backend  | git rev-parse --short origin/main                                                                                                                                                                           |
+      |         | backend  | git merge-base --is-ancestor {{ sha-in-origin 'origin main commit' }} {{ sha-before-run 'local main commit' }}                                                                                              |
+      |         | backend  | git merge-base --is-ancestor {{ sha-before-run 'local main commit' }} {{ sha-in-origin 'origin main commit' }}                                                                                              |
+      |         | backend  | git merge-tree --write-tree --name-only --no-messages {{ sha-before-run 'local main commit' }} {{ sha-in-origin 'origin main commit' }}                                                                     |
+      |         | backend  | git rev-parse --short feature                                                                                                                                                                               |
+      |         | backend  | git rev-parse --short origin/feature                                                                                                                                                                        |
+      |         | backend  | git merge-base --is-ancestor {{ sha-in-origin 'origin feature commit' }} {{ sha 'local feature commit' }}                                                                                                   |
+      |         | backend  | git merge-base --is-ancestor {{ sha 'local feature commit' }} {{ sha-in-origin 'origin feature commit' }}                                                                                                   |
+      |         | backend  | git merge-tree --write-tree --name-only --no-messages {{ sha 'local feature commit' }} {{ sha-in-origin 'origin feature commit' }}                                                                          |
+      |         | backend  | git commit-tree --no-gpg-sign 7eecb9294a89ec558faa2ded7fd3ee0d5d04fe60 -p {{ sha 'local feature commit' }} -p {{ sha-in-origin 'origin feature commit' }} -m simulated merge of origin/feature into feature |
+      |         | backend  | git rev-parse --short {{ sha-long 'simulated merge of origin/feature into feature' }}                                                                                                                       |
+      |         | backend  | git commit-tree --no-gpg-sign a59451da91085d4a364a0a8559fc45fcd0219313 -p {{ sha-before-run 'local main commit' }} -p {{ sha-in-origin 'origin main commit' }} -m simulated merge of origin/main into main  |
+      |         | backend  | git rev-parse --short {{ sha-long 'simulated merge of origin/main into main' }}                                                                                                                             |
+      |         | backend  | git merge-base --is-ancestor {{ sha 'simulated merge of origin/main into main' }} {{ sha 'simulated merge of origin/feature into feature' }}                                                                |
+      |         | backend  | git merge-base --is-ancestor {{ sha 'simulated merge of origin/feature into feature' }} {{ sha 'simulated merge of origin/main into main' }}                                                                |
+      |         | backend  | git merge-tree --write-tree --name-only --no-messages {{ sha 'simulated merge of origin/feature into feature' }} {{ sha 'simulated merge of origin/main into main' }}                                       |
+      | feature | frontend | git checkout main                                                                                                                                                                                           |
+      | main    | frontend | git rebase origin/main                                                                                                                                                                                      |
+      |         | backend  | git rev-list --left-right main...origin/main                                                                                                                                                                |
+      | main    | frontend | git push                                                                                                                                                                                                    |
+      |         | frontend | git checkout feature                                                                                                                                                                                        |
+      | feature | frontend | git merge --no-edit --ff origin/feature                                                                                                                                                                     |
+      |         | frontend | git merge --no-edit --ff main                                                                                                                                                                               |
+      |         | backend  | git rev-list --left-right feature...origin/feature                                                                                                                                                          |
+      | feature | frontend | git push                                                                                                                                                                                                    |
+      |         | backend  | git show-ref --verify --quiet refs/heads/feature                                                                                                                                                            |
+      |         | backend  | git show-ref --verify --quiet refs/heads/main                                                                                                                                                               |
+      |         | backend  | git branch -vva --sort=refname                                                                                                                                                                              |
+      |         | backend  | git config -lz --includes --global                                                                                                                                                                          |
+      |         | backend  | git config -lz --includes --local                                                                                                                                                                           |
+      |         | backend  | git stash list                                                                                                                                                                                              |
     And it prints:
       """
-      Ran 28 shell commands.
+      Ran 46 shell commands.
       """
     And all branches are now synchronized
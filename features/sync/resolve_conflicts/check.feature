@@ -0,0 +1,49 @@
+Feature: conflicts that the conflict resolution rules resolve don't show up in the conflict preview
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [[sync.resolve]]
+      paths = [ "*.lock" ]
+      command = "printf regenerated > package.lock"
+      """
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+
+  Scenario: conflicts only in generated files
+    Given the commits
+      | BRANCH  | LOCATION | MESSAGE                    | FILE NAME    | FILE CONTENT    |
+      | main    | local    | conflicting main commit    | package.lock | main content    |
+      | feature | local    | conflicting feature commit | package.lock | feature content |
+    When I run "git-town sync --check"
+    Then it runs no commands
+    And it prints:
+      """
+      Syncing runs into no conflicts.
+      """
+    And the initial branches and lineage exist
+
+  Scenario: conflicts in other files
+    Given the commits
+      | BRANCH  | LOCATION | MESSAGE                      | FILE NAME        | FILE CONTENT    |
+      | main    | local    | conflicting main commit 1    | conflicting_file | main content    |
+      |         | local    | conflicting main commit 2    | package.lock     | main content    |
+      | feature | local    | conflicting feature commit 1 | conflicting_file | feature content |
+      |         | local    | conflicting feature commit 2 | package.lock     | feature content |
+    When I run "git-town sync --check"
+    Then it runs no commands
+    And it prints something like:
+      """
+      branch "feature" conflicts with "main" in conflicting_file, package.lock
+      """
+    And it prints the error:
+      """
+      syncing would run into conflicts
+      """
+    And the initial branches and lineage exist
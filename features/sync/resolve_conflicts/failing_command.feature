@@ -0,0 +1,61 @@
+@skipWindows
+Feature: a failing conflict resolution command requires manual resolution
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [[sync.resolve]]
+      paths = [ "package.lock" ]
+      command = "exit 1"
+      """
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE                    | FILE NAME    | FILE CONTENT    |
+      | main    | local    | conflicting main commit    | package.lock | main content    |
+      | feature | local    | conflicting feature commit | package.lock | feature content |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                 |
+      | feature | git fetch --prune --tags                |
+      |         | git checkout main                       |
+      | main    | git rebase origin/main                  |
+      |         | git push                                |
+      |         | git checkout feature                    |
+      | feature | git merge --no-edit --ff origin/feature |
+      |         | git merge --no-edit --ff main           |
+      | <none>  | sh -c "exit 1"                          |
+    And it prints the error:
+      """
+      cannot resolve the conflicts automatically, running "exit 1" failed: exit status 1
+      """
+    And the current branch is still "feature"
+    And a merge is now in progress
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "package.lock"
+    And I run "git-town continue"
+    Then it runs the commands
+      | BRANCH  | COMMAND              |
+      | feature | git commit --no-edit |
+      |         | git push             |
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And no merge is in progress
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND           |
+      | feature | git merge --abort |
+    And the current branch is still "feature"
+    And no merge is in progress
+    And the initial branches and lineage exist
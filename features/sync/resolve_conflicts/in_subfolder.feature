@@ -0,0 +1,45 @@
+@skipWindows
+Feature: automatically resolve conflicts in generated files while syncing in a subfolder
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [[sync.resolve]]
+      paths = [ "package.lock" ]
+      command = "printf regenerated > package.lock"
+      """
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE                    | FILE NAME      | FILE CONTENT    |
+      | main    | local    | main folder commit         | subfolder/file | folder content  |
+      |         | local    | conflicting main commit    | package.lock   | main content    |
+      | feature | local    | feature folder commit      | subfolder/file | folder content  |
+      |         | local    | conflicting feature commit | package.lock   | feature content |
+    When I run "git-town sync" in the "subfolder" folder
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                   |
+      | feature | git fetch --prune --tags                  |
+      |         | git checkout main                         |
+      | main    | git rebase origin/main                    |
+      |         | git push                                  |
+      |         | git checkout feature                      |
+      | feature | git merge --no-edit --ff origin/feature   |
+      |         | git merge --no-edit --ff main             |
+      | <none>  | sh -c "printf regenerated > package.lock" |
+      | feature | git add --update                          |
+      |         | git commit --no-edit                      |
+      |         | git push                                  |
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And no merge is in progress
+    And file "package.lock" now has content "regenerated"
+    And no uncommitted files exist
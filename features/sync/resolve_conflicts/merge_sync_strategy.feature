@@ -0,0 +1,59 @@
+@skipWindows
+Feature: automatically resolve conflicts in generated files while merging the parent branch
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [[sync.resolve]]
+      paths = [ "*.lock" ]
+      command = "printf regenerated > package.lock"
+      """
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE                    | FILE NAME    | FILE CONTENT    |
+      | main    | local    | conflicting main commit    | package.lock | main content    |
+      | feature | local    | conflicting feature commit | package.lock | feature content |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                   |
+      | feature | git fetch --prune --tags                  |
+      |         | git checkout main                         |
+      | main    | git rebase origin/main                    |
+      |         | git push                                  |
+      |         | git checkout feature                      |
+      | feature | git merge --no-edit --ff origin/feature   |
+      |         | git merge --no-edit --ff main             |
+      | <none>  | sh -c "printf regenerated > package.lock" |
+      | feature | git add --update                          |
+      |         | git commit --no-edit                      |
+      |         | git push                                  |
+    And it prints:
+      """
+      Resolved the conflicts in package.lock automatically.
+      """
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And no merge is in progress
+    And file "package.lock" now has content "regenerated"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                      |
+      | feature | git reset --hard {{ sha 'conflicting feature commit' }}                      |
+      |         | git push --force-with-lease origin {{ sha 'persisted config file' }}:feature |
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE                    | FILE NAME    | FILE CONTENT    |
+      | main    | local, origin | conflicting main commit    | package.lock | main content    |
+      | feature | local         | conflicting feature commit | package.lock | feature content |
+    And the initial branches and lineage exist
@@ -0,0 +1,65 @@
+@skipWindows
+Feature: conflicts in files without a conflict resolution rule require manual resolution
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [[sync.resolve]]
+      paths = [ "*.lock" ]
+      command = "printf regenerated > package.lock"
+      """
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE                      | FILE NAME        | FILE CONTENT    |
+      | main    | local    | conflicting main commit 1    | conflicting_file | main content    |
+      |         | local    | conflicting main commit 2    | package.lock     | main content    |
+      | feature | local    | conflicting feature commit 1 | conflicting_file | feature content |
+      |         | local    | conflicting feature commit 2 | package.lock     | feature content |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                 |
+      | feature | git fetch --prune --tags                |
+      |         | git checkout main                       |
+      | main    | git rebase origin/main                  |
+      |         | git push                                |
+      |         | git checkout feature                    |
+      | feature | git merge --no-edit --ff origin/feature |
+      |         | git merge --no-edit --ff main           |
+    And it prints the error:
+      """
+      To continue after having resolved conflicts, run "git town continue".
+      """
+    And the current branch is still "feature"
+    And a merge is now in progress
+
+  Scenario: resolve the other conflicts and continue
+    When I resolve the conflict in "conflicting_file"
+    And I run "git-town continue"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                   |
+      |         | sh -c "printf regenerated > package.lock" |
+      | feature | git add --update                          |
+      |         | git commit --no-edit                      |
+      |         | git push                                  |
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And no merge is in progress
+    And file "package.lock" now has content "regenerated"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND           |
+      | feature | git merge --abort |
+    And the current branch is still "feature"
+    And no merge is in progress
+    And the initial branches and lineage exist
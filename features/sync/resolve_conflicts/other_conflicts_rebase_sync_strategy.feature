@@ -0,0 +1,70 @@
+@skipWindows
+Feature: conflicts in files without a conflict resolution rule require manual resolution while rebasing
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [sync-strategy]
+      feature-branches = "rebase"
+
+      [[sync.resolve]]
+      paths = [ "*.lock" ]
+      command = "printf regenerated > package.lock"
+      """
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE                    | FILE NAME        | FILE CONTENT    |
+      | main    | local    | conflicting main commit 1  | conflicting_file | main content    |
+      |         | local    | conflicting main commit 2  | package.lock     | main content    |
+      | feature | local    | conflicting feature commit | conflicting_file | feature content |
+    And I add this commit to the current branch:
+      | MESSAGE                    | FILE NAME    | FILE CONTENT    |
+      | conflicting feature commit | package.lock | feature content |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+      |         | git checkout main        |
+      | main    | git rebase origin/main   |
+      |         | git push                 |
+      |         | git checkout feature     |
+      | feature | git rebase main          |
+    And it prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in conflicting_file
+      """
+    And the current branch is still "feature"
+    And a rebase is now in progress
+
+  Scenario: resolve the other conflicts and continue
+    When I resolve the conflict in "conflicting_file"
+    And I run "git-town continue" and close the editor
+    Then it runs the commands
+      | BRANCH  | COMMAND                                         |
+      | feature | git rebase --continue                           |
+      | <none>  | sh -c "printf regenerated > package.lock"       |
+      | feature | git add --update                                |
+      |         | git -c core.editor=true rebase --continue       |
+      |         | git push --force-with-lease --force-if-includes |
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And no rebase is in progress
+    And file "package.lock" now has content "regenerated"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND            |
+      | feature | git rebase --abort |
+    And the current branch is still "feature"
+    And no rebase is in progress
+    And the initial branches and lineage exist
@@ -0,0 +1,45 @@
+@skipWindows
+Feature: commit the changes that the conflict resolution command makes to other tracked files
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [[sync.resolve]]
+      paths = [ "package.lock" ]
+      command = "printf regenerated > package.lock && printf updated > package.json"
+      """
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE                    | FILE NAME    | FILE CONTENT     |
+      | main    | local    | main package commit        | package.json | original content |
+      |         | local    | conflicting main commit    | package.lock | main content     |
+      | feature | local    | conflicting feature commit | package.lock | feature content  |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                    |
+      | feature | git fetch --prune --tags                                                   |
+      |         | git checkout main                                                          |
+      | main    | git rebase origin/main                                                     |
+      |         | git push                                                                   |
+      |         | git checkout feature                                                       |
+      | feature | git merge --no-edit --ff origin/feature                                    |
+      |         | git merge --no-edit --ff main                                              |
+      | <none>  | sh -c "printf regenerated > package.lock && printf updated > package.json" |
+      | feature | git add --update                                                           |
+      |         | git commit --no-edit                                                       |
+      |         | git push                                                                   |
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And no merge is in progress
+    And no uncommitted files exist
+    And file "package.lock" now has content "regenerated"
+    And file "package.json" now has content "updated"
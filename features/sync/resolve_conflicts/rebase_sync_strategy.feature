@@ -0,0 +1,61 @@
+@skipWindows
+Feature: automatically resolve conflicts in generated files while rebasing onto the parent branch
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [sync-strategy]
+      feature-branches = "rebase"
+
+      [[sync.resolve]]
+      paths = [ "*.lock" ]
+      command = "printf regenerated > package.lock"
+      """
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE                 | FILE NAME    | FILE CONTENT      |
+      | main    | local    | conflicting main commit | package.lock | main content      |
+      | feature | local    | feature commit 1        | package.lock | feature content 1 |
+      |         | local    | feature commit 2        | package.lock | feature content 2 |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                         |
+      | feature | git fetch --prune --tags                        |
+      |         | git checkout main                               |
+      | main    | git rebase origin/main                          |
+      |         | git push                                        |
+      |         | git checkout feature                            |
+      | feature | git rebase main                                 |
+      | <none>  | sh -c "printf regenerated > package.lock"       |
+      | feature | git add --update                                |
+      |         | git -c core.editor=true rebase --continue       |
+      | <none>  | sh -c "printf regenerated > package.lock"       |
+      | feature | git add --update                                |
+      |         | git -c core.editor=true rebase --continue       |
+      |         | git push --force-with-lease --force-if-includes |
+    And it prints:
+      """
+      Resolved the conflicts in package.lock automatically.
+      """
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And no rebase is in progress
+    And file "package.lock" now has content "regenerated"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                      |
+      | feature | git reset --hard {{ sha 'feature commit 2' }}                                |
+      |         | git push --force-with-lease origin {{ sha 'persisted config file' }}:feature |
+    And the current branch is still "feature"
+    And the initial branches and lineage exist
@@ -0,0 +1,60 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v16/internal/cli/dialog/components/list"
+	"github.com/git-town/git-town/v16/internal/messages"
+)
+
+const (
+	apiTokenSourceTitle = `API token source`
+	apiTokenSourceHelp  = `
+Where should Git Town get the token for the API of your code hosting platform?
+
+Without a token stored in the Git Town configuration,
+Git Town uses the token in the standard environment variable of your platform,
+for example GITHUB_TOKEN, GITLAB_TOKEN, or GITEA_TOKEN.
+If that variable isn't set, Git Town asks the Git credential helper
+that you have configured for the host of your origin remote.
+This keeps the token out of your plain-text Git configuration.
+
+`
+)
+
+const (
+	APITokenSourceEntryCredentialHelper apiTokenSourceEntry = `credential helper: use an environment variable or the Git credential helper`
+	APITokenSourceEntryGitConfig        apiTokenSourceEntry = `Git config: store a token in plain text in the Git Town configuration`
+)
+
+// APITokenSource lets the user choose whether to store the API token in the Git configuration.
+// Returns true if the user wants to use a credential helper instead.
+func APITokenSource(hasToken bool, inputs components.TestInput) (useCredentialHelper bool, aborted bool, err error) {
+	entries := []apiTokenSourceEntry{
+		APITokenSourceEntryCredentialHelper,
+		APITokenSourceEntryGitConfig,
+	}
+	defaultPos := 0
+	if hasToken {
+		defaultPos = 1
+	}
+	selection, aborted, err := components.RadioList(list.NewEntries(entries...), defaultPos, apiTokenSourceTitle, apiTokenSourceHelp, inputs)
+	if err != nil || aborted {
+		return false, aborted, err
+	}
+	fmt.Printf(messages.APITokenSource, components.FormattedSelection(selection.Short(), aborted))
+	return selection == APITokenSourceEntryCredentialHelper, aborted, err
+}
+
+type apiTokenSourceEntry string
+
+func (self apiTokenSourceEntry) Short() string {
+	start, _, _ := strings.Cut(self.String(), ":")
+	return start
+}
+
+func (self apiTokenSourceEntry) String() string {
+	return string(self)
+}
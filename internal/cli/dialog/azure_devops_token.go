@@ -0,0 +1,35 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v16/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+const (
+	azureDevOpsTokenTitle = `Azure DevOps API token`
+	azureDevOpsTokenHelp  = `
+If you have an API token for Azure DevOps,
+and want to ship branches from the CLI,
+please enter it now.
+
+It's okay to leave this empty.
+
+`
+)
+
+// AzureDevOpsToken lets the user enter the Azure DevOps API token.
+func AzureDevOpsToken(oldValue Option[configdomain.AzureDevOpsToken], inputs components.TestInput) (Option[configdomain.AzureDevOpsToken], bool, error) {
+	text, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          azureDevOpsTokenHelp,
+		Prompt:        "Your Azure DevOps API token: ",
+		TestInput:     inputs,
+		Title:         azureDevOpsTokenTitle,
+	})
+	fmt.Printf(messages.AzureDevOpsToken, components.FormattedSecret(text, aborted))
+	return configdomain.ParseAzureDevOpsToken(text), aborted, err
+}
@@ -0,0 +1,35 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v16/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+const (
+	bitbucketTokenTitle = `Bitbucket API token`
+	bitbucketTokenHelp  = `
+If you have an API token for Bitbucket,
+and want to ship branches from the CLI,
+please enter it now.
+
+It's okay to leave this empty.
+
+`
+)
+
+// BitbucketToken lets the user enter the Bitbucket API token.
+func BitbucketToken(oldValue Option[configdomain.BitbucketToken], inputs components.TestInput) (Option[configdomain.BitbucketToken], bool, error) {
+	text, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          bitbucketTokenHelp,
+		Prompt:        "Your Bitbucket API token: ",
+		TestInput:     inputs,
+		Title:         bitbucketTokenTitle,
+	})
+	fmt.Printf(messages.BitbucketToken, components.FormattedSecret(text, aborted))
+	return configdomain.ParseBitbucketToken(text), aborted, err
+}
@@ -24,7 +24,9 @@ Only change this if your code hosting server uses as custom URL.
 func HostingPlatform(existingValue Option[configdomain.HostingPlatform], inputs components.TestInput) (Option[configdomain.HostingPlatform], bool, error) {
 	entries := hostingPlatformEntries{
 		hostingPlatformAutoDetect,
+		hostingPlatformAzureDevOps,
 		hostingPlatformBitBucket,
+		hostingPlatformBitBucketDatacenter,
 		hostingPlatformGitea,
 		hostingPlatformGitHub,
 		hostingPlatformGitLab,
@@ -38,19 +40,25 @@ func HostingPlatform(existingValue Option[configdomain.HostingPlatform], inputs
 type hostingPlatformEntry string
 
 const (
-	hostingPlatformAutoDetect hostingPlatformEntry = "auto-detect"
-	hostingPlatformBitBucket  hostingPlatformEntry = "BitBucket"
-	hostingPlatformGitea      hostingPlatformEntry = "Gitea"
-	hostingPlatformGitHub     hostingPlatformEntry = "GitHub"
-	hostingPlatformGitLab     hostingPlatformEntry = "GitLab"
+	hostingPlatformAutoDetect          hostingPlatformEntry = "auto-detect"
+	hostingPlatformAzureDevOps         hostingPlatformEntry = "Azure DevOps"
+	hostingPlatformBitBucket           hostingPlatformEntry = "BitBucket"
+	hostingPlatformBitBucketDatacenter hostingPlatformEntry = "BitBucket Data Center"
+	hostingPlatformGitea               hostingPlatformEntry = "Gitea"
+	hostingPlatformGitHub              hostingPlatformEntry = "GitHub"
+	hostingPlatformGitLab              hostingPlatformEntry = "GitLab"
 )
 
 func (entry hostingPlatformEntry) HostingPlatform() Option[configdomain.HostingPlatform] {
 	switch entry {
 	case hostingPlatformAutoDetect, "":
 		return None[configdomain.HostingPlatform]()
+	case hostingPlatformAzureDevOps:
+		return Some(configdomain.HostingPlatformAzureDevOps)
 	case hostingPlatformBitBucket:
 		return Some(configdomain.HostingPlatformBitbucket)
+	case hostingPlatformBitBucketDatacenter:
+		return Some(configdomain.HostingPlatformBitbucketDatacenter)
 	case hostingPlatformGitea:
 		return Some(configdomain.HostingPlatformGitea)
 	case hostingPlatformGitHub:
@@ -0,0 +1,59 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v16/internal/cli/dialog/components/list"
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+)
+
+const (
+	importLineageTitle        = `Import lineage`
+	importLineageHelpTemplate = `
+The proposals on your code hosting platform
+indicate these parent branches:
+
+%s
+
+Do you want to store them in the Git Town configuration?
+
+`
+)
+
+const (
+	ImportLineageEntryYes importLineageEntry = `yes, set these parent branches`
+	ImportLineageEntryNo  importLineageEntry = `no, keep the current parent branches`
+)
+
+// ImportLineage asks the user to confirm setting the given parent branches.
+func ImportLineage(lineage configdomain.Lineage, inputs components.TestInput) (confirmed bool, aborted bool, err error) {
+	entries := []importLineageEntry{
+		ImportLineageEntryYes,
+		ImportLineageEntryNo,
+	}
+	parents := make([]string, 0, lineage.Len())
+	for _, entry := range lineage.Entries() {
+		parents = append(parents, fmt.Sprintf("  %s: %s", entry.Child, entry.Parent))
+	}
+	help := fmt.Sprintf(importLineageHelpTemplate, strings.Join(parents, "\n"))
+	selection, aborted, err := components.RadioList(list.NewEntries(entries...), 0, importLineageTitle, help, inputs)
+	if err != nil || aborted {
+		return false, aborted, err
+	}
+	fmt.Printf(messages.ImportLineage, components.FormattedSelection(selection.Short(), aborted))
+	return selection == ImportLineageEntryYes, aborted, err
+}
+
+type importLineageEntry string
+
+func (self importLineageEntry) Short() string {
+	start, _, _ := strings.Cut(self.String(), ",")
+	return start
+}
+
+func (self importLineageEntry) String() string {
+	return string(self)
+}
@@ -0,0 +1,69 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v16/internal/cli/dialog/components/list"
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+)
+
+const (
+	proposalBranchTypeTitle        = `Branch type`
+	proposalBranchTypeHelpTemplate = `
+Other people authored branch %q.
+How do you want to work with it?
+
+Options:
+
+- contribution: you push your commits to this branch,
+  the branch owner syncs it with its parent and ships it
+- observed: you only try out the changes locally,
+  the branch owner makes all official changes
+
+`
+)
+
+const (
+	ProposalBranchTypeEntryContribution proposalBranchTypeEntry = `contribution: push my commits to this branch`
+	ProposalBranchTypeEntryObserved     proposalBranchTypeEntry = `observed: only try out the changes locally`
+)
+
+// ProposalBranchType asks the user whether to contribute to or to observe the given branch that other people authored.
+func ProposalBranchType(branch gitdomain.LocalBranchName, inputs components.TestInput) (configdomain.BranchType, bool, error) {
+	entries := []proposalBranchTypeEntry{
+		ProposalBranchTypeEntryContribution,
+		ProposalBranchTypeEntryObserved,
+	}
+	help := fmt.Sprintf(proposalBranchTypeHelpTemplate, branch)
+	selection, aborted, err := components.RadioList(list.NewEntries(entries...), 0, proposalBranchTypeTitle, help, inputs)
+	if err != nil || aborted {
+		return configdomain.BranchTypeContributionBranch, aborted, err
+	}
+	fmt.Printf(messages.ProposalBranchType, components.FormattedSelection(selection.Short(), aborted))
+	return selection.BranchType(), aborted, err
+}
+
+type proposalBranchTypeEntry string
+
+func (self proposalBranchTypeEntry) BranchType() configdomain.BranchType {
+	switch self {
+	case ProposalBranchTypeEntryContribution:
+		return configdomain.BranchTypeContributionBranch
+	case ProposalBranchTypeEntryObserved:
+		return configdomain.BranchTypeObservedBranch
+	}
+	panic("unhandled proposalBranchTypeEntry: " + self)
+}
+
+func (self proposalBranchTypeEntry) Short() string {
+	start, _, _ := strings.Cut(self.String(), ":")
+	return start
+}
+
+func (self proposalBranchTypeEntry) String() string {
+	return string(self)
+}
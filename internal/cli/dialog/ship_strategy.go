@@ -17,7 +17,9 @@ Which method should Git Town use to ship feature branches?
 
 Options:
 
-- api: merge the proposal on your code hosting platform via the code hosting API
+- api: squash-merge the proposal on your code hosting platform via the code hosting API
+- api-merge: merge the proposal with a merge commit on your code hosting platform via the code hosting API
+- api-rebase: rebase-merge the proposal on your code hosting platform via the code hosting API
 - fast-forward: in your local repo, fast-forward the parent branch to point to the commits on the feature branch
 - squash-merge: in your local repo, squash-merge the feature branch into its parent branch
 
@@ -26,7 +28,9 @@ All options update proposals of child branches and remove the shipped branch loc
 )
 
 const (
-	ShipStrategyEntryAPI         shipStrategyEntry = `api: merge the proposal on your code hosting platform via the code hosting API`
+	ShipStrategyEntryAPI         shipStrategyEntry = `api: squash-merge the proposal on your code hosting platform via the code hosting API`
+	ShipStrategyEntryAPIMerge    shipStrategyEntry = `api-merge: merge the proposal with a merge commit on your code hosting platform via the code hosting API`
+	ShipStrategyEntryAPIRebase   shipStrategyEntry = `api-rebase: rebase-merge the proposal on your code hosting platform via the code hosting API`
 	ShipStrategyEntryFastForward shipStrategyEntry = `fast-forward: in your local repo, fast-forward the parent branch to point to the commits on the feature branch`
 	ShipStrategyEntrySquashMerge shipStrategyEntry = `squash-merge: in your local repo, squash-merge the feature branch into its parent branch`
 )
@@ -34,6 +38,8 @@ const (
 func ShipStrategy(existing configdomain.ShipStrategy, inputs components.TestInput) (configdomain.ShipStrategy, bool, error) {
 	entries := []shipStrategyEntry{
 		ShipStrategyEntryAPI,
+		ShipStrategyEntryAPIMerge,
+		ShipStrategyEntryAPIRebase,
 		ShipStrategyEntryFastForward,
 		ShipStrategyEntrySquashMerge,
 	}
@@ -52,6 +58,10 @@ func (self shipStrategyEntry) ShipStrategy() configdomain.ShipStrategy {
 	switch self {
 	case ShipStrategyEntryAPI:
 		return configdomain.ShipStrategyAPI
+	case ShipStrategyEntryAPIMerge:
+		return configdomain.ShipStrategyAPIMerge
+	case ShipStrategyEntryAPIRebase:
+		return configdomain.ShipStrategyAPIRebase
 	case ShipStrategyEntryFastForward:
 		return configdomain.ShipStragegyFastForward
 	case ShipStrategyEntrySquashMerge:
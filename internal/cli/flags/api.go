@@ -0,0 +1,26 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const apiLong = "api"
+
+// type-safe access to the CLI arguments of type configdomain.UseAPI
+func API(desc string) (AddFunc, ReadAPIFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.PersistentFlags().Bool(apiLong, false, desc)
+	}
+	readFlag := func(cmd *cobra.Command) configdomain.UseAPI {
+		value, err := cmd.Flags().GetBool(apiLong)
+		if err != nil {
+			panic(err)
+		}
+		return configdomain.UseAPI(value)
+	}
+	return addFlag, readFlag
+}
+
+// the type signature for the function that reads the api flag from the args to the given Cobra command
+type ReadAPIFlagFunc func(*cobra.Command) configdomain.UseAPI
@@ -0,0 +1,26 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const jsonLong = "json"
+
+// type-safe access to the CLI arguments of type configdomain.JSONOutput
+func JSON() (AddFunc, ReadJSONFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.PersistentFlags().Bool(jsonLong, false, "print the result as JSON")
+	}
+	readFlag := func(cmd *cobra.Command) configdomain.JSONOutput {
+		value, err := cmd.Flags().GetBool(jsonLong)
+		if err != nil {
+			panic(err)
+		}
+		return configdomain.JSONOutput(value)
+	}
+	return addFlag, readFlag
+}
+
+// the type signature for the function that reads the json flag from the args to the given Cobra command
+type ReadJSONFlagFunc func(*cobra.Command) configdomain.JSONOutput
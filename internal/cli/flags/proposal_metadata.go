@@ -0,0 +1,65 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const (
+	assigneeLong = "assignee" // long form of the "assignee" CLI flag
+	draftLong    = "draft"    // long form of the "draft" CLI flag
+	labelLong    = "label"    // long form of the "label" CLI flag
+	reviewerLong = "reviewer" // long form of the "reviewer" CLI flag
+)
+
+// type-safe access to the CLI arguments that provide the assignees of new proposals
+func ProposalAssignees() (AddFunc, ReadStringsFlagFunc) {
+	return stringsFlag(assigneeLong, "assign the proposal to the given user, can be repeated")
+}
+
+// type-safe access to the CLI arguments of type configdomain.ProposeDraft
+func ProposalDraft() (AddFunc, ReadProposalDraftFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.PersistentFlags().Bool(draftLong, false, "create the proposal as a draft")
+	}
+	readFlag := func(cmd *cobra.Command) configdomain.ProposeDraft {
+		value, err := cmd.Flags().GetBool(draftLong)
+		if err != nil {
+			panic(err)
+		}
+		return configdomain.ProposeDraft(value)
+	}
+	return addFlag, readFlag
+}
+
+// type-safe access to the CLI arguments that provide the labels of new proposals
+func ProposalLabels() (AddFunc, ReadStringsFlagFunc) {
+	return stringsFlag(labelLong, "add the given label to the proposal, can be repeated")
+}
+
+// type-safe access to the CLI arguments that provide the reviewers of new proposals
+func ProposalReviewers() (AddFunc, ReadStringsFlagFunc) {
+	return stringsFlag(reviewerLong, "request a review from the given user, can be repeated")
+}
+
+// stringsFlag provides type-safe access to a CLI flag that can be given multiple times
+// and accepts comma-separated values.
+func stringsFlag(name, description string) (AddFunc, ReadStringsFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.PersistentFlags().StringSlice(name, []string{}, description)
+	}
+	readFlag := func(cmd *cobra.Command) []string {
+		value, err := cmd.Flags().GetStringSlice(name)
+		if err != nil {
+			panic(err)
+		}
+		return value
+	}
+	return addFlag, readFlag
+}
+
+// the type signature for the function that reads the draft flag from the args to the given Cobra command
+type ReadProposalDraftFlagFunc func(*cobra.Command) configdomain.ProposeDraft
+
+// the type signature for the functions that read a flag that can be given multiple times from the args to the given Cobra command
+type ReadStringsFlagFunc func(*cobra.Command) []string
@@ -0,0 +1,26 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const shipAutoMergeLong = "auto"
+
+// type-safe access to the CLI arguments of type configdomain.ShipAutoMerge
+func ShipAutoMerge() (AddFunc, ReadShipAutoMergeFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.PersistentFlags().Bool(shipAutoMergeLong, false, "let the hosting platform merge the proposal once all its checks pass")
+	}
+	readFlag := func(cmd *cobra.Command) configdomain.ShipAutoMerge {
+		value, err := cmd.Flags().GetBool(shipAutoMergeLong)
+		if err != nil {
+			panic(err)
+		}
+		return configdomain.ShipAutoMerge(value)
+	}
+	return addFlag, readFlag
+}
+
+// the type signature for the function that reads the auto flag from the args to the given Cobra command
+type ReadShipAutoMergeFlagFunc func(*cobra.Command) configdomain.ShipAutoMerge
@@ -0,0 +1,26 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const syncCheckLong = "check"
+
+// type-safe access to the CLI arguments of type configdomain.SyncCheck
+func SyncCheck() (AddFunc, ReadSyncCheckFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.PersistentFlags().Bool(syncCheckLong, false, "only report the conflicts that syncing would run into")
+	}
+	readFlag := func(cmd *cobra.Command) configdomain.SyncCheck {
+		value, err := cmd.Flags().GetBool(syncCheckLong)
+		if err != nil {
+			panic(err)
+		}
+		return configdomain.SyncCheck(value)
+	}
+	return addFlag, readFlag
+}
+
+// the type signature for the function that reads the check flag from the args to the given Cobra command
+type ReadSyncCheckFlagFunc func(*cobra.Command) configdomain.SyncCheck
@@ -4,14 +4,21 @@ import (
 	"strings"
 
 	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
 )
 
 // BranchLineage provides printable formatting of the given branch lineage.
 func BranchLineage(lineage configdomain.Lineage) string {
+	return BranchLineageWithLabels(lineage, gitdomain.LocalBranchName.String)
+}
+
+// BranchLineageWithLabels provides printable formatting of the given branch lineage
+// that displays each branch using the text that the given function provides for it.
+func BranchLineageWithLabels(lineage configdomain.Lineage, label func(gitdomain.LocalBranchName) string) string {
 	roots := lineage.Roots()
 	trees := make([]string, len(roots))
 	for r, root := range roots {
-		trees[r] = BranchTree(root, lineage)
+		trees[r] = BranchTreeWithLabels(root, lineage, label)
 	}
 	return strings.Join(trees, "\n\n")
 }
@@ -7,10 +7,16 @@ import (
 
 // BranchTree provids a printable version of the given branch tree.
 func BranchTree(branch gitdomain.LocalBranchName, lineage configdomain.Lineage) string {
-	result := branch.String()
+	return BranchTreeWithLabels(branch, lineage, gitdomain.LocalBranchName.String)
+}
+
+// BranchTreeWithLabels provides a printable version of the given branch tree
+// that displays each branch using the text that the given function provides for it.
+func BranchTreeWithLabels(branch gitdomain.LocalBranchName, lineage configdomain.Lineage, label func(gitdomain.LocalBranchName) string) string {
+	result := label(branch)
 	childBranches := lineage.Children(branch)
 	for _, childBranch := range childBranches {
-		result += "\n" + Indent(BranchTree(childBranch, lineage))
+		result += "\n" + Indent(BranchTreeWithLabels(childBranch, lineage, label))
 	}
 	return result
 }
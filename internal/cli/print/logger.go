@@ -7,17 +7,49 @@ import (
 )
 
 // The Logger logger logs activities of a particular component on the CLI.
-type Logger struct{}
+type Logger struct {
+	quiet bool // whether to suppress all output, for commands that print machine-readable output
+}
+
+// QuietLogger provides a Logger that doesn't print anything.
+func QuietLogger() Logger {
+	return Logger{quiet: true}
+}
 
 func (l Logger) Failed(failure error) {
+	if l.quiet {
+		return
+	}
 	fmt.Println(colors.BoldRed().Styled(fmt.Sprintf("FAILED: %v\n", failure)))
 }
 
+// Progress indicates that a long-running activity is still ongoing.
+func (l Logger) Progress() {
+	if l.quiet {
+		return
+	}
+	fmt.Print(colors.Bold().Styled("."))
+}
+
 func (l Logger) Start(template string, data ...interface{}) {
+	if l.quiet {
+		return
+	}
 	fmt.Println()
 	fmt.Print(colors.Bold().Styled(fmt.Sprintf(template, data...)))
 }
 
+// Warning reports a problem that doesn't stop the current activity.
+func (l Logger) Warning(template string, data ...interface{}) {
+	if l.quiet {
+		return
+	}
+	fmt.Println(colors.Cyan().Styled(fmt.Sprintf(template, data...)))
+}
+
 func (l Logger) Success() {
+	if l.quiet {
+		return
+	}
 	fmt.Println(colors.BoldGreen().Styled("ok"))
 }
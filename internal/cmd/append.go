@@ -202,13 +202,14 @@ func appendProgram(data appendFeatureData) program.Program {
 	if !data.hasOpenChanges {
 		for _, branchToSync := range data.branchesToSync {
 			sync.BranchProgram(branchToSync.BranchInfo, sync.BranchProgramArgs{
-				BranchInfos:        data.allBranches,
-				Config:             data.config.Config,
-				FirstCommitMessage: branchToSync.FirstCommitMessage,
-				InitialBranch:      data.initialBranch,
-				Program:            prog,
-				Remotes:            data.remotes,
-				PushBranches:       true,
+				BranchInfos:         data.allBranches,
+				Config:              data.config.Config,
+				FirstCommitMessage:  branchToSync.FirstCommitMessage,
+				InitialBranch:       data.initialBranch,
+				Program:             prog,
+				Remotes:             data.remotes,
+				PushBranches:        true,
+				SyncWithoutCheckout: false,
 			})
 		}
 	}
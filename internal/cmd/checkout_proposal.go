@@ -0,0 +1,302 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+
+	"github.com/git-town/git-town/v16/internal/cli/dialog"
+	"github.com/git-town/git-town/v16/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v16/internal/cli/flags"
+	"github.com/git-town/git-town/v16/internal/cli/print"
+	"github.com/git-town/git-town/v16/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v16/internal/config"
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/execute"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/hosting"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+	"github.com/git-town/git-town/v16/internal/undo/undoconfig"
+	"github.com/git-town/git-town/v16/internal/validate"
+	fullInterpreter "github.com/git-town/git-town/v16/internal/vm/interpreter/full"
+	"github.com/git-town/git-town/v16/internal/vm/opcodes"
+	"github.com/git-town/git-town/v16/internal/vm/program"
+	"github.com/git-town/git-town/v16/internal/vm/runstate"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const checkoutProposalCmd = "checkout-proposal"
+
+const checkoutProposalDesc = "Check out the branch of a proposal"
+
+const checkoutProposalHelp = `
+Looks up the proposal with the given number on your code hosting platform, fetches the branch that it merges, and checks out a local branch that tracks it. Sets the target branch of the proposal as the parent of the new branch.
+
+Proposals from forks add a Git remote named after the owner of the fork and check out the branch as "<owner>/<branch>". If other people authored the commits in the branch, asks whether to make it a contribution or an observed branch.
+
+Supported for repositories hosted on GitHub, GitLab, and Gitea. You can undo the changes with "git town undo".`
+
+func checkoutProposalCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "checkout-proposal <number>",
+		GroupID: "basic",
+		Args:    cobra.ExactArgs(1),
+		Short:   checkoutProposalDesc,
+		Long:    cmdhelpers.Long(checkoutProposalDesc, checkoutProposalHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeCheckoutProposal(args[0], readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeCheckoutProposal(numberText string, verbose configdomain.Verbose) error {
+	number, err := strconv.Atoi(numberText)
+	if err != nil || number <= 0 {
+		return fmt.Errorf(messages.CheckoutProposalNumberInvalid, numberText)
+	}
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		PrintBranchNames: true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: true,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, exit, err := determineCheckoutProposalData(repo, number, verbose)
+	if err != nil || exit {
+		return err
+	}
+	runProgram := checkoutProposalProgram(data)
+	finalUndoProgram := program.Program{}
+	if data.addRemoteURL.IsSome() {
+		finalUndoProgram.Add(&opcodes.RemoveRemote{Remote: data.remote})
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: data.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        data.stashSize,
+		Command:               checkoutProposalCmd,
+		DryRun:                false,
+		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
+		EndConfigSnapshot:     None[undoconfig.ConfigSnapshot](),
+		EndStashSize:          None[gitdomain.StashSize](),
+		FinalUndoProgram:      finalUndoProgram,
+		RunProgram:            runProgram,
+		TouchedBranches:       runProgram.TouchedBranches(),
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		Connector:               Some(data.connector),
+		DialogTestInputs:        data.dialogTestInputs,
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+		InitialBranch:           data.initialBranch,
+		InitialBranchesSnapshot: data.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        data.stashSize,
+		RootDir:                 repo.RootDir,
+		RunState:                runState,
+		Verbose:                 verbose,
+	})
+}
+
+type checkoutProposalData struct {
+	addRemoteURL     Option[string] // URL of the fork remote to add, if it doesn't exist yet
+	branch           gitdomain.LocalBranchName
+	branchType       configdomain.BranchType
+	branchesSnapshot gitdomain.BranchesSnapshot
+	config           config.ValidatedConfig
+	connector        hostingdomain.Connector
+	dialogTestInputs components.TestInputs
+	hasOpenChanges   bool
+	initialBranch    gitdomain.LocalBranchName
+	parent           gitdomain.LocalBranchName
+	previousBranch   Option[gitdomain.LocalBranchName]
+	remote           gitdomain.Remote
+	remoteBranch     gitdomain.LocalBranchName // name of the branch at the remote
+	stashSize        gitdomain.StashSize
+}
+
+func determineCheckoutProposalData(repo execute.OpenRepoResult, number int, verbose configdomain.Verbose) (data checkoutProposalData, exit bool, err error) {
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return data, false, err
+	}
+	branchesSnapshot, stashSize, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 true,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return data, exit, err
+	}
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return data, false, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchesSnapshot:   branchesSnapshot,
+		BranchesToValidate: gitdomain.LocalBranchNames{},
+		DialogTestInputs:   dialogTestInputs,
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		LocalBranches:      branchesSnapshot.Branches.LocalBranches().Names(),
+		RepoStatus:         repoStatus,
+		TestInputs:         dialogTestInputs,
+		Unvalidated:        repo.UnvalidatedConfig,
+	})
+	if err != nil || exit {
+		return data, exit, err
+	}
+	var connectorOpt Option[hostingdomain.Connector]
+	if remoteURL, hasRemoteURL := validatedConfig.ProposalRemoteURL().Get(); hasRemoteURL {
+		connectorOpt, err = hosting.NewConnector(hosting.NewConnectorArgs{
+			Backend:         repo.Backend,
+			Config:          *validatedConfig.Config.UnvalidatedConfig,
+			Git:             repo.Git,
+			HostingPlatform: validatedConfig.Config.HostingPlatform,
+			Log:             print.Logger{},
+			PushRemoteURL:   validatedConfig.PushRemoteURL(),
+			RemoteURL:       remoteURL,
+		})
+		if err != nil {
+			return data, false, err
+		}
+	}
+	connector, hasConnector := connectorOpt.Get()
+	if !hasConnector {
+		return data, false, hostingdomain.UnsupportedServiceError()
+	}
+	loader, canLoad := connector.(hostingdomain.ProposalLoader)
+	if !canLoad {
+		return data, false, errors.New(messages.CheckoutProposalUnsupported)
+	}
+	proposalOpt, err := loader.LoadProposal(number)
+	if err != nil {
+		return data, false, err
+	}
+	proposal, hasProposal := proposalOpt.Get()
+	if !hasProposal {
+		return data, false, fmt.Errorf(messages.CheckoutProposalNotFound, number)
+	}
+	remoteBranch := proposal.Source.Branch
+	branch := remoteBranch
+	proposalRemote := validatedConfig.Config.ProposalRemote
+	remote := proposalRemote
+	addRemoteURL := None[string]()
+	branchLocation := remoteBranch.AtRemote(remote).BranchName()
+	fork, isFork := proposal.Source.Fork.Get()
+	if isFork {
+		// the branch of a fork can have the same name as a local branch, for example "main"
+		remote = gitdomain.Remote(fork.Owner)
+		branch = gitdomain.NewLocalBranchName(fork.Owner + "/" + remoteBranch.String())
+		if repo.UnvalidatedConfig.GitConfig.RemoteURL(remote).IsNone() {
+			addRemoteURL = Some(fork.CloneURL)
+		}
+	}
+	if branchesSnapshot.Branches.HasLocalBranch(branch) {
+		return data, false, fmt.Errorf(messages.CheckoutProposalBranchExists, branch, number)
+	}
+	if isFork {
+		// fetch the commits to determine their authors without adding a remote that aborting would have to clean up
+		if err = repo.Git.FetchBranchFromURL(repo.Backend, fork.CloneURL, remoteBranch); err != nil {
+			return data, false, err
+		}
+		branchLocation = gitdomain.NewBranchName("FETCH_HEAD")
+	} else if branchesSnapshot.Branches.FindByRemoteName(remoteBranch.AtRemote(remote)).IsNone() {
+		return data, false, fmt.Errorf(messages.CheckoutProposalBranchMissing, remoteBranch, number)
+	}
+	parent := proposal.Proposal.Target
+	parentLocation := parent.AtRemote(proposalRemote).BranchName()
+	if branchesSnapshot.Branches.HasLocalBranch(parent) {
+		parentLocation = parent.BranchName()
+	}
+	authors, err := repo.Git.BranchAuthors(repo.Backend, branchLocation, parentLocation)
+	if err != nil {
+		return data, false, err
+	}
+	branchType := configdomain.BranchTypeFeatureBranch
+	if slices.ContainsFunc(authors, func(author gitdomain.Author) bool { return author != validatedConfig.Author() }) {
+		branchType, exit, err = dialog.ProposalBranchType(branch, dialogTestInputs.Next())
+		if err != nil || exit {
+			return data, exit, err
+		}
+	}
+	return checkoutProposalData{
+		addRemoteURL:     addRemoteURL,
+		branch:           branch,
+		branchType:       branchType,
+		branchesSnapshot: branchesSnapshot,
+		config:           validatedConfig,
+		connector:        connector,
+		dialogTestInputs: dialogTestInputs,
+		hasOpenChanges:   repoStatus.OpenChanges,
+		initialBranch:    initialBranch,
+		parent:           parent,
+		previousBranch:   repo.Git.PreviouslyCheckedOutBranch(repo.Backend),
+		remote:           remote,
+		remoteBranch:     remoteBranch,
+		stashSize:        stashSize,
+	}, false, nil
+}
+
+func checkoutProposalProgram(data checkoutProposalData) program.Program {
+	prog := NewMutable(&program.Program{})
+	if url, hasURL := data.addRemoteURL.Get(); hasURL {
+		prog.Value.Add(&opcodes.AddRemote{Remote: data.remote, URL: url})
+	}
+	if data.remote != data.config.Config.ProposalRemote {
+		prog.Value.Add(&opcodes.FetchRemoteBranch{Branch: data.remoteBranch, Remote: data.remote})
+	}
+	prog.Value.Add(&opcodes.CreateBranch{
+		Branch:        data.branch,
+		StartingPoint: data.remoteBranch.AtRemote(data.remote).Location(),
+	})
+	prog.Value.Add(&opcodes.SetParent{
+		Branch: data.branch,
+		Parent: data.parent,
+	})
+	switch data.branchType {
+	case configdomain.BranchTypeContributionBranch:
+		prog.Value.Add(&opcodes.AddToContributionBranches{Branch: data.branch})
+	case configdomain.BranchTypeObservedBranch:
+		prog.Value.Add(&opcodes.AddToObservedBranches{Branch: data.branch})
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePerennialBranch, configdomain.BranchTypePrototypeBranch:
+	}
+	prog.Value.Add(&opcodes.Checkout{Branch: data.branch})
+	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
+		DryRun:                   false,
+		PreviousBranchCandidates: []Option[gitdomain.LocalBranchName]{Some(data.initialBranch), data.previousBranch},
+		RunInGitRoot:             true,
+		StashOpenChanges:         data.hasOpenChanges,
+	})
+	return prog.Get()
+}
@@ -0,0 +1,26 @@
+package cmdhelpers
+
+import (
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/vm/opcodes"
+	"github.com/git-town/git-town/v16/internal/vm/program"
+)
+
+// UpdateStackSections makes the given program update the stack section
+// in the proposals of all stacks that the given branches belong to,
+// unless the user has disabled stack sections.
+func UpdateStackSections(prog *program.Program, config configdomain.ValidatedConfig, branches gitdomain.LocalBranchNames) {
+	if !config.ProposalStackSection.IsTrue() {
+		return
+	}
+	stackRoots := gitdomain.LocalBranchNames{}
+	for _, branch := range branches {
+		stack := config.Lineage.StackOf(branch)
+		if len(stack) < 2 || stackRoots.Contains(stack[0]) {
+			continue
+		}
+		stackRoots = append(stackRoots, stack[0])
+		prog.Add(&opcodes.ConnectorUpdateStackSections{Branch: branch})
+	}
+}
@@ -0,0 +1,247 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v16/internal/cli/dialog"
+	"github.com/git-town/git-town/v16/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v16/internal/cli/flags"
+	"github.com/git-town/git-town/v16/internal/cli/print"
+	"github.com/git-town/git-town/v16/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v16/internal/config"
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/execute"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/hosting"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+	"github.com/git-town/git-town/v16/internal/undo/undoconfig"
+	"github.com/git-town/git-town/v16/internal/validate"
+	fullInterpreter "github.com/git-town/git-town/v16/internal/vm/interpreter/full"
+	"github.com/git-town/git-town/v16/internal/vm/opcodes"
+	"github.com/git-town/git-town/v16/internal/vm/program"
+	"github.com/git-town/git-town/v16/internal/vm/runstate"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const importLineageCmd = "config import-lineage"
+
+const importLineageDesc = "Set the parent branches from the proposals on the hosting platform"
+
+const importLineageHelp = `
+Looks up the open proposal of each local feature branch and sets the parent of the branch to the target branch of its proposal. This is useful after cloning a repository or switching machines, when the Git Town configuration doesn't contain the parent branches yet.
+
+Supported for repositories hosted on GitHub, GitLab, and Gitea. You can undo the changes with "git town undo".`
+
+func importLineageCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "import-lineage",
+		Args:  cobra.NoArgs,
+		Short: importLineageDesc,
+		Long:  cmdhelpers.Long(importLineageDesc, importLineageHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeImportLineage(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeImportLineage(verbose configdomain.Verbose) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		PrintBranchNames: true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: true,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, exit, err := determineImportLineageData(repo, verbose)
+	if err != nil || exit {
+		return err
+	}
+	if data.importedLineage.IsEmpty() {
+		fmt.Println(messages.ImportLineageNoChanges)
+		return nil
+	}
+	confirmed, aborted, err := dialog.ImportLineage(data.importedLineage, data.dialogTestInputs.Next())
+	if err != nil || aborted || !confirmed {
+		return err
+	}
+	runProgram := importLineageProgram(data.importedLineage)
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: data.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        data.stashSize,
+		Command:               importLineageCmd,
+		DryRun:                false,
+		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
+		EndConfigSnapshot:     None[undoconfig.ConfigSnapshot](),
+		EndStashSize:          None[gitdomain.StashSize](),
+		RunProgram:            runProgram,
+		TouchedBranches:       runProgram.TouchedBranches(),
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		Connector:               Some(data.connector),
+		DialogTestInputs:        data.dialogTestInputs,
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+		InitialBranch:           data.initialBranch,
+		InitialBranchesSnapshot: data.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        data.stashSize,
+		RootDir:                 repo.RootDir,
+		RunState:                runState,
+		Verbose:                 verbose,
+	})
+}
+
+type importLineageData struct {
+	branchesSnapshot gitdomain.BranchesSnapshot
+	config           config.ValidatedConfig
+	connector        hostingdomain.Connector
+	dialogTestInputs components.TestInputs
+	hasOpenChanges   bool
+	importedLineage  configdomain.Lineage
+	initialBranch    gitdomain.LocalBranchName
+	stashSize        gitdomain.StashSize
+}
+
+func determineImportLineageData(repo execute.OpenRepoResult, verbose configdomain.Verbose) (data importLineageData, exit bool, err error) {
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return data, false, err
+	}
+	branchesSnapshot, stashSize, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 false,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return data, exit, err
+	}
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return data, false, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	localBranches := branchesSnapshot.Branches.LocalBranches().Names()
+	// this command is supposed to provide the lineage, so don't ask the user for it here
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchesSnapshot:   branchesSnapshot,
+		BranchesToValidate: gitdomain.LocalBranchNames{},
+		DialogTestInputs:   dialogTestInputs,
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		LocalBranches:      localBranches,
+		RepoStatus:         repoStatus,
+		TestInputs:         dialogTestInputs,
+		Unvalidated:        repo.UnvalidatedConfig,
+	})
+	if err != nil || exit {
+		return data, exit, err
+	}
+	var connectorOpt Option[hostingdomain.Connector]
+	if remoteURL, hasRemoteURL := validatedConfig.ProposalRemoteURL().Get(); hasRemoteURL {
+		connectorOpt, err = hosting.NewConnector(hosting.NewConnectorArgs{
+			Backend:         repo.Backend,
+			Config:          *validatedConfig.Config.UnvalidatedConfig,
+			Git:             repo.Git,
+			HostingPlatform: validatedConfig.Config.HostingPlatform,
+			Log:             print.Logger{},
+			PushRemoteURL:   validatedConfig.PushRemoteURL(),
+			RemoteURL:       remoteURL,
+		})
+		if err != nil {
+			return data, false, err
+		}
+	}
+	connector, hasConnector := connectorOpt.Get()
+	if !hasConnector {
+		return data, false, hostingdomain.UnsupportedServiceError()
+	}
+	searcher, canSearch := connector.(hostingdomain.ProposalSearcher)
+	if !canSearch {
+		return data, false, errors.New(messages.ImportLineageUnsupported)
+	}
+	importedLineage, err := importLineageFromProposals(searcher, validatedConfig.Config, localBranches)
+	if err != nil {
+		return data, false, err
+	}
+	return importLineageData{
+		branchesSnapshot: branchesSnapshot,
+		config:           validatedConfig,
+		connector:        connector,
+		dialogTestInputs: dialogTestInputs,
+		hasOpenChanges:   repoStatus.OpenChanges,
+		importedLineage:  importedLineage,
+		initialBranch:    initialBranch,
+		stashSize:        stashSize,
+	}, false, nil
+}
+
+// importLineageFromProposals provides the parent branches that the open proposals of the given local branches indicate,
+// as far as they differ from the given lineage.
+// It ignores proposals that target branches that don't exist locally or that would make the lineage circular.
+func importLineageFromProposals(searcher hostingdomain.ProposalSearcher, config configdomain.ValidatedConfig, localBranches gitdomain.LocalBranchNames) (configdomain.Lineage, error) {
+	result := configdomain.NewLineage()
+	resultingLineage := config.Lineage.Merge(result)
+	for _, branch := range localBranches {
+		if !config.MustKnowParent(branch) {
+			continue
+		}
+		proposalOpt, err := searcher.SearchProposal(branch)
+		if err != nil {
+			return result, fmt.Errorf(messages.ProposalNotFoundForBranch, branch, err)
+		}
+		proposal, hasProposal := proposalOpt.Get()
+		if !hasProposal || !localBranches.Contains(proposal.Target) {
+			continue
+		}
+		if parent, hasParent := config.Lineage.Parent(branch).Get(); hasParent && parent == proposal.Target {
+			continue
+		}
+		if proposal.Target == branch || resultingLineage.IsAncestor(branch, proposal.Target) {
+			continue
+		}
+		result.Add(branch, proposal.Target)
+		resultingLineage.Add(branch, proposal.Target)
+	}
+	return result, nil
+}
+
+func importLineageProgram(importedLineage configdomain.Lineage) program.Program {
+	prog := program.Program{}
+	for _, entry := range importedLineage.Entries() {
+		prog.Add(&opcodes.SetParent{
+			Branch: entry.Child,
+			Parent: entry.Parent,
+		})
+	}
+	return prog
+}
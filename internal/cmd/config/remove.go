@@ -41,7 +41,7 @@ func executeRemoveConfig(verbose configdomain.Verbose) error {
 	if err != nil {
 		return err
 	}
-	err = repo.UnvalidatedConfig.GitConfig.RemoveLocalGitConfiguration(repo.UnvalidatedConfig.Config.Value.Lineage)
+	err = repo.UnvalidatedConfig.GitConfig.RemoveLocalGitConfiguration(repo.UnvalidatedConfig.Config.Value.Lineage, repo.UnvalidatedConfig.LocalGitConfig.ParentSHAs, repo.UnvalidatedConfig.LocalGitConfig.SyncStrategyOverrides)
 	if err != nil {
 		return err
 	}
@@ -28,6 +28,7 @@ func RootCmd() *cobra.Command {
 	}
 	addVerboseFlag(&configCmd)
 	configCmd.AddCommand(getParentCommand())
+	configCmd.AddCommand(importLineageCommand())
 	configCmd.AddCommand(removeConfigCommand())
 	configCmd.AddCommand(SetupCommand())
 	return &configCmd
@@ -63,18 +64,27 @@ func printConfig(config configdomain.UnvalidatedConfig) {
 	print.Entry("offline", format.Bool(config.Offline.IsTrue()))
 	print.Entry("run pre-push hook", format.Bool(bool(config.PushHook)))
 	print.Entry("push new branches", format.Bool(config.ShouldPushNewBranches()))
+	print.Entry("push remote", config.PushRemote.String())
+	print.Entry("proposal body", config.ProposalBodySource.String())
+	print.Entry("proposal remote", config.ProposalRemote.String())
+	print.Entry("proposal stack section", format.Bool(config.ProposalStackSection.IsTrue()))
 	print.Entry("ship strategy", config.ShipStrategy.String())
 	print.Entry("ship deletes the tracking branch", format.Bool(config.ShipDeleteTrackingBranch.IsTrue()))
 	print.Entry("sync-feature strategy", config.SyncFeatureStrategy.String())
 	print.Entry("sync-perennial strategy", config.SyncPerennialStrategy.String())
+	print.Entry("sync-strategy overrides", format.StringsSetting(config.SyncStrategyOverrides.String()))
 	print.Entry("sync with upstream", format.Bool(config.SyncUpstream.IsTrue()))
 	print.Entry("sync tags", format.Bool(config.SyncTags.IsTrue()))
 	fmt.Println()
 	print.Header("Hosting")
 	print.Entry("hosting platform override", format.StringSetting(config.HostingPlatform.String()))
+	print.Entry("API URL override", format.OptionalStringerSetting(config.HostingAPIURL))
+	print.Entry("CA file", format.OptionalStringerSetting(config.HostingCAFile))
 	print.Entry("GitHub token", format.OptionalStringerSetting(config.GitHubToken))
 	print.Entry("GitLab token", format.OptionalStringerSetting(config.GitLabToken))
 	print.Entry("Gitea token", format.OptionalStringerSetting(config.GiteaToken))
+	print.Entry("Bitbucket token", format.OptionalStringerSetting(config.BitbucketToken))
+	print.Entry("Azure DevOps token", format.OptionalStringerSetting(config.AzureDevOpsToken))
 	fmt.Println()
 	if config.Lineage.Len() > 0 {
 		print.LabelAndValue("Branch Lineage", format.BranchLineage(config.Lineage))
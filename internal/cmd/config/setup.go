@@ -106,6 +106,16 @@ func determineHostingPlatform(config config.UnvalidatedConfig, userChoice Option
 	return None[configdomain.HostingPlatform]()
 }
 
+// enterAPIToken lets the user choose between a credential helper and a token stored in the Git configuration,
+// and lets the user enter the token if they choose the latter.
+func enterAPIToken[T ~string](existing Option[T], tokenDialog func(Option[T], components.TestInput) (Option[T], bool, error), inputs components.TestInputs) (Option[T], bool, error) {
+	useCredentialHelper, aborted, err := dialog.APITokenSource(existing.IsSome(), inputs.Next())
+	if err != nil || aborted || useCredentialHelper {
+		return None[T](), aborted, err
+	}
+	return tokenDialog(existing, inputs.Next())
+}
+
 func enterData(config config.UnvalidatedConfig, gitCommands git.Commands, backend gitdomain.RunnerQuerier, data *setupData) (aborted bool, err error) {
 	aborted, err = dialog.Welcome(data.dialogInputs.Next())
 	if err != nil || aborted {
@@ -141,20 +151,28 @@ func enterData(config config.UnvalidatedConfig, gitCommands git.Commands, backen
 	}
 	if platform, has := determineHostingPlatform(config, data.userInput.config.HostingPlatform).Get(); has {
 		switch platform {
-		case configdomain.HostingPlatformBitbucket:
-			// BitBucket API isn't supported yet
+		case configdomain.HostingPlatformAzureDevOps:
+			data.userInput.config.AzureDevOpsToken, aborted, err = enterAPIToken(config.Config.Value.AzureDevOpsToken, dialog.AzureDevOpsToken, data.dialogInputs)
+			if err != nil || aborted {
+				return aborted, err
+			}
+		case configdomain.HostingPlatformBitbucket, configdomain.HostingPlatformBitbucketDatacenter:
+			data.userInput.config.BitbucketToken, aborted, err = enterAPIToken(config.Config.Value.BitbucketToken, dialog.BitbucketToken, data.dialogInputs)
+			if err != nil || aborted {
+				return aborted, err
+			}
 		case configdomain.HostingPlatformGitea:
-			data.userInput.config.GiteaToken, aborted, err = dialog.GiteaToken(config.Config.Value.GiteaToken, data.dialogInputs.Next())
+			data.userInput.config.GiteaToken, aborted, err = enterAPIToken(config.Config.Value.GiteaToken, dialog.GiteaToken, data.dialogInputs)
 			if err != nil || aborted {
 				return aborted, err
 			}
 		case configdomain.HostingPlatformGitHub:
-			data.userInput.config.GitHubToken, aborted, err = dialog.GitHubToken(config.Config.Value.GitHubToken, data.dialogInputs.Next())
+			data.userInput.config.GitHubToken, aborted, err = enterAPIToken(config.Config.Value.GitHubToken, dialog.GitHubToken, data.dialogInputs)
 			if err != nil || aborted {
 				return aborted, err
 			}
 		case configdomain.HostingPlatformGitLab:
-			data.userInput.config.GitLabToken, aborted, err = dialog.GitLabToken(config.Config.Value.GitLabToken, data.dialogInputs.Next())
+			data.userInput.config.GitLabToken, aborted, err = enterAPIToken(config.Config.Value.GitLabToken, dialog.GitLabToken, data.dialogInputs)
 			if err != nil || aborted {
 				return aborted, err
 			}
@@ -244,6 +262,14 @@ func saveAll(userInput userInput, oldConfig config.UnvalidatedConfig, gitCommand
 	if err != nil {
 		return err
 	}
+	err = saveAzureDevOpsToken(oldConfig.Config.Value.AzureDevOpsToken, userInput.config.AzureDevOpsToken, gitCommands, frontend)
+	if err != nil {
+		return err
+	}
+	err = saveBitbucketToken(oldConfig.Config.Value.BitbucketToken, userInput.config.BitbucketToken, gitCommands, frontend)
+	if err != nil {
+		return err
+	}
 	err = saveGiteaToken(oldConfig.Config.Value.GiteaToken, userInput.config.GiteaToken, gitCommands, frontend)
 	if err != nil {
 		return err
@@ -301,6 +327,26 @@ func saveAliases(oldAliases, newAliases configdomain.Aliases, gitCommands git.Co
 	return nil
 }
 
+func saveAzureDevOpsToken(oldToken, newToken Option[configdomain.AzureDevOpsToken], gitCommands git.Commands, frontend gitdomain.Runner) error {
+	if newToken == oldToken {
+		return nil
+	}
+	if value, has := newToken.Get(); has {
+		return gitCommands.SetAzureDevOpsToken(frontend, value)
+	}
+	return gitCommands.RemoveAzureDevOpsToken(frontend)
+}
+
+func saveBitbucketToken(oldToken, newToken Option[configdomain.BitbucketToken], gitCommands git.Commands, frontend gitdomain.Runner) error {
+	if newToken == oldToken {
+		return nil
+	}
+	if value, has := newToken.Get(); has {
+		return gitCommands.SetBitbucketToken(frontend, value)
+	}
+	return gitCommands.RemoveBitbucketToken(frontend)
+}
+
 func saveCreatePrototypeBranches(oldValue, newValue configdomain.CreatePrototypeBranches, config config.UnvalidatedConfig) error {
 	if newValue == oldValue {
 		return nil
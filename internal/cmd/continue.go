@@ -125,8 +125,8 @@ func determineContinueData(repo execute.OpenRepoResult, verbose configdomain.Ver
 	if err != nil || exit {
 		return data, exit, err
 	}
-	if repoStatus.Conflicts {
-		return data, false, errors.New(messages.ContinueUnresolvedConflicts)
+	if err = validate.NoUnresolvedConflicts(repo.Backend, repo.Git, repoStatus, validatedConfig.Config.ConflictResolutions); err != nil {
+		return data, false, err
 	}
 	if repoStatus.UntrackedChanges {
 		return data, false, errors.New(messages.ContinueUntrackedChanges)
@@ -139,12 +139,15 @@ func determineContinueData(repo execute.OpenRepoResult, verbose configdomain.Ver
 			return data, false, errors.New(messages.CurrentBranchCannotDetermine)
 		}
 	}
-	if originURL, hasOriginURL := validatedConfig.OriginURL().Get(); hasOriginURL {
+	if remoteURL, hasRemoteURL := validatedConfig.ProposalRemoteURL().Get(); hasRemoteURL {
 		connector, err = hosting.NewConnector(hosting.NewConnectorArgs{
+			Backend:         repo.Backend,
 			Config:          repo.UnvalidatedConfig.Config.Get(),
+			Git:             repo.Git,
 			HostingPlatform: validatedConfig.Config.HostingPlatform,
 			Log:             print.Logger{},
-			RemoteURL:       originURL,
+			PushRemoteURL:   validatedConfig.PushRemoteURL(),
+			RemoteURL:       remoteURL,
 		})
 	}
 	return continueData{
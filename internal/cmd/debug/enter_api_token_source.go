@@ -0,0 +1,20 @@
+package debug
+
+import (
+	"os"
+
+	"github.com/git-town/git-town/v16/internal/cli/dialog"
+	"github.com/git-town/git-town/v16/internal/cli/dialog/components"
+	"github.com/spf13/cobra"
+)
+
+func enterAPITokenSource() *cobra.Command {
+	return &cobra.Command{
+		Use: "api-token-source",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			dialogInputs := components.LoadTestInputs(os.Environ())
+			_, _, err := dialog.APITokenSource(false, dialogInputs.Next())
+			return err
+		},
+	}
+}
@@ -0,0 +1,24 @@
+package debug
+
+import (
+	"os"
+
+	"github.com/git-town/git-town/v16/internal/cli/dialog"
+	"github.com/git-town/git-town/v16/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+func enterImportLineage() *cobra.Command {
+	return &cobra.Command{
+		Use: "import-lineage",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			dialogInputs := components.LoadTestInputs(os.Environ())
+			lineage := configdomain.NewLineage()
+			lineage.Add("feature-1", "main")
+			lineage.Add("feature-2", "feature-1")
+			_, _, err := dialog.ImportLineage(lineage, dialogInputs.Next())
+			return err
+		},
+	}
+}
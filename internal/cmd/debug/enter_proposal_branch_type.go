@@ -0,0 +1,20 @@
+package debug
+
+import (
+	"os"
+
+	"github.com/git-town/git-town/v16/internal/cli/dialog"
+	"github.com/git-town/git-town/v16/internal/cli/dialog/components"
+	"github.com/spf13/cobra"
+)
+
+func enterProposalBranchType() *cobra.Command {
+	return &cobra.Command{
+		Use: "proposal-branch-type",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			dialogInputs := components.LoadTestInputs(os.Environ())
+			_, _, err := dialog.ProposalBranchType("feature", dialogInputs.Next())
+			return err
+		},
+	}
+}
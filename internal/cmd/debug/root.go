@@ -11,8 +11,12 @@ func RootCmd() *cobra.Command {
 		Hidden: true,
 	}
 	debugCommand.AddCommand(enterAliases())
+	debugCommand.AddCommand(enterAPITokenSource())
+	debugCommand.AddCommand(enterAzureDevOpsToken())
+	debugCommand.AddCommand(enterBitbucketToken())
 	debugCommand.AddCommand(enterCreatePrototypeBranches())
 	debugCommand.AddCommand(enterHostingPlatform())
+	debugCommand.AddCommand(enterImportLineage())
 	debugCommand.AddCommand(enterGiteaToken())
 	debugCommand.AddCommand(enterGitHubToken())
 	debugCommand.AddCommand(enterGitLabToken())
@@ -21,6 +25,7 @@ func RootCmd() *cobra.Command {
 	debugCommand.AddCommand(enterOriginHostname())
 	debugCommand.AddCommand(enterPerennialBranches())
 	debugCommand.AddCommand(enterPerennialRegex())
+	debugCommand.AddCommand(enterProposalBranchType())
 	debugCommand.AddCommand(enterSyncFeatureStrategy())
 	debugCommand.AddCommand(enterSyncPerennialStrategy())
 	debugCommand.AddCommand(enterSyncUpstream())
@@ -12,11 +12,13 @@ import (
 )
 
 func newPullRequestCommand() *cobra.Command {
+	addAPIFlag, readAPIFlag := flags.API("create the proposal via the API and print its URL")
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
 	addTitleFlag, readTitleFlag := flags.ProposalTitle()
 	addBodyFlag, readBodyFlag := flags.ProposalBody()
 	addBodyFileFlag, readBodyFileFlag := flags.ProposalBodyFile()
+	addStackFlag, readStackFlag := flags.Stack("propose all branches in the stack via the API")
 	cmd := cobra.Command{
 		Use:     "new-pull-request",
 		GroupID: "basic",
@@ -26,16 +28,18 @@ func newPullRequestCommand() *cobra.Command {
 		Long:    cmdhelpers.Long(proposeDesc, fmt.Sprintf(proposeHelp, configdomain.KeyHostingPlatform, configdomain.KeyHostingOriginHostname)),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			printDeprecationNotice()
-			result := executePropose(readDryRunFlag(cmd), readVerboseFlag(cmd), readTitleFlag(cmd), readBodyFlag(cmd), readBodyFileFlag(cmd))
+			result := executePropose(readDryRunFlag(cmd), readVerboseFlag(cmd), readAPIFlag(cmd), readStackFlag(cmd), readTitleFlag(cmd), readBodyFlag(cmd), readBodyFileFlag(cmd), proposalMetadataArgs{})
 			printDeprecationNotice()
 			return result
 		},
 	}
+	addAPIFlag(&cmd)
 	addDryRunFlag(&cmd)
 	addVerboseFlag(&cmd)
 	addTitleFlag(&cmd)
 	addBodyFlag(&cmd)
 	addBodyFileFlag(&cmd)
+	addStackFlag(&cmd)
 	return &cmd
 }
 
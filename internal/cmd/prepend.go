@@ -211,13 +211,14 @@ func prependProgram(data prependData) program.Program {
 	if !data.hasOpenChanges {
 		for _, branchToSync := range data.branchesToSync {
 			sync.BranchProgram(branchToSync.BranchInfo, sync.BranchProgramArgs{
-				BranchInfos:        data.allBranches,
-				Config:             data.config.Config,
-				FirstCommitMessage: branchToSync.FirstCommitMessage,
-				InitialBranch:      data.initialBranch,
-				Program:            prog,
-				PushBranches:       true,
-				Remotes:            data.remotes,
+				BranchInfos:         data.allBranches,
+				Config:              data.config.Config,
+				FirstCommitMessage:  branchToSync.FirstCommitMessage,
+				InitialBranch:       data.initialBranch,
+				Program:             prog,
+				PushBranches:        true,
+				Remotes:             data.remotes,
+				SyncWithoutCheckout: false,
 			})
 		}
 	}
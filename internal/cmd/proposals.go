@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/cli/flags"
+	"github.com/git-town/git-town/v16/internal/cli/format"
+	"github.com/git-town/git-town/v16/internal/cli/print"
+	"github.com/git-town/git-town/v16/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/execute"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/hosting"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const proposalsDesc = "Display the proposals of all branches in the lineage"
+
+const proposalsHelp = `
+Looks up the proposal of each branch that has a parent branch and displays them as a tree.
+
+Supported for repositories hosted on GitHub, GitLab, Gitea, Bitbucket, and Azure DevOps. On GitHub, GitLab, and Gitea it also finds proposals that target a different branch than the parent branch and warns about them. On Bitbucket and Azure DevOps it only finds proposals that target the parent branch.`
+
+func proposalsCommand() *cobra.Command {
+	addJSONFlag, readJSONFlag := flags.JSON()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "proposals",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   proposalsDesc,
+		Long:    cmdhelpers.Long(proposalsDesc, proposalsHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeProposals(readJSONFlag(cmd), readVerboseFlag(cmd))
+		},
+	}
+	addJSONFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeProposals(jsonOutput configdomain.JSONOutput, verbose configdomain.Verbose) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		PrintBranchNames: !jsonOutput.Enabled(),
+		PrintCommands:    !jsonOutput.Enabled(),
+		ValidateGitRepo:  true,
+		ValidateIsOnline: true,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	connector, err := determineProposalsConnector(repo, jsonOutput)
+	if err != nil {
+		return err
+	}
+	lineage := repo.UnvalidatedConfig.Config.Value.Lineage
+	entries, err := loadProposalsEntries(connector, lineage)
+	if err != nil {
+		return err
+	}
+	if jsonOutput.Enabled() {
+		encoded, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(encoded))
+		return nil
+	}
+	fmt.Println()
+	fmt.Println(proposalsTree(entries, lineage))
+	print.Footer(verbose, repo.CommandsCounter.Get(), repo.FinalMessages.Result())
+	return nil
+}
+
+func determineProposalsConnector(repo execute.OpenRepoResult, jsonOutput configdomain.JSONOutput) (hostingdomain.Connector, error) {
+	remoteURL, hasRemoteURL := repo.UnvalidatedConfig.ProposalRemoteURL().Get()
+	if !hasRemoteURL {
+		return nil, hostingdomain.UnsupportedServiceError()
+	}
+	log := print.Logger{}
+	if jsonOutput.Enabled() {
+		log = print.QuietLogger()
+	}
+	connectorOpt, err := hosting.NewConnector(hosting.NewConnectorArgs{
+		Backend:         repo.Backend,
+		Config:          repo.UnvalidatedConfig.Config.Get(),
+		Git:             repo.Git,
+		HostingPlatform: repo.UnvalidatedConfig.Config.Value.HostingPlatform,
+		Log:             log,
+		PushRemoteURL:   repo.UnvalidatedConfig.PushRemoteURL(),
+		RemoteURL:       remoteURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	connector, hasConnector := connectorOpt.Get()
+	if !hasConnector {
+		return nil, hostingdomain.UnsupportedServiceError()
+	}
+	return connector, nil
+}
+
+// loadProposalsEntries looks up the proposals of all branches in the given lineage.
+// Connectors that can search proposals independent of their target also find proposals
+// that target a different branch than the parent branch.
+func loadProposalsEntries(connector hostingdomain.Connector, lineage configdomain.Lineage) ([]proposalsEntry, error) {
+	result := []proposalsEntry{}
+	for _, lineageEntry := range lineage.Entries() {
+		var proposalOpt Option[hostingdomain.Proposal]
+		var err error
+		if searcher, canSearch := connector.(hostingdomain.ProposalSearcher); canSearch {
+			proposalOpt, err = searcher.SearchProposal(lineageEntry.Child)
+		} else {
+			proposalOpt, err = connector.FindProposal(lineageEntry.Child, lineageEntry.Parent)
+		}
+		if err != nil {
+			return result, fmt.Errorf(messages.ProposalNotFoundForBranch, lineageEntry.Child, err)
+		}
+		entry := proposalsEntry{
+			Branch:   lineageEntry.Child,
+			Parent:   lineageEntry.Parent,
+			Proposal: None[proposalsEntryProposal](),
+		}
+		if proposal, hasProposal := proposalOpt.Get(); hasProposal {
+			if statusLoader, canLoadStatus := connector.(hostingdomain.ProposalStatusLoader); canLoadStatus {
+				proposal, err = statusLoader.LoadProposalStatus(proposal)
+				if err != nil {
+					return result, err
+				}
+			}
+			entry.Proposal = Some(proposalsEntryProposal{
+				Checks:         proposal.Checks,
+				Draft:          proposal.Draft,
+				Number:         proposal.Number,
+				Review:         proposal.Review,
+				Target:         proposal.Target,
+				TargetMismatch: proposal.Target != lineageEntry.Parent,
+				Title:          proposal.Title,
+				URL:            proposal.URL,
+			})
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+// proposalsTree provides the given proposals as a printable tree in the shape of the given lineage.
+func proposalsTree(entries []proposalsEntry, lineage configdomain.Lineage) string {
+	if len(lineage.Roots()) == 0 {
+		return messages.ProposalsNoBranches
+	}
+	return format.BranchLineageWithLabels(lineage, func(branch gitdomain.LocalBranchName) string {
+		for _, entry := range entries {
+			if entry.Branch == branch {
+				return entry.String()
+			}
+		}
+		return branch.String()
+	})
+}
+
+// proposalsEntry describes the proposal of a branch in the lineage.
+type proposalsEntry struct {
+	Branch   gitdomain.LocalBranchName      `json:"branch"`
+	Parent   gitdomain.LocalBranchName      `json:"parent"`
+	Proposal Option[proposalsEntryProposal] `json:"proposal"`
+}
+
+func (self proposalsEntry) String() string {
+	proposal, hasProposal := self.Proposal.Get()
+	if !hasProposal {
+		return fmt.Sprintf(messages.ProposalsBranchNone, self.Branch)
+	}
+	details := []string{"into " + proposal.Target.String()}
+	switch {
+	case proposal.Draft:
+		details = append(details, "draft")
+	case proposal.Review != hostingdomain.ProposalReviewUnknown && proposal.Review != hostingdomain.ProposalReviewNone:
+		details = append(details, proposal.Review.String())
+	}
+	result := fmt.Sprintf(messages.ProposalsBranch, self.Branch, proposal.Number, proposal.Title, strings.Join(details, ", "))
+	if proposal.TargetMismatch {
+		result += "\n" + format.Indent(fmt.Sprintf(messages.ProposalsTargetMismatch, proposal.Target, self.Parent))
+	}
+	return result
+}
+
+// proposalsEntryProposal contains the details of a proposal that the proposals command displays.
+type proposalsEntryProposal struct {
+	Checks         hostingdomain.ProposalChecks `json:"checks"`
+	Draft          bool                         `json:"draft"`
+	Number         int                          `json:"number"`
+	Review         hostingdomain.ProposalReview `json:"review"`
+	Target         gitdomain.LocalBranchName    `json:"target"`
+	TargetMismatch bool                         `json:"targetMismatch"`
+	Title          string                       `json:"title"`
+	URL            string                       `json:"url"`
+}
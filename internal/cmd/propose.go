@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/git-town/git-town/v16/internal/browser"
 	"github.com/git-town/git-town/v16/internal/cli/dialog/components"
@@ -14,7 +15,9 @@ import (
 	"github.com/git-town/git-town/v16/internal/config"
 	"github.com/git-town/git-town/v16/internal/config/configdomain"
 	"github.com/git-town/git-town/v16/internal/execute"
+	"github.com/git-town/git-town/v16/internal/git"
 	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/gohacks/slice"
 	"github.com/git-town/git-town/v16/internal/hosting"
 	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
 	"github.com/git-town/git-town/v16/internal/messages"
@@ -38,14 +41,28 @@ Syncs the current branch and opens a browser window to the new proposal page of
 
 The form is pre-populated for the current branch so that the proposal only shows the changes made against the immediate parent branch.
 
+With --stack, proposes all branches in the stack of the current branch through the API of your hosting platform. Each proposal targets the parent branch of its branch. Existing proposals that target a different branch get updated to target the parent branch. Prints a table of all proposals at the end.
+
+With --api, creates the proposal through the API of your hosting platform instead and prints its URL. This works without a browser, for example over SSH or in dev containers. If the branch already has a proposal, prints the URL of that proposal. Without --title, the proposal uses the first commit message in the branch as its title. The --api mode is supported for GitHub, GitLab and Gitea.
+
+Without --body or --body-file, pre-populates the body with the proposal template of your hosting platform in the main branch. With the "proposal-body" setting set to "commits", pre-populates the body with the commits in the branch and the title with its first commit message instead.
+
+The --reviewer, --label, and --assignee flags can be given multiple times. Together with --draft they get applied through the API of GitHub, GitLab and Gitea. Without --api, they get encoded in the URL of the new proposal page where the hosting platform supports it. Defaults for them can be configured in the "propose" section of the configuration file. Proposals for prototype branches are always created as drafts.
+
 Supported only for repositories hosted on GitHub, GitLab, Gitea and Bitbucket. When using self-hosted versions this command needs to be configured with "git config %s <driver>" where driver is "github", "gitlab", "gitea", or "bitbucket". When using SSH identities, this command needs to be configured with "git config %s <hostname>" where hostname matches what is in your ssh config file.`
 
 func proposeCommand() *cobra.Command {
+	addAPIFlag, readAPIFlag := flags.API("create the proposal via the API and print its URL")
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
 	addTitleFlag, readTitleFlag := flags.ProposalTitle()
 	addBodyFlag, readBodyFlag := flags.ProposalBody()
 	addBodyFileFlag, readBodyFileFlag := flags.ProposalBodyFile()
+	addStackFlag, readStackFlag := flags.Stack("propose all branches in the stack via the API")
+	addAssigneesFlag, readAssigneesFlag := flags.ProposalAssignees()
+	addDraftFlag, readDraftFlag := flags.ProposalDraft()
+	addLabelsFlag, readLabelsFlag := flags.ProposalLabels()
+	addReviewersFlag, readReviewersFlag := flags.ProposalReviewers()
 	cmd := cobra.Command{
 		Use:     proposeCmd,
 		GroupID: "basic",
@@ -53,18 +70,38 @@ func proposeCommand() *cobra.Command {
 		Short:   proposeDesc,
 		Long:    cmdhelpers.Long(proposeDesc, fmt.Sprintf(proposeHelp, configdomain.KeyHostingPlatform, configdomain.KeyHostingOriginHostname)),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executePropose(readDryRunFlag(cmd), readVerboseFlag(cmd), readTitleFlag(cmd), readBodyFlag(cmd), readBodyFileFlag(cmd))
+			metadataArgs := proposalMetadataArgs{
+				assignees: readAssigneesFlag(cmd),
+				draft:     readDraftFlag(cmd),
+				labels:    readLabelsFlag(cmd),
+				reviewers: readReviewersFlag(cmd),
+			}
+			return executePropose(readDryRunFlag(cmd), readVerboseFlag(cmd), readAPIFlag(cmd), readStackFlag(cmd), readTitleFlag(cmd), readBodyFlag(cmd), readBodyFileFlag(cmd), metadataArgs)
 		},
 	}
+	addAPIFlag(&cmd)
 	addDryRunFlag(&cmd)
 	addVerboseFlag(&cmd)
 	addTitleFlag(&cmd)
 	addBodyFlag(&cmd)
 	addBodyFileFlag(&cmd)
+	addStackFlag(&cmd)
+	addAssigneesFlag(&cmd)
+	addDraftFlag(&cmd)
+	addLabelsFlag(&cmd)
+	addReviewersFlag(&cmd)
 	return &cmd
 }
 
-func executePropose(dryRun configdomain.DryRun, verbose configdomain.Verbose, title gitdomain.ProposalTitle, body gitdomain.ProposalBody, bodyFile gitdomain.ProposalBodyFile) error {
+// proposalMetadataArgs contains the proposal metadata provided via CLI flags
+type proposalMetadataArgs struct {
+	assignees []string
+	draft     configdomain.ProposeDraft
+	labels    []string
+	reviewers []string
+}
+
+func executePropose(dryRun configdomain.DryRun, verbose configdomain.Verbose, useAPI configdomain.UseAPI, fullStack configdomain.FullStack, title gitdomain.ProposalTitle, body gitdomain.ProposalBody, bodyFile gitdomain.ProposalBodyFile, metadataArgs proposalMetadataArgs) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		PrintBranchNames: true,
@@ -76,11 +113,15 @@ func executePropose(dryRun configdomain.DryRun, verbose configdomain.Verbose, ti
 	if err != nil {
 		return err
 	}
-	data, exit, err := determineProposeData(repo, dryRun, verbose, title, body, bodyFile)
+	data, exit, err := determineProposeData(repo, dryRun, verbose, useAPI, fullStack, title, body, bodyFile, metadataArgs)
 	if err != nil || exit {
 		return err
 	}
 	if existingProposalURL, hasExistingProposal := data.existingProposalURL.Get(); hasExistingProposal {
+		if useAPI.Enabled() {
+			fmt.Println(existingProposalURL)
+			return nil
+		}
 		browser.Open(existingProposalURL, repo.Frontend, repo.Backend)
 		return nil
 	}
@@ -122,22 +163,27 @@ type proposeData struct {
 	branchToPropose     gitdomain.LocalBranchName
 	branchTypeToPropose configdomain.BranchType
 	branchesSnapshot    gitdomain.BranchesSnapshot
+	branchesToPropose   gitdomain.LocalBranchNames
 	branchesToSync      []configdomain.BranchToSync
 	config              config.ValidatedConfig
 	connector           Option[hostingdomain.Connector]
 	dialogTestInputs    components.TestInputs
 	dryRun              configdomain.DryRun
 	existingProposalURL Option[string]
+	fullStack           configdomain.FullStack
 	hasOpenChanges      bool
 	initialBranch       gitdomain.LocalBranchName
 	previousBranch      Option[gitdomain.LocalBranchName]
 	proposalBody        gitdomain.ProposalBody
+	proposalMetadata    hostingdomain.ProposalMetadata
 	proposalTitle       gitdomain.ProposalTitle
 	remotes             gitdomain.Remotes
+	stackProposalBodies map[gitdomain.LocalBranchName]gitdomain.ProposalBody
 	stashSize           gitdomain.StashSize
+	useAPI              configdomain.UseAPI
 }
 
-func determineProposeData(repo execute.OpenRepoResult, dryRun configdomain.DryRun, verbose configdomain.Verbose, title gitdomain.ProposalTitle, body gitdomain.ProposalBody, bodyFile gitdomain.ProposalBodyFile) (data proposeData, exit bool, err error) {
+func determineProposeData(repo execute.OpenRepoResult, dryRun configdomain.DryRun, verbose configdomain.Verbose, useAPI configdomain.UseAPI, fullStack configdomain.FullStack, title gitdomain.ProposalTitle, body gitdomain.ProposalBody, bodyFile gitdomain.ProposalBodyFile, metadataArgs proposalMetadataArgs) (data proposeData, exit bool, err error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
 	if err != nil {
@@ -198,12 +244,15 @@ func determineProposeData(repo execute.OpenRepoResult, dryRun configdomain.DryRu
 		return data, false, fmt.Errorf(messages.ProposalNoParent, branchToPropose)
 	}
 	var connectorOpt Option[hostingdomain.Connector]
-	if originURL, hasOriginURL := validatedConfig.OriginURL().Get(); hasOriginURL {
+	if remoteURL, hasRemoteURL := validatedConfig.ProposalRemoteURL().Get(); hasRemoteURL {
 		connectorOpt, err = hosting.NewConnector(hosting.NewConnectorArgs{
+			Backend:         repo.Backend,
 			Config:          *validatedConfig.Config.UnvalidatedConfig,
+			Git:             repo.Git,
 			HostingPlatform: validatedConfig.Config.HostingPlatform,
 			Log:             print.Logger{},
-			RemoteURL:       originURL,
+			PushRemoteURL:   validatedConfig.PushRemoteURL(),
+			RemoteURL:       remoteURL,
 		})
 		if err != nil {
 			return data, false, err
@@ -214,14 +263,32 @@ func determineProposeData(repo execute.OpenRepoResult, dryRun configdomain.DryRu
 	if !hasConnector {
 		return data, false, hostingdomain.UnsupportedServiceError()
 	}
-	existingProposalOpt, err := connector.FindProposal(initialBranch, parentOfBranchToPropose)
-	if err != nil {
-		existingProposalOpt = None[hostingdomain.Proposal]()
+	if useAPI.Enabled() || fullStack.Enabled() {
+		if _, canCreateProposals := connector.(hostingdomain.ProposalCreator); !canCreateProposals {
+			return data, false, errors.New(messages.ProposalCreateAPIUnsupported)
+		}
 	}
-	if existingProposal, hasExistingProposal := existingProposalOpt.Get(); hasExistingProposal {
-		existingProposalURL = Some(existingProposal.URL)
+	branchesToPropose := gitdomain.LocalBranchNames{branchToPropose}
+	if fullStack.Enabled() {
+		if _, canSearchProposals := connector.(hostingdomain.ProposalSearcher); !canSearchProposals {
+			return data, false, errors.New(messages.ProposalCreateAPIUnsupported)
+		}
+		branchesToPropose = gitdomain.LocalBranchNames{}
+		for _, branch := range validatedConfig.Config.Lineage.BranchLineageWithoutRoot(branchToPropose) {
+			if validateBranchTypeToPropose(validatedConfig.Config.BranchType(branch)) == nil {
+				branchesToPropose = append(branchesToPropose, branch)
+			}
+		}
+	} else {
+		existingProposalOpt, err := connector.FindProposal(initialBranch, parentOfBranchToPropose)
+		if err != nil {
+			existingProposalOpt = None[hostingdomain.Proposal]()
+		}
+		if existingProposal, hasExistingProposal := existingProposalOpt.Get(); hasExistingProposal {
+			existingProposalURL = Some(existingProposal.URL)
+		}
 	}
-	branchNamesToSync := validatedConfig.Config.Lineage.BranchAndAncestors(branchToPropose)
+	branchNamesToSync := validatedConfig.Config.Lineage.BranchesAndAncestors(branchesToPropose)
 	branchesToSync, err := branchesToSync(branchNamesToSync, branchesSnapshot, repo, validatedConfig.Config.MainBranch)
 	if err != nil {
 		return data, false, err
@@ -244,24 +311,67 @@ func determineProposeData(repo execute.OpenRepoResult, dryRun configdomain.DryRu
 			bodyText = gitdomain.ProposalBody(fileData)
 		}
 	}
+	proposalBodyArgs := defaultProposalBodyArgs{
+		backend:  repo.Backend,
+		config:   validatedConfig.Config,
+		git:      repo.Git,
+		platform: None[configdomain.HostingPlatform](),
+	}
+	if remoteURL, hasRemoteURL := validatedConfig.ProposalRemoteURL().Get(); hasRemoteURL {
+		proposalBodyArgs.platform = hosting.Detect(remoteURL, validatedConfig.Config.HostingPlatform)
+	}
+	if bodyText == "" {
+		bodyText, err = defaultProposalBody(proposalBodyArgs, branchToPropose, parentOfBranchToPropose)
+		if err != nil {
+			return data, false, err
+		}
+	}
+	if title == "" && (validatedConfig.Config.ProposalBodySource == configdomain.ProposalBodySourceCommits || useAPI.Enabled()) {
+		// the API of hosting platforms requires a title
+		title, err = firstCommitTitle(repo, branchToPropose, parentOfBranchToPropose)
+		if err != nil {
+			return data, false, err
+		}
+	}
+	if useAPI.Enabled() && existingProposalURL.IsNone() && strings.TrimSpace(title.String()) == "" {
+		return data, false, errors.New(messages.ProposalTitleMissing)
+	}
+	stackProposalBodies := map[gitdomain.LocalBranchName]gitdomain.ProposalBody{}
+	if fullStack.Enabled() {
+		for _, branch := range branchesToPropose {
+			parent, hasParent := validatedConfig.Config.Lineage.Parent(branch).Get()
+			if !hasParent {
+				continue
+			}
+			stackProposalBodies[branch], err = defaultProposalBody(proposalBodyArgs, branch, parent)
+			if err != nil {
+				return data, false, err
+			}
+		}
+	}
 	return proposeData{
 		allBranches:         branchesSnapshot.Branches,
 		branchToPropose:     branchToPropose,
 		branchTypeToPropose: branchTypeToPropose,
 		branchesSnapshot:    branchesSnapshot,
+		branchesToPropose:   branchesToPropose,
 		branchesToSync:      branchesToSync,
 		config:              validatedConfig,
 		connector:           connectorOpt,
 		dialogTestInputs:    dialogTestInputs,
 		dryRun:              dryRun,
 		existingProposalURL: existingProposalURL,
+		fullStack:           fullStack,
 		hasOpenChanges:      repoStatus.OpenChanges,
 		initialBranch:       initialBranch,
 		previousBranch:      previousBranch,
 		proposalBody:        bodyText,
+		proposalMetadata:    determineProposalMetadata(metadataArgs, validatedConfig.Config.UnvalidatedConfig),
 		proposalTitle:       title,
 		remotes:             remotes,
+		stackProposalBodies: stackProposalBodies,
 		stashSize:           stashSize,
+		useAPI:              useAPI,
 	}, false, err
 }
 
@@ -269,16 +379,19 @@ func proposeProgram(data proposeData) program.Program {
 	prog := NewMutable(&program.Program{})
 	for _, branchToSync := range data.branchesToSync {
 		sync.BranchProgram(branchToSync.BranchInfo, sync.BranchProgramArgs{
-			BranchInfos:        data.allBranches,
-			Config:             data.config.Config,
-			FirstCommitMessage: branchToSync.FirstCommitMessage,
-			InitialBranch:      data.initialBranch,
-			Remotes:            data.remotes,
-			Program:            prog,
-			PushBranches:       true,
+			BranchInfos:         data.allBranches,
+			Config:              data.config.Config,
+			FirstCommitMessage:  branchToSync.FirstCommitMessage,
+			InitialBranch:       data.initialBranch,
+			Remotes:             data.remotes,
+			Program:             prog,
+			PushBranches:        true,
+			SyncWithoutCheckout: false,
 		})
 	}
-	if data.branchTypeToPropose == configdomain.BranchTypePrototypeBranch {
+	if data.fullStack.Enabled() {
+		pushUnsyncedStackBranches(prog, data)
+	} else if data.branchTypeToPropose == configdomain.BranchTypePrototypeBranch {
 		prog.Value.Add(&opcodes.RemoveFromPrototypeBranches{Branch: data.branchToPropose})
 	}
 	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{data.previousBranch}
@@ -288,15 +401,148 @@ func proposeProgram(data proposeData) program.Program {
 		StashOpenChanges:         data.hasOpenChanges,
 		PreviousBranchCandidates: previousBranchCandidates,
 	})
-	prog.Value.Add(&opcodes.CreateProposal{
-		Branch:        data.branchToPropose,
-		MainBranch:    data.config.Config.MainBranch,
-		ProposalBody:  data.proposalBody,
-		ProposalTitle: data.proposalTitle,
-	})
+	switch {
+	case data.fullStack.Enabled():
+		for _, branchToPropose := range data.branchesToPropose {
+			prog.Value.Add(&opcodes.ConnectorSubmitProposal{
+				Branch:        branchToPropose,
+				Metadata:      branchProposalMetadata(data, branchToPropose),
+				ProposalBody:  data.stackProposalBodies[branchToPropose],
+				ProposalTitle: stackProposalTitle(branchToPropose, data.branchesToSync),
+			})
+		}
+		prog.Value.Add(&opcodes.ConnectorListProposals{Branches: data.branchesToPropose})
+	case data.useAPI.Enabled():
+		prog.Value.Add(&opcodes.ConnectorCreateProposal{
+			Branch:        data.branchToPropose,
+			Metadata:      branchProposalMetadata(data, data.branchToPropose),
+			ProposalBody:  data.proposalBody,
+			ProposalTitle: data.proposalTitle,
+		})
+	default:
+		prog.Value.Add(&opcodes.CreateProposal{
+			Branch:        data.branchToPropose,
+			MainBranch:    data.config.Config.MainBranch,
+			Metadata:      branchProposalMetadata(data, data.branchToPropose),
+			ProposalBody:  data.proposalBody,
+			ProposalTitle: data.proposalTitle,
+		})
+	}
+	cmdhelpers.UpdateStackSections(prog.Value, data.config.Config, data.branchesToPropose)
 	return prog.Get()
 }
 
+// branchProposalMetadata provides the metadata for the new proposal of the given branch.
+// Proposals for prototype branches are drafts because the branch hasn't been shared before.
+func branchProposalMetadata(data proposeData, branch gitdomain.LocalBranchName) hostingdomain.ProposalMetadata {
+	result := data.proposalMetadata
+	if data.config.Config.BranchType(branch) == configdomain.BranchTypePrototypeBranch {
+		result.Draft = true
+	}
+	return result
+}
+
+// defaultProposalBodyArgs contains the information needed to determine the body of new proposals.
+type defaultProposalBodyArgs struct {
+	backend  gitdomain.RunnerQuerier
+	config   configdomain.ValidatedConfig
+	git      git.Commands
+	platform Option[configdomain.HostingPlatform]
+}
+
+// defaultProposalBody provides the body for a new proposal of the given branch when the user doesn't provide one:
+// a list of the commits in the branch or the content of the proposal template of the hosting platform.
+func defaultProposalBody(args defaultProposalBodyArgs, branch, parent gitdomain.LocalBranchName) (gitdomain.ProposalBody, error) {
+	switch args.config.ProposalBodySource {
+	case configdomain.ProposalBodySourceCommits:
+		commits, err := args.git.CommitsInFeatureBranch(args.backend, branch, parent)
+		if err != nil {
+			return "", err
+		}
+		lines := make([]string, len(commits))
+		for c, commit := range commits {
+			lines[c] = "- " + commit.Message.String()
+		}
+		return gitdomain.ProposalBody(strings.Join(lines, "\n")), nil
+	case configdomain.ProposalBodySourceTemplate:
+		platform, hasPlatform := args.platform.Get()
+		if !hasPlatform {
+			return "", nil
+		}
+		templatePath, hasTemplatePath := hosting.ProposalTemplatePath(platform).Get()
+		if !hasTemplatePath {
+			return "", nil
+		}
+		// hosting platforms use the template in the main branch
+		content, hasContent := args.git.FileContent(args.backend, args.config.MainBranch.BranchName(), templatePath).Get()
+		if !hasContent {
+			return "", nil
+		}
+		return gitdomain.ProposalBody(content), nil
+	}
+	return "", nil
+}
+
+// determineProposalMetadata merges the proposal metadata provided via CLI flags into the configured defaults.
+func determineProposalMetadata(args proposalMetadataArgs, config *configdomain.UnvalidatedConfig) hostingdomain.ProposalMetadata {
+	return hostingdomain.ProposalMetadata{
+		Assignees: slice.AppendAllMissing(slice.AppendAllMissing([]string{}, config.ProposeAssignees...), args.assignees...),
+		Draft:     args.draft.Enabled() || config.ProposeDraft.Enabled(),
+		Labels:    slice.AppendAllMissing(slice.AppendAllMissing([]string{}, config.ProposeLabels...), args.labels...),
+		Reviewers: slice.AppendAllMissing(slice.AppendAllMissing([]string{}, config.ProposeReviewers...), args.reviewers...),
+	}
+}
+
+// firstCommitTitle provides the subject of the first commit in the given branch as the title for its proposal.
+func firstCommitTitle(repo execute.OpenRepoResult, branch, parent gitdomain.LocalBranchName) (gitdomain.ProposalTitle, error) {
+	firstCommitMessage, err := repo.Git.FirstCommitMessageInBranch(repo.Backend, branch.BranchName(), parent.BranchName())
+	if err != nil {
+		return "", err
+	}
+	if message, hasMessage := firstCommitMessage.Get(); hasMessage {
+		return gitdomain.ProposalTitle(message.Parts().Subject), nil
+	}
+	return "", nil
+}
+
+// pushUnsyncedStackBranches pushes the branches in the proposed stack that the sync program doesn't push:
+// prototype branches, which it converts into feature branches, and parked branches other than the initial branch.
+func pushUnsyncedStackBranches(prog Mutable[program.Program], data proposeData) {
+	for _, branchToPropose := range data.branchesToPropose {
+		switch data.config.Config.BranchType(branchToPropose) {
+		case configdomain.BranchTypePrototypeBranch:
+			prog.Value.Add(&opcodes.RemoveFromPrototypeBranches{Branch: branchToPropose})
+		case configdomain.BranchTypeParkedBranch:
+			if branchToPropose == data.initialBranch {
+				continue
+			}
+		case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypePerennialBranch:
+			continue
+		}
+		branchInfo, hasBranchInfo := data.allBranches.FindByLocalName(branchToPropose).Get()
+		if hasBranchInfo && branchInfo.HasTrackingBranch() {
+			prog.Value.Add(&opcodes.Checkout{Branch: branchToPropose})
+			prog.Value.Add(&opcodes.PushCurrentBranch{CurrentBranch: branchToPropose})
+		} else {
+			prog.Value.Add(&opcodes.CreateTrackingBranch{Branch: branchToPropose})
+		}
+	}
+	prog.Value.Add(&opcodes.Checkout{Branch: data.initialBranch})
+}
+
+// stackProposalTitle provides the title for a new proposal of the given branch
+// when proposing an entire stack: the subject of the first commit in the branch, or the branch name.
+func stackProposalTitle(branch gitdomain.LocalBranchName, branchesToSync []configdomain.BranchToSync) gitdomain.ProposalTitle {
+	for _, branchToSync := range branchesToSync {
+		if branchToSync.BranchInfo.LocalName.Equal(Some(branch)) {
+			if firstCommitMessage, hasFirstCommitMessage := branchToSync.FirstCommitMessage.Get(); hasFirstCommitMessage {
+				return gitdomain.ProposalTitle(firstCommitMessage.Parts().Subject)
+			}
+		}
+	}
+	return gitdomain.ProposalTitle(branch.String())
+}
+
 func validateBranchTypeToPropose(branchType configdomain.BranchType) error {
 	switch branchType {
 	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
@@ -250,6 +250,10 @@ func renameBranchProgram(data renameBranchData) program.Program {
 					result.Value.Add(&opcodes.SetParent{Branch: data.newBranch, Parent: parentBranch})
 				}
 				result.Value.Add(&opcodes.DeleteParentBranch{Branch: oldLocalBranch})
+				if override, hasOverride := data.config.LocalGitConfig.SyncStrategyOverrides.ForBranchName(oldLocalBranch).Get(); hasOverride {
+					result.Value.Add(&opcodes.SetSyncStrategyOverride{Branch: data.newBranch, Strategy: override.Strategy})
+					result.Value.Add(&opcodes.RemoveSyncStrategyOverride{Branch: oldLocalBranch})
+				}
 			}
 		}
 		for _, child := range data.config.Config.Lineage.Children(oldLocalBranch) {
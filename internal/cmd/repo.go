@@ -73,9 +73,12 @@ func determineRepoData(args []string, repo execute.OpenRepoResult) (data repoDat
 	var connectorOpt Option[hostingdomain.Connector]
 	if remoteURL, hasRemoteURL := repo.UnvalidatedConfig.RemoteURL(remote).Get(); hasRemoteURL {
 		connectorOpt, err = hosting.NewConnector(hosting.NewConnectorArgs{
+			Backend:         repo.Backend,
 			Config:          repo.UnvalidatedConfig.Config.Get(),
+			Git:             repo.Git,
 			HostingPlatform: repo.UnvalidatedConfig.Config.Value.HostingPlatform,
 			Log:             print.Logger{},
+			PushRemoteURL:   repo.UnvalidatedConfig.PushRemoteURL(),
 			RemoteURL:       remoteURL,
 		})
 		if err != nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/git-town/git-town/v16/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
 	"github.com/git-town/git-town/v16/internal/git/gitdomain"
 	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
 	"github.com/git-town/git-town/v16/internal/messages"
@@ -21,7 +22,7 @@ type shipDataAPI struct {
 	proposalMessage        string
 }
 
-func determineAPIData(sharedData sharedShipData) (result shipDataAPI, err error) {
+func determineAPIData(sharedData sharedShipData, force configdomain.Force, autoMerge configdomain.ShipAutoMerge) (result shipDataAPI, err error) {
 	branchToShipRemoteName, hasRemoteBranchToShip := sharedData.branchToShip.RemoteName.Get()
 	if !hasRemoteBranchToShip {
 		return result, fmt.Errorf(messages.ShipAPINoRemoteBranch, sharedData.branchNameToShip)
@@ -30,6 +31,15 @@ func determineAPIData(sharedData sharedShipData) (result shipDataAPI, err error)
 	if !hasConnector {
 		return result, errors.New(messages.ShipAPIConnectorRequired)
 	}
+	if autoMerge.Enabled() {
+		if _, canAutoMerge := connector.(hostingdomain.ProposalAutoMerger); !canAutoMerge {
+			return result, errors.New(messages.ShipAPIAutoMergeUnsupported)
+		}
+	} else if shipStrategy := sharedData.config.Config.ShipStrategy; shipStrategy != configdomain.ShipStrategyAPI {
+		if _, canMerge := connector.(hostingdomain.ProposalMerger); !canMerge {
+			return result, fmt.Errorf(messages.ShipAPIStrategyUnsupported, shipStrategy)
+		}
+	}
 	proposalOpt, err := connector.FindProposal(sharedData.branchNameToShip, sharedData.targetBranchName)
 	if err != nil {
 		return result, err
@@ -38,6 +48,16 @@ func determineAPIData(sharedData sharedShipData) (result shipDataAPI, err error)
 	if !hasProposal {
 		return result, fmt.Errorf(messages.ShipAPINoProposal, sharedData.branchNameToShip)
 	}
+	if statusLoader, canLoadStatus := connector.(hostingdomain.ProposalStatusLoader); canLoadStatus && force.IsFalse() {
+		proposal, err = statusLoader.LoadProposalStatus(proposal)
+		if err != nil {
+			return result, err
+		}
+		err = validateProposalStatus(proposal, sharedData.branchNameToShip, autoMerge)
+		if err != nil {
+			return result, err
+		}
+	}
 	proposalMessage := connector.DefaultProposalMessage(proposal)
 	return shipDataAPI{
 		branchToShipRemoteName: branchToShipRemoteName,
@@ -58,6 +78,7 @@ func shipAPIProgram(sharedData sharedShipData, apiData shipDataAPI, commitMessag
 		ProposalNumber:  apiData.proposal.Number,
 		CommitMessage:   commitMessage,
 		ProposalMessage: apiData.proposalMessage,
+		ShipStrategy:    sharedData.config.Config.ShipStrategy,
 	})
 	if sharedData.config.Config.ShipDeleteTrackingBranch {
 		prog.Value.Add(&opcodes.DeleteTrackingBranch{Branch: apiData.branchToShipRemoteName})
@@ -67,6 +88,7 @@ func shipAPIProgram(sharedData sharedShipData, apiData shipDataAPI, commitMessag
 	}
 	if !sharedData.dryRun {
 		prog.Value.Add(&opcodes.DeleteParentBranch{Branch: branchToShipLocal})
+		prog.Value.Add(&opcodes.RemoveSyncStrategyOverride{Branch: branchToShipLocal})
 	}
 	for _, child := range sharedData.childBranches {
 		prog.Value.Add(&opcodes.ChangeParent{Branch: child, Parent: localTargetBranch})
@@ -80,3 +102,36 @@ func shipAPIProgram(sharedData sharedShipData, apiData shipDataAPI, commitMessag
 	})
 	return prog.Get()
 }
+
+// shipAPIAutoMergeProgram enables auto-merge for the proposal of the branch to ship.
+// It leaves all local branches in place since the hosting platform merges the proposal later.
+// "git town sync" removes the branch once the proposal is merged.
+func shipAPIAutoMergeProgram(sharedData sharedShipData, apiData shipDataAPI, commitMessage Option[gitdomain.CommitMessage]) program.Program {
+	return program.Program{
+		&opcodes.ConnectorEnableAutoMerge{
+			Branch:               sharedData.branchNameToShip,
+			CommitMessage:        commitMessage,
+			DeleteTrackingBranch: sharedData.config.Config.ShipDeleteTrackingBranch,
+			ProposalNumber:       apiData.proposal.Number,
+			ShipStrategy:         sharedData.config.Config.ShipStrategy,
+		},
+	}
+}
+
+// validateProposalStatus ensures that the CI checks and reviews of the given proposal allow shipping it.
+// Auto-merging proposals wait for the missing approvals, so they don't need them yet.
+func validateProposalStatus(proposal hostingdomain.Proposal, branch gitdomain.LocalBranchName, autoMerge configdomain.ShipAutoMerge) error {
+	if proposal.Checks == hostingdomain.ProposalChecksFailure {
+		return fmt.Errorf(messages.ShipAPIProposalChecksFailed, branch)
+	}
+	switch proposal.Review {
+	case hostingdomain.ProposalReviewChangesRequested:
+		return fmt.Errorf(messages.ShipAPIProposalChangesRequest, branch)
+	case hostingdomain.ProposalReviewRequired:
+		if !autoMerge.Enabled() {
+			return fmt.Errorf(messages.ShipAPIProposalNotApproved, branch)
+		}
+	case hostingdomain.ProposalReviewApproved, hostingdomain.ProposalReviewNone, hostingdomain.ProposalReviewUnknown:
+	}
+	return nil
+}
@@ -38,30 +38,43 @@ To use the online functionality, configure a personal access token with the "rep
 and run "git config %s <token>" (optionally add the "--global" flag).
 
 If your origin server deletes shipped branches,
-disable the ship-delete-tracking-branch configuration setting.`
+disable the ship-delete-tracking-branch configuration setting.
+
+When shipping via the API, Git Town refuses to ship proposals
+whose CI checks have failed or that still need approving reviews.
+Ship with the "--force" flag to ship them anyway.
+
+Ship with the "--auto" flag to let the hosting platform merge the proposal
+once its CI checks pass and it has all required approvals.
+This keeps the branch around until "git town sync" removes it after the merge.
+Requires one of the api ship strategies.`
 
 func Cmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addMessageFlag, readMessageFlag := flags.CommitMessage("specify the commit message for the squash commit")
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
 	addToParentFlag, readToParentFlag := flags.ShipIntoNonPerennialParent()
+	addForceFlag, readForceFlag := flags.Force("ship even if the CI checks of the proposal failed or reviews are missing")
+	addAutoMergeFlag, readAutoMergeFlag := flags.ShipAutoMerge()
 	cmd := cobra.Command{
 		Use:   shipCommand,
 		Args:  cobra.MaximumNArgs(1),
 		Short: shipDesc,
 		Long:  cmdhelpers.Long(shipDesc, fmt.Sprintf(shipHelp, configdomain.KeyGithubToken)),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeShip(args, readMessageFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd), readToParentFlag(cmd))
+			return executeShip(args, readMessageFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd), readToParentFlag(cmd), readForceFlag(cmd), readAutoMergeFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
 	addVerboseFlag(&cmd)
 	addMessageFlag(&cmd)
 	addToParentFlag(&cmd)
+	addForceFlag(&cmd)
+	addAutoMergeFlag(&cmd)
 	return &cmd
 }
 
-func executeShip(args []string, message Option[gitdomain.CommitMessage], dryRun configdomain.DryRun, verbose configdomain.Verbose, toParent configdomain.ShipIntoNonperennialParent) error {
+func executeShip(args []string, message Option[gitdomain.CommitMessage], dryRun configdomain.DryRun, verbose configdomain.Verbose, toParent configdomain.ShipIntoNonperennialParent, force configdomain.Force, autoMerge configdomain.ShipAutoMerge) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		PrintBranchNames: true,
@@ -77,18 +90,22 @@ func executeShip(args []string, message Option[gitdomain.CommitMessage], dryRun
 	if err != nil || exit {
 		return err
 	}
-	err = validateSharedData(sharedData, toParent, message)
+	err = validateSharedData(sharedData, toParent, message, autoMerge)
 	if err != nil {
 		return err
 	}
 	var shipProgram program.Program
 	switch sharedData.config.Config.ShipStrategy {
-	case configdomain.ShipStrategyAPI:
-		apiData, err := determineAPIData(sharedData)
+	case configdomain.ShipStrategyAPI, configdomain.ShipStrategyAPIMerge, configdomain.ShipStrategyAPIRebase:
+		apiData, err := determineAPIData(sharedData, force, autoMerge)
 		if err != nil {
 			return err
 		}
-		shipProgram = shipAPIProgram(sharedData, apiData, message)
+		if autoMerge.Enabled() {
+			shipProgram = shipAPIAutoMergeProgram(sharedData, apiData, message)
+		} else {
+			shipProgram = shipAPIProgram(sharedData, apiData, message)
+		}
 	case configdomain.ShipStragegyFastForward:
 		mergeData, err := determineMergeData(repo)
 		if err != nil {
@@ -102,6 +119,11 @@ func executeShip(args []string, message Option[gitdomain.CommitMessage], dryRun
 		}
 		shipProgram = shipProgramSquashMerge(sharedData, squashMergeData, message)
 	}
+	if sharedData.connector.IsSome() && !autoMerge.Enabled() {
+		// the branches that remain in the stack of the shipped branch
+		remainingStackBranches := append(gitdomain.LocalBranchNames{sharedData.targetBranchName}, sharedData.childBranches...)
+		cmdhelpers.UpdateStackSections(&shipProgram, sharedData.config.Config, remainingStackBranches)
+	}
 	runState := runstate.RunState{
 		BeginBranchesSnapshot: sharedData.branchesSnapshot,
 		BeginConfigSnapshot:   repo.ConfigSnapshot,
@@ -143,10 +165,16 @@ func updateChildBranchProposals(prog *program.Program, proposals []hostingdomain
 	}
 }
 
-func validateSharedData(data sharedShipData, toParent configdomain.ShipIntoNonperennialParent, message Option[gitdomain.CommitMessage]) error {
+func validateSharedData(data sharedShipData, toParent configdomain.ShipIntoNonperennialParent, message Option[gitdomain.CommitMessage], autoMerge configdomain.ShipAutoMerge) error {
+	if autoMerge.Enabled() && !data.config.Config.ShipStrategy.UsesAPI() {
+		return errors.New(messages.ShipAutoMergeRequiresAPI)
+	}
 	if data.config.Config.ShipStrategy == configdomain.ShipStragegyFastForward && message.IsSome() {
 		return errors.New(messages.ShipMessageWithFastForward)
 	}
+	if data.config.Config.ShipStrategy == configdomain.ShipStrategyAPIRebase && message.IsSome() {
+		return errors.New(messages.ShipMessageWithAPIRebase)
+	}
 	if !toParent {
 		branch := data.branchToShip.LocalName.GetOrPanic()
 		parentBranch := data.targetBranch.LocalName.GetOrPanic()
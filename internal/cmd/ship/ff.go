@@ -24,6 +24,7 @@ func shipProgramFastForward(sharedData sharedShipData, squashMergeData shipDataM
 	}
 	if !sharedData.dryRun {
 		prog.Value.Add(&opcodes.DeleteParentBranch{Branch: sharedData.branchNameToShip})
+		prog.Value.Add(&opcodes.RemoveSyncStrategyOverride{Branch: sharedData.branchNameToShip})
 	}
 	if branchToShipRemoteName, hasRemoteName := sharedData.branchToShip.RemoteName.Get(); hasRemoteName {
 		if sharedData.config.Config.IsOnline() {
@@ -117,12 +117,15 @@ func determineSharedShipData(args []string, repo execute.OpenRepoResult, dryRun
 	childBranches := validatedConfig.Config.Lineage.Children(branchNameToShip)
 	var proposalsOfChildBranches []hostingdomain.Proposal
 	var connectorOpt Option[hostingdomain.Connector]
-	if originURL, hasOriginURL := validatedConfig.OriginURL().Get(); hasOriginURL {
+	if remoteURL, hasRemoteURL := validatedConfig.ProposalRemoteURL().Get(); hasRemoteURL {
 		connectorOpt, err = hosting.NewConnector(hosting.NewConnectorArgs{
+			Backend:         repo.Backend,
 			Config:          *validatedConfig.Config.UnvalidatedConfig,
+			Git:             repo.Git,
 			HostingPlatform: validatedConfig.Config.HostingPlatform,
 			Log:             print.Logger{},
-			RemoteURL:       originURL,
+			PushRemoteURL:   validatedConfig.PushRemoteURL(),
+			RemoteURL:       remoteURL,
 		})
 		if err != nil {
 			return data, false, err
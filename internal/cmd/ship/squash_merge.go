@@ -39,6 +39,7 @@ func shipProgramSquashMerge(sharedData sharedShipData, squashMergeData shipDataM
 	}
 	if !sharedData.dryRun {
 		prog.Value.Add(&opcodes.DeleteParentBranch{Branch: sharedData.branchNameToShip})
+		prog.Value.Add(&opcodes.RemoveSyncStrategyOverride{Branch: sharedData.branchNameToShip})
 	}
 	if branchToShipRemoteName, hasRemoteName := sharedData.branchToShip.RemoteName.Get(); hasRemoteName {
 		if sharedData.config.Config.IsOnline() {
@@ -113,12 +113,15 @@ func executeSkip(verbose configdomain.Verbose) error {
 		}
 	}
 	var connector Option[hostingdomain.Connector]
-	if originURL, hasOriginURL := validatedConfig.OriginURL().Get(); hasOriginURL {
+	if remoteURL, hasRemoteURL := validatedConfig.ProposalRemoteURL().Get(); hasRemoteURL {
 		connector, err = hosting.NewConnector(hosting.NewConnectorArgs{
+			Backend:         repo.Backend,
 			Config:          *validatedConfig.Config.UnvalidatedConfig,
+			Git:             repo.Git,
 			HostingPlatform: validatedConfig.Config.HostingPlatform,
 			Log:             print.Logger{},
-			RemoteURL:       originURL,
+			PushRemoteURL:   validatedConfig.PushRemoteURL(),
+			RemoteURL:       remoteURL,
 		})
 		if err != nil {
 			return err
@@ -4,9 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 
+	"github.com/git-town/git-town/v16/internal/cli/colors"
 	"github.com/git-town/git-town/v16/internal/cli/dialog/components"
 	"github.com/git-town/git-town/v16/internal/cli/flags"
+	"github.com/git-town/git-town/v16/internal/cli/print"
 	"github.com/git-town/git-town/v16/internal/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v16/internal/config"
 	"github.com/git-town/git-town/v16/internal/config/configdomain"
@@ -14,6 +18,7 @@ import (
 	"github.com/git-town/git-town/v16/internal/execute"
 	"github.com/git-town/git-town/v16/internal/git/gitdomain"
 	"github.com/git-town/git-town/v16/internal/gohacks/stringslice"
+	"github.com/git-town/git-town/v16/internal/hosting"
 	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
 	"github.com/git-town/git-town/v16/internal/messages"
 	"github.com/git-town/git-town/v16/internal/sync"
@@ -51,6 +56,7 @@ func syncCmd() *cobra.Command {
 	addDetachedFlag, readDetachedFlag := flags.Detached()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
 	addAllFlag, readAllFlag := flags.All()
+	addCheckFlag, readCheckFlag := flags.SyncCheck()
 	addNoPushFlag, readNoPushFlag := flags.NoPush()
 	addStackFlag, readStackFlag := flags.Stack("sync the stack that the current branch belongs to")
 	cmd := cobra.Command{
@@ -60,10 +66,11 @@ func syncCmd() *cobra.Command {
 		Short:   syncDesc,
 		Long:    cmdhelpers.Long(syncDesc, fmt.Sprintf(syncHelp, configdomain.KeySyncUpstream)),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeSync(readAllFlag(cmd), readStackFlag(cmd), readDetachedFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd), readNoPushFlag(cmd))
+			return executeSync(readAllFlag(cmd), readStackFlag(cmd), readDetachedFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd), readNoPushFlag(cmd), readCheckFlag(cmd))
 		},
 	}
 	addAllFlag(&cmd)
+	addCheckFlag(&cmd)
 	addDetachedFlag(&cmd)
 	addVerboseFlag(&cmd)
 	addDryRunFlag(&cmd)
@@ -72,7 +79,7 @@ func syncCmd() *cobra.Command {
 	return &cmd
 }
 
-func executeSync(syncAllBranches configdomain.SyncAllBranches, syncStack configdomain.FullStack, detached configdomain.Detached, dryRun configdomain.DryRun, verbose configdomain.Verbose, pushBranches configdomain.PushBranches) error {
+func executeSync(syncAllBranches configdomain.SyncAllBranches, syncStack configdomain.FullStack, detached configdomain.Detached, dryRun configdomain.DryRun, verbose configdomain.Verbose, pushBranches configdomain.PushBranches, check configdomain.SyncCheck) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		PrintBranchNames: true,
@@ -84,29 +91,35 @@ func executeSync(syncAllBranches configdomain.SyncAllBranches, syncStack configd
 	if err != nil {
 		return err
 	}
-	data, exit, err := determineSyncData(syncAllBranches, syncStack, repo, verbose, detached)
+	if check.Enabled() && !repo.GitVersion.SupportsMergeTree() {
+		return fmt.Errorf(messages.SyncCheckGitVersionTooLow, repo.GitVersion.Major, repo.GitVersion.Minor)
+	}
+	data, exit, err := determineSyncData(syncAllBranches, syncStack, repo, verbose, detached, check)
 	if err != nil || exit {
 		return err
 	}
 
 	// remove outdated lineage
-	if err = data.config.RemoveOutdatedConfiguration(data.allBranches.LocalBranches().Names()); err != nil {
-		return err
-	}
-	if err = cleanupPerennialParentEntries(data.config.Config.Lineage, data.config.Config.PerennialBranches, data.config.GitConfig, repo.FinalMessages); err != nil {
-		return err
+	if !check.Enabled() {
+		if err = data.config.RemoveOutdatedConfiguration(data.allBranches.LocalBranches().Names()); err != nil {
+			return err
+		}
+		if err = cleanupPerennialParentEntries(data.config.Config.Lineage, data.config.Config.PerennialBranches, data.config.GitConfig, repo.FinalMessages); err != nil {
+			return err
+		}
 	}
 
 	runProgram := program.Program{}
 	sync.BranchesProgram(sync.BranchesProgramArgs{
 		BranchProgramArgs: sync.BranchProgramArgs{
-			BranchInfos:        data.allBranches,
-			Config:             data.config.Config,
-			FirstCommitMessage: None[gitdomain.CommitMessage](), // will be populated inside sync.BranchesProgram
-			InitialBranch:      data.initialBranch,
-			Remotes:            data.remotes,
-			Program:            NewMutable(&runProgram),
-			PushBranches:       pushBranches,
+			BranchInfos:         data.allBranches,
+			Config:              data.config.Config,
+			FirstCommitMessage:  None[gitdomain.CommitMessage](), // will be populated inside sync.BranchesProgram
+			InitialBranch:       data.initialBranch,
+			Remotes:             data.remotes,
+			Program:             NewMutable(&runProgram),
+			PushBranches:        pushBranches,
+			SyncWithoutCheckout: syncAllBranches.Enabled(),
 		},
 		BranchesToSync: data.branchesToSync,
 		DryRun:         dryRun,
@@ -115,7 +128,36 @@ func executeSync(syncAllBranches configdomain.SyncAllBranches, syncStack configd
 		PreviousBranch: data.previousBranch,
 		ShouldPushTags: data.shouldPushTags,
 	})
+	if data.connector.IsSome() {
+		cmdhelpers.UpdateStackSections(&runProgram, data.config.Config, data.branchNamesToSync)
+	}
 	runProgram = optimizer.Optimize(runProgram)
+	conflicts := []sync.BranchConflict{}
+	if repo.GitVersion.SupportsMergeTree() {
+		conflicts, err = sync.DetectConflicts(sync.DetectConflictsArgs{
+			Backend:       repo.Backend,
+			Config:        data.config,
+			Git:           repo.Git,
+			InitialBranch: data.initialBranch,
+			Program:       runProgram,
+		})
+	}
+	if check.Enabled() {
+		if err != nil {
+			return err
+		}
+		if len(conflicts) > 0 {
+			printSyncConflicts(conflicts)
+			return errors.New(messages.SyncCheckConflicts)
+		}
+		fmt.Println(messages.SyncCheckNoConflicts)
+		print.Footer(verbose, repo.CommandsCounter.Get(), repo.FinalMessages.Result())
+		return nil
+	}
+	// problems simulating the sync don't prevent the actual sync
+	if err == nil && len(conflicts) > 0 {
+		printSyncConflicts(conflicts)
+	}
 	runState := runstate.RunState{
 		BeginBranchesSnapshot: data.branchesSnapshot,
 		BeginConfigSnapshot:   repo.ConfigSnapshot,
@@ -132,7 +174,7 @@ func executeSync(syncAllBranches configdomain.SyncAllBranches, syncStack configd
 		Backend:                 repo.Backend,
 		CommandsCounter:         repo.CommandsCounter,
 		Config:                  data.config,
-		Connector:               None[hostingdomain.Connector](),
+		Connector:               data.connector,
 		DialogTestInputs:        data.dialogTestInputs,
 		FinalMessages:           repo.FinalMessages,
 		Frontend:                repo.Frontend,
@@ -149,21 +191,23 @@ func executeSync(syncAllBranches configdomain.SyncAllBranches, syncStack configd
 }
 
 type syncData struct {
-	allBranches      gitdomain.BranchInfos
-	branchesSnapshot gitdomain.BranchesSnapshot
-	branchesToSync   []configdomain.BranchToSync
-	config           config.ValidatedConfig
-	detached         configdomain.Detached
-	dialogTestInputs components.TestInputs
-	hasOpenChanges   bool
-	initialBranch    gitdomain.LocalBranchName
-	previousBranch   Option[gitdomain.LocalBranchName]
-	remotes          gitdomain.Remotes
-	shouldPushTags   bool
-	stashSize        gitdomain.StashSize
+	allBranches       gitdomain.BranchInfos
+	branchNamesToSync gitdomain.LocalBranchNames
+	branchesSnapshot  gitdomain.BranchesSnapshot
+	branchesToSync    []configdomain.BranchToSync
+	config            config.ValidatedConfig
+	connector         Option[hostingdomain.Connector]
+	detached          configdomain.Detached
+	dialogTestInputs  components.TestInputs
+	hasOpenChanges    bool
+	initialBranch     gitdomain.LocalBranchName
+	previousBranch    Option[gitdomain.LocalBranchName]
+	remotes           gitdomain.Remotes
+	shouldPushTags    bool
+	stashSize         gitdomain.StashSize
 }
 
-func determineSyncData(syncAllBranches configdomain.SyncAllBranches, syncStack configdomain.FullStack, repo execute.OpenRepoResult, verbose configdomain.Verbose, detached configdomain.Detached) (data syncData, exit bool, err error) {
+func determineSyncData(syncAllBranches configdomain.SyncAllBranches, syncStack configdomain.FullStack, repo execute.OpenRepoResult, verbose configdomain.Verbose, detached configdomain.Detached, check configdomain.SyncCheck) (data syncData, exit bool, err error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
 	if err != nil {
@@ -174,11 +218,11 @@ func determineSyncData(syncAllBranches configdomain.SyncAllBranches, syncStack c
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
-		Fetch:                 true,
+		Fetch:                 !check.Enabled(),
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
 		Git:                   repo.Git,
-		HandleUnfinishedState: true,
+		HandleUnfinishedState: !check.Enabled(),
 		Repo:                  repo,
 		RepoStatus:            repoStatus,
 		RootDir:               repo.RootDir,
@@ -212,10 +256,15 @@ func determineSyncData(syncAllBranches configdomain.SyncAllBranches, syncStack c
 		return data, false, errors.New(messages.CurrentBranchCannotDetermine)
 	}
 	localBranches := branchesSnapshot.Branches.LocalBranches().Names()
+	// sync --check only previews conflicts, it must not ask for missing lineage or persist it
+	branchesToValidate := gitdomain.LocalBranchNames{initialBranch}
+	if check.Enabled() {
+		branchesToValidate = gitdomain.LocalBranchNames{}
+	}
 	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
 		Backend:            repo.Backend,
 		BranchesSnapshot:   branchesSnapshot,
-		BranchesToValidate: gitdomain.LocalBranchNames{initialBranch},
+		BranchesToValidate: branchesToValidate,
 		DialogTestInputs:   dialogTestInputs,
 		Frontend:           repo.Frontend,
 		Git:                repo.Git,
@@ -236,10 +285,13 @@ func determineSyncData(syncAllBranches configdomain.SyncAllBranches, syncStack c
 	default:
 		branchNamesToSync = gitdomain.LocalBranchNames{initialBranch}
 	}
+	if !check.Enabled() {
+		branchesToValidate = branchNamesToSync
+	}
 	validatedConfig, exit, err = validate.Config(validate.ConfigArgs{
 		Backend:            repo.Backend,
 		BranchesSnapshot:   branchesSnapshot,
-		BranchesToValidate: branchNamesToSync,
+		BranchesToValidate: branchesToValidate,
 		DialogTestInputs:   dialogTestInputs,
 		Frontend:           repo.Frontend,
 		Git:                repo.Git,
@@ -268,19 +320,42 @@ func determineSyncData(syncAllBranches configdomain.SyncAllBranches, syncStack c
 	if err != nil {
 		return data, false, err
 	}
+	connector := None[hostingdomain.Connector]()
+	// sync --check doesn't need to know about merged proposals because it doesn't clean up branches
+	if remoteURL, hasRemoteURL := validatedConfig.ProposalRemoteURL().Get(); hasRemoteURL && validatedConfig.Config.IsOnline() && !check.Enabled() {
+		connector, err = hosting.NewConnector(hosting.NewConnectorArgs{
+			Backend:         repo.Backend,
+			Config:          *validatedConfig.Config.UnvalidatedConfig,
+			Git:             repo.Git,
+			HostingPlatform: validatedConfig.Config.HostingPlatform,
+			Log:             print.Logger{},
+			PushRemoteURL:   validatedConfig.PushRemoteURL(),
+			RemoteURL:       remoteURL,
+		})
+		if err != nil {
+			return data, false, err
+		}
+	}
+	if connector, hasConnector := connector.Get(); hasConnector {
+		if finder, canFindMergedProposals := connector.(hostingdomain.MergedProposalFinder); canFindMergedProposals {
+			markMergedProposals(branchesToSync, finder, validatedConfig.Config, repo)
+		}
+	}
 	return syncData{
-		allBranches:      branchesSnapshot.Branches,
-		branchesSnapshot: branchesSnapshot,
-		branchesToSync:   branchesToSync,
-		config:           validatedConfig,
-		detached:         detached,
-		dialogTestInputs: dialogTestInputs,
-		hasOpenChanges:   repoStatus.OpenChanges,
-		initialBranch:    initialBranch,
-		previousBranch:   previousBranchOpt,
-		remotes:          remotes,
-		shouldPushTags:   shouldPushTags,
-		stashSize:        stashSize,
+		allBranches:       branchesSnapshot.Branches,
+		branchNamesToSync: branchNamesToSync,
+		branchesSnapshot:  branchesSnapshot,
+		branchesToSync:    branchesToSync,
+		config:            validatedConfig,
+		connector:         connector,
+		detached:          detached,
+		dialogTestInputs:  dialogTestInputs,
+		hasOpenChanges:    repoStatus.OpenChanges,
+		initialBranch:     initialBranch,
+		previousBranch:    previousBranchOpt,
+		remotes:           remotes,
+		shouldPushTags:    shouldPushTags,
+		stashSize:         stashSize,
 	}, false, err
 }
 
@@ -310,11 +385,58 @@ func branchesToSync(branchNamesToSync gitdomain.LocalBranchNames, branchesSnapsh
 		result[b] = configdomain.BranchToSync{
 			BranchInfo:         branchInfoToSync,
 			FirstCommitMessage: firstCommitMessage,
+			ProposalMerged:     false,
 		}
 	}
 	return result, nil
 }
 
+// markMergedProposals marks the given branches whose proposal was merged on the code hosting platform
+// even though their tracking branch still exists.
+// Problems talking to the hosting platform only cause a warning.
+func markMergedProposals(branchesToSync []configdomain.BranchToSync, finder hostingdomain.MergedProposalFinder, config configdomain.ValidatedConfig, repo execute.OpenRepoResult) {
+	for b, branchToSync := range branchesToSync {
+		localName, hasLocalName := branchToSync.BranchInfo.LocalName.Get()
+		localSHA, hasLocalSHA := branchToSync.BranchInfo.LocalSHA.Get()
+		if !hasLocalName || !hasLocalSHA || !branchToSync.BranchInfo.HasTrackingBranch() {
+			continue
+		}
+		switch branchToSync.BranchInfo.SyncStatus {
+		case gitdomain.SyncStatusUpToDate, gitdomain.SyncStatusNotInSync:
+		default:
+			continue
+		}
+		if config.BranchType(localName) != configdomain.BranchTypeFeatureBranch {
+			continue
+		}
+		parent, hasParent := config.Lineage.Parent(localName).Get()
+		if !hasParent {
+			continue
+		}
+		proposals, err := finder.FindMergedProposals(localName, parent)
+		if err != nil {
+			repo.FinalMessages.Add(fmt.Sprintf(messages.ProposalMergedProblem, localName, err))
+			continue
+		}
+		// Only a proposal that contains all local commits of the branch makes the branch obsolete.
+		// The branch might have received new commits after the proposal got merged,
+		// for example when its name got reused for a new change.
+		branchesToSync[b].ProposalMerged = slices.ContainsFunc(proposals, func(proposal hostingdomain.MergedProposal) bool {
+			return repo.Git.IsAncestor(repo.Backend, localSHA, proposal.HeadSHA.Location())
+		})
+	}
+}
+
+// printSyncConflicts prints the given conflicts that syncing would run into.
+func printSyncConflicts(conflicts []sync.BranchConflict) {
+	cyan := colors.Cyan()
+	fmt.Println()
+	fmt.Println(cyan.Styled(messages.SyncConflictsPreview))
+	for _, conflict := range conflicts {
+		fmt.Println(cyan.Styled(fmt.Sprintf(messages.SyncConflictsPreviewEntry, conflict.Branch, conflict.Source, strings.Join(conflict.Files, ", "))))
+	}
+}
+
 // cleanupPerennialParentEntries removes outdated entries from the configuration.
 func cleanupPerennialParentEntries(lineage configdomain.Lineage, perennialBranches gitdomain.LocalBranchNames, access gitconfig.Access, finalMessages stringslice.Collector) error {
 	for _, perennialBranch := range perennialBranches {
@@ -0,0 +1,22 @@
+package configdomain
+
+import (
+	"strings"
+
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// BitbucketToken is a bearer token to use with the Bitbucket API.
+type BitbucketToken string
+
+func (self BitbucketToken) String() string {
+	return string(self)
+}
+
+func ParseBitbucketToken(value string) Option[BitbucketToken] {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return None[BitbucketToken]()
+	}
+	return Some(BitbucketToken(value))
+}
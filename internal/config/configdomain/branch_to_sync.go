@@ -9,4 +9,5 @@ import (
 type BranchToSync struct {
 	BranchInfo         gitdomain.BranchInfo
 	FirstCommitMessage Option[gitdomain.CommitMessage] // commit message of the first commit on this branch
+	ProposalMerged     bool                            // whether the proposal of this branch was merged on the code hosting platform
 }
@@ -0,0 +1,91 @@
+package configdomain
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/messages"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// ConflictResolution describes how to automatically resolve conflicts in generated files,
+// for example lockfiles, by running a command that regenerates them.
+type ConflictResolution struct {
+	Command string   // the shell command that regenerates the matching files
+	Paths   []string // glob patterns for the files whose conflicts the command resolves
+}
+
+// NewConflictResolution provides a ConflictResolution that resolves conflicts in the files matching the given paths
+// by running the given command.
+func NewConflictResolution(command string, paths []string) (ConflictResolution, error) {
+	command = strings.TrimSpace(command)
+	if command == "" {
+		return ConflictResolution{}, fmt.Errorf(messages.ConfigResolveNoCommand, strings.Join(paths, ", "))
+	}
+	if len(paths) == 0 {
+		return ConflictResolution{}, fmt.Errorf(messages.ConfigResolveNoPaths, command)
+	}
+	for _, pattern := range paths {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return ConflictResolution{}, fmt.Errorf(messages.ConfigResolveInvalidPath, pattern, err)
+		}
+	}
+	return ConflictResolution{
+		Command: command,
+		Paths:   paths,
+	}, nil
+}
+
+// Matches indicates whether this ConflictResolution resolves conflicts in the file with the given path.
+// Patterns that contain a slash match the path relative to the repository root,
+// patterns without a slash match the file name in any folder.
+func (self ConflictResolution) Matches(file string) bool {
+	for _, pattern := range self.Paths {
+		name := file
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(file)
+		}
+		if matches, _ := path.Match(pattern, name); matches {
+			return true
+		}
+	}
+	return false
+}
+
+// ConflictResolutions contains all configured conflict resolutions.
+type ConflictResolutions []ConflictResolution
+
+// ForFiles provides the conflict resolutions that resolve the conflicts in all the given files.
+// Each file uses the first matching conflict resolution.
+// Provides None if the conflicts in at least one of the given files cannot be resolved automatically.
+func (self ConflictResolutions) ForFiles(files []string) Option[ConflictResolutions] {
+	if len(files) == 0 {
+		return None[ConflictResolutions]()
+	}
+	used := make([]bool, len(self))
+	for _, file := range files {
+		index, found := self.find(file).Get()
+		if !found {
+			return None[ConflictResolutions]()
+		}
+		used[index] = true
+	}
+	result := ConflictResolutions{}
+	for r, resolution := range self {
+		if used[r] {
+			result = append(result, resolution)
+		}
+	}
+	return Some(result)
+}
+
+// provides the index of the first conflict resolution that matches the given file
+func (self ConflictResolutions) find(file string) Option[int] {
+	for r, resolution := range self {
+		if resolution.Matches(file) {
+			return Some(r)
+		}
+	}
+	return None[int]()
+}
@@ -0,0 +1,100 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestConflictResolution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NewConflictResolution", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("valid rule", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.NewConflictResolution(" go mod tidy ", []string{"go.sum"})
+			must.NoError(t, err)
+			want := configdomain.ConflictResolution{
+				Command: "go mod tidy",
+				Paths:   []string{"go.sum"},
+			}
+			must.Eq(t, want, have)
+		})
+
+		t.Run("no command", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.NewConflictResolution(" ", []string{"go.sum"})
+			must.Error(t, err)
+		})
+
+		t.Run("no paths", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.NewConflictResolution("go mod tidy", []string{})
+			must.Error(t, err)
+		})
+
+		t.Run("invalid path", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.NewConflictResolution("go mod tidy", []string{"go.sum["})
+			must.Error(t, err)
+		})
+	})
+
+	t.Run("Matches", func(t *testing.T) {
+		t.Parallel()
+		resolution := configdomain.ConflictResolution{
+			Command: "npm install",
+			Paths:   []string{"*.lock", "web/package-lock.json"},
+		}
+		tests := map[string]bool{
+			"yarn.lock":                 true,
+			"frontend/yarn.lock":        true,
+			"web/package-lock.json":     true,
+			"package-lock.json":         false,
+			"app/web/package-lock.json": false,
+			"yarn.lock.txt":             false,
+		}
+		for give, want := range tests {
+			have := resolution.Matches(give)
+			must.EqOp(t, want, have)
+		}
+	})
+
+	t.Run("ForFiles", func(t *testing.T) {
+		t.Parallel()
+		goModTidy := configdomain.ConflictResolution{Command: "go mod tidy", Paths: []string{"go.sum"}}
+		npmInstall := configdomain.ConflictResolution{Command: "npm install", Paths: []string{"package-lock.json"}}
+		resolveAll := configdomain.ConflictResolution{Command: "make generate", Paths: []string{"*.sum", "*.json"}}
+		resolutions := configdomain.ConflictResolutions{goModTidy, npmInstall, resolveAll}
+
+		t.Run("all files match the same rule", func(t *testing.T) {
+			t.Parallel()
+			have := resolutions.ForFiles([]string{"go.sum", "tools/go.sum"})
+			want := Some(configdomain.ConflictResolutions{goModTidy})
+			must.Eq(t, want, have)
+		})
+
+		t.Run("files match different rules", func(t *testing.T) {
+			t.Parallel()
+			have := resolutions.ForFiles([]string{"package-lock.json", "go.sum", "tsconfig.json"})
+			want := Some(configdomain.ConflictResolutions{goModTidy, npmInstall, resolveAll})
+			must.Eq(t, want, have)
+		})
+
+		t.Run("a file matches no rule", func(t *testing.T) {
+			t.Parallel()
+			have := resolutions.ForFiles([]string{"go.sum", "main.go"})
+			must.True(t, have.IsNone())
+		})
+
+		t.Run("no files", func(t *testing.T) {
+			t.Parallel()
+			have := resolutions.ForFiles([]string{})
+			must.True(t, have.IsNone())
+		})
+	})
+}
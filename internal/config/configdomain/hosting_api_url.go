@@ -0,0 +1,23 @@
+package configdomain
+
+import (
+	"strings"
+
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// HostingAPIURL is the base URL of the API of the code hosting platform,
+// for installations that serve their API from a different location than the one derived from the origin remote.
+type HostingAPIURL string
+
+func (self HostingAPIURL) String() string {
+	return string(self)
+}
+
+func ParseHostingAPIURL(value string) Option[HostingAPIURL] {
+	value = strings.TrimRight(strings.TrimSpace(value), "/")
+	if value == "" {
+		return None[HostingAPIURL]()
+	}
+	return Some(HostingAPIURL(value))
+}
@@ -0,0 +1,23 @@
+package configdomain
+
+import (
+	"strings"
+
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// HostingCAFile is the path of a PEM file with the certificates of the certificate authorities
+// that Git Town should trust when talking to the API of the code hosting platform.
+type HostingCAFile string
+
+func (self HostingCAFile) String() string {
+	return string(self)
+}
+
+func ParseHostingCAFile(value string) Option[HostingCAFile] {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return None[HostingCAFile]()
+	}
+	return Some(HostingCAFile(value))
+}
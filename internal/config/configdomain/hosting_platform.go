@@ -14,10 +14,12 @@ type HostingPlatform string
 func (self HostingPlatform) String() string { return string(self) }
 
 const (
-	HostingPlatformBitbucket = HostingPlatform("bitbucket")
-	HostingPlatformGitHub    = HostingPlatform("github")
-	HostingPlatformGitLab    = HostingPlatform("gitlab")
-	HostingPlatformGitea     = HostingPlatform("gitea")
+	HostingPlatformAzureDevOps         = HostingPlatform("azure-devops")
+	HostingPlatformBitbucket           = HostingPlatform("bitbucket")
+	HostingPlatformBitbucketDatacenter = HostingPlatform("bitbucket-datacenter")
+	HostingPlatformGitHub              = HostingPlatform("github")
+	HostingPlatformGitLab              = HostingPlatform("gitlab")
+	HostingPlatformGitea               = HostingPlatform("gitea")
 )
 
 // ParseHostingPlatform provides the HostingPlatform enum matching the given text.
@@ -37,7 +39,9 @@ func ParseHostingPlatform(platformName string) (Option[HostingPlatform], error)
 // hostingPlatforms provides all legal values for HostingPlatform.
 func hostingPlatforms() []HostingPlatform {
 	return []HostingPlatform{
+		HostingPlatformAzureDevOps,
 		HostingPlatformBitbucket,
+		HostingPlatformBitbucketDatacenter,
 		HostingPlatformGitHub,
 		HostingPlatformGitLab,
 		HostingPlatformGitea,
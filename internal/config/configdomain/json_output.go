@@ -0,0 +1,8 @@
+package configdomain
+
+// indicates whether to print machine-readable JSON instead of human-readable text
+type JSONOutput bool
+
+func (self JSONOutput) Enabled() bool {
+	return bool(self)
+}
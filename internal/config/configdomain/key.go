@@ -42,6 +42,8 @@ const (
 	KeyAliasSetParent                      = Key("alias.set-parent")
 	KeyAliasShip                           = Key("alias.ship")
 	KeyAliasSync                           = Key("alias.sync")
+	KeyAzureDevOpsToken                    = Key("git-town.azure-devops-token")
+	KeyBitbucketToken                      = Key("git-town.bitbucket-token")
 	KeyContributionBranches                = Key("git-town.contribution-branches")
 	KeyCreatePrototypeBranches             = Key("git-town.create-prototype-branches")
 	KeyDeprecatedCodeHostingDriver         = Key("git-town.code-hosting-driver")
@@ -57,6 +59,8 @@ const (
 	KeyGiteaToken                          = Key("git-town.gitea-token")
 	KeyGithubToken                         = Key(pkg.KeyGithubToken)
 	KeyGitlabToken                         = Key("git-town.gitlab-token")
+	KeyHostingAPIURL                       = Key("git-town.hosting-api-url")
+	KeyHostingCAFile                       = Key("git-town.hosting-ca-file")
 	KeyHostingOriginHostname               = Key("git-town.hosting-origin-hostname")
 	KeyHostingPlatform                     = Key("git-town.hosting-platform")
 	KeyMainBranch                          = Key("git-town.main-branch")
@@ -65,9 +69,13 @@ const (
 	KeyParkedBranches                      = Key("git-town.parked-branches")
 	KeyPerennialBranches                   = Key("git-town.perennial-branches")
 	KeyPerennialRegex                      = Key("git-town.perennial-regex")
+	KeyProposalBody                        = Key("git-town.proposal-body")
+	KeyProposalRemote                      = Key("git-town.proposal-remote")
+	KeyProposalStackSection                = Key("git-town.proposal-stack-section")
 	KeyPrototypeBranches                   = Key("git-town.prototype-branches")
 	KeyPushHook                            = Key("git-town.push-hook")
 	KeyPushNewBranches                     = Key("git-town.push-new-branches")
+	KeyPushRemote                          = Key("git-town.push-remote")
 	KeyShipDeleteTrackingBranch            = Key("git-town.ship-delete-tracking-branch")
 	KeyShipStrategy                        = Key("git-town.ship-strategy")
 	KeyObsoleteSyncBeforeShip              = Key("git-town.sync-before-ship")
@@ -82,8 +90,12 @@ const (
 )
 
 var keys = []Key{ //nolint:gochecknoglobals
+	KeyHostingAPIURL,
+	KeyHostingCAFile,
 	KeyHostingOriginHostname,
 	KeyHostingPlatform,
+	KeyAzureDevOpsToken,
+	KeyBitbucketToken,
 	KeyContributionBranches,
 	KeyCreatePrototypeBranches,
 	KeyDeprecatedCodeHostingDriver,
@@ -107,9 +119,13 @@ var keys = []Key{ //nolint:gochecknoglobals
 	KeyParkedBranches,
 	KeyPerennialBranches,
 	KeyPerennialRegex,
+	KeyProposalBody,
+	KeyProposalRemote,
+	KeyProposalStackSection,
 	KeyPrototypeBranches,
 	KeyPushHook,
 	KeyPushNewBranches,
+	KeyPushRemote,
 	KeyShipDeleteTrackingBranch,
 	KeyShipStrategy,
 	KeyObsoleteSyncBeforeShip,
@@ -125,6 +141,17 @@ func NewParentKey(branch gitdomain.LocalBranchName) Key {
 	return Key(LineageKeyPrefix + branch + LineageKeySuffix)
 }
 
+// NewParentSHAKeyForBranch provides the key under which Git Town stores the SHA of the parent commit
+// that the given branch was last synced with.
+func NewParentSHAKeyForBranch(branch gitdomain.LocalBranchName) Key {
+	return Key(LineageKeyPrefix + branch + ParentSHAKeySuffix)
+}
+
+// NewSyncStrategyKeyForBranch provides the key under which Git Town stores the sync strategy of the given branch.
+func NewSyncStrategyKeyForBranch(branch gitdomain.LocalBranchName) Key {
+	return Key(LineageKeyPrefix + branch + SyncStrategyKeySuffix)
+}
+
 func ParseKey(name string) Option[Key] {
 	for _, configKey := range keys {
 		if configKey.String() == name {
@@ -134,6 +161,12 @@ func ParseKey(name string) Option[Key] {
 	if isLineageKey(name) {
 		return Some(Key(name))
 	}
+	if isParentSHAKey(name) {
+		return Some(Key(name))
+	}
+	if isSyncStrategyKey(name) {
+		return Some(Key(name))
+	}
 	if aliasKey, isAliasKey := AllAliasableCommands().LookupKey(name).Get(); isAliasKey {
 		return Some(aliasKey.Key())
 	}
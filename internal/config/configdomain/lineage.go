@@ -223,6 +223,20 @@ func (self Lineage) RemoveBranch(branch gitdomain.LocalBranchName) {
 	delete(self.data, branch)
 }
 
+// StackOf provides all branches in the stack that the given branch belongs to,
+// i.e. the oldest ancestor of the given branch that isn't the root branch and all its descendants.
+// Returns nothing if the given branch has no parent.
+func (self Lineage) StackOf(branch gitdomain.LocalBranchName) gitdomain.LocalBranchNames {
+	if self.Parent(branch).IsNone() {
+		return gitdomain.LocalBranchNames{}
+	}
+	stackRoot := branch
+	if ancestors := self.AncestorsWithoutRoot(branch); len(ancestors) > 0 {
+		stackRoot = ancestors[0]
+	}
+	return append(gitdomain.LocalBranchNames{stackRoot}, self.Descendants(stackRoot)...)
+}
+
 // Roots provides the branches with children and no parents.
 func (self Lineage) Roots() gitdomain.LocalBranchNames {
 	roots := gitdomain.LocalBranchNames{}
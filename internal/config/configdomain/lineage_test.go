@@ -607,4 +607,30 @@ func TestLineage(t *testing.T) {
 			must.Eq(t, want, have)
 		})
 	})
+
+	t.Run("StackOf", func(t *testing.T) {
+		t.Parallel()
+		lineage := configdomain.NewLineage()
+		lineage.Add(one, main)
+		lineage.Add(two, one)
+		lineage.Add(three, one)
+		t.Run("root branch", func(t *testing.T) {
+			t.Parallel()
+			have := lineage.StackOf(main)
+			want := gitdomain.LocalBranchNames{}
+			must.Eq(t, want, have)
+		})
+		t.Run("oldest branch in the stack", func(t *testing.T) {
+			t.Parallel()
+			have := lineage.StackOf(one)
+			want := gitdomain.LocalBranchNames{one, three, two}
+			must.Eq(t, want, have)
+		})
+		t.Run("leaf branch", func(t *testing.T) {
+			t.Parallel()
+			have := lineage.StackOf(two)
+			want := gitdomain.LocalBranchNames{one, three, two}
+			must.Eq(t, want, have)
+		})
+	})
 }
@@ -0,0 +1,40 @@
+package configdomain
+
+import (
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// a Key that contains the commit of the parent branch that an individual branch was last synced with
+type ParentSHAKey Key
+
+// NewParentSHAKey indicates using the returned option whether this key is a ParentSHAKey.
+func NewParentSHAKey(key Key) Option[ParentSHAKey] {
+	if isParentSHAKey(key.String()) {
+		return Some(ParentSHAKey(key))
+	}
+	return None[ParentSHAKey]()
+}
+
+// provides the name of the branch encoded in this ParentSHAKey
+func (self ParentSHAKey) BranchName() Option[gitdomain.LocalBranchName] {
+	return gitdomain.NewLocalBranchNameOption(strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(self.String(), LineageKeyPrefix), ParentSHAKeySuffix)))
+}
+
+// converts this ParentSHAKey into a generic Key
+func (self ParentSHAKey) Key() Key {
+	return Key(self)
+}
+
+func (self ParentSHAKey) String() string {
+	return string(self)
+}
+
+const ParentSHAKeySuffix = ".parent-sha"
+
+// indicates whether the given key value is for a ParentSHAKey
+func isParentSHAKey(key string) bool {
+	return strings.HasPrefix(key, LineageKeyPrefix) && strings.HasSuffix(key, ParentSHAKeySuffix)
+}
@@ -0,0 +1,21 @@
+package configdomain
+
+import "github.com/git-town/git-town/v16/internal/git/gitdomain"
+
+// ParentSHAs contains the commits of the parent branches that individual branches were last synced with.
+type ParentSHAs map[gitdomain.LocalBranchName]gitdomain.SHA
+
+// NewParentSHAsFromSnapshot provides the parent commits that the given Git metadata records for individual branches.
+func NewParentSHAsFromSnapshot(snapshot SingleSnapshot) ParentSHAs {
+	result := ParentSHAs{}
+	for key, value := range snapshot.ParentSHAEntries() {
+		branch, hasBranch := key.BranchName().Get()
+		if !hasBranch {
+			continue
+		}
+		if sha, hasSHA := gitdomain.NewSHAOption(value).Get(); hasSHA {
+			result[branch] = sha
+		}
+	}
+	return result
+}
@@ -0,0 +1,28 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestParentSHAs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NewParentSHAsFromSnapshot", func(t *testing.T) {
+		t.Parallel()
+		snapshot := configdomain.SingleSnapshot{
+			"git-town-branch.feature.parent":     "main",
+			"git-town-branch.feature.parent-sha": "111111",
+			"git-town-branch.other.parent-sha":   "zonk",
+			"git-town.main-branch":               "main",
+		}
+		have := configdomain.NewParentSHAsFromSnapshot(snapshot)
+		want := configdomain.ParentSHAs{
+			"feature": gitdomain.NewSHA("111111"),
+		}
+		must.Eq(t, want, have)
+	})
+}
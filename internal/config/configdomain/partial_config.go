@@ -1,6 +1,8 @@
 package configdomain
 
 import (
+	"slices"
+
 	"github.com/git-town/git-town/v16/internal/git/gitdomain"
 	"github.com/git-town/git-town/v16/internal/gohacks"
 	"github.com/git-town/git-town/v16/internal/gohacks/mapstools"
@@ -10,6 +12,9 @@ import (
 // PartialConfig contains configuration data as it is stored in the local or global Git configuration.
 type PartialConfig struct {
 	Aliases                  Aliases
+	AzureDevOpsToken         Option[AzureDevOpsToken]
+	BitbucketToken           Option[BitbucketToken]
+	ConflictResolutions      ConflictResolutions
 	ContributionBranches     gitdomain.LocalBranchNames
 	CreatePrototypeBranches  Option[CreatePrototypeBranches]
 	GitHubToken              Option[GitHubToken]
@@ -17,30 +22,43 @@ type PartialConfig struct {
 	GitUserEmail             Option[GitUserEmail]
 	GitUserName              Option[GitUserName]
 	GiteaToken               Option[GiteaToken]
+	HostingAPIURL            Option[HostingAPIURL]
+	HostingCAFile            Option[HostingCAFile]
 	HostingOriginHostname    Option[HostingOriginHostname]
 	HostingPlatform          Option[HostingPlatform]
 	Lineage                  Lineage
 	MainBranch               Option[gitdomain.LocalBranchName]
 	ObservedBranches         gitdomain.LocalBranchNames
 	Offline                  Option[Offline]
+	ParentSHAs               ParentSHAs
 	ParkedBranches           gitdomain.LocalBranchNames
 	PerennialBranches        gitdomain.LocalBranchNames
 	PerennialRegex           Option[PerennialRegex]
+	ProposalBodySource       Option[ProposalBodySource]
+	ProposalRemote           Option[gitdomain.Remote]
+	ProposalStackSection     Option[ProposalStackSection]
+	ProposeAssignees         []string
+	ProposeDraft             Option[ProposeDraft]
+	ProposeLabels            []string
+	ProposeReviewers         []string
 	PrototypeBranches        gitdomain.LocalBranchNames
 	PushHook                 Option[PushHook]
 	PushNewBranches          Option[PushNewBranches]
+	PushRemote               Option[gitdomain.Remote]
 	ShipDeleteTrackingBranch Option[ShipDeleteTrackingBranch]
 	ShipStrategy             Option[ShipStrategy]
 	SyncFeatureStrategy      Option[SyncFeatureStrategy]
 	SyncPerennialStrategy    Option[SyncPerennialStrategy]
 	SyncPrototypeStrategy    Option[SyncPrototypeStrategy]
+	SyncStrategyOverrides    SyncStrategyOverrides
 	SyncTags                 Option[SyncTags]
 	SyncUpstream             Option[SyncUpstream]
 }
 
 func EmptyPartialConfig() PartialConfig {
 	return PartialConfig{
-		Aliases: Aliases{},
+		Aliases:    Aliases{},
+		ParentSHAs: ParentSHAs{},
 	} //exhaustruct:ignore
 }
 
@@ -53,6 +71,10 @@ func NewPartialConfigFromSnapshot(snapshot SingleSnapshot, updateOutdated bool,
 	ec.Check(err)
 	offline, err := ParseOffline(snapshot[KeyOffline], KeyOffline.String())
 	ec.Check(err)
+	proposalBodySource, err := ParseProposalBodySource(snapshot[KeyProposalBody])
+	ec.Check(err)
+	proposalStackSection, err := ParseProposalStackSection(snapshot[KeyProposalStackSection], KeyProposalStackSection.String())
+	ec.Check(err)
 	pushHook, err := ParsePushHook(snapshot[KeyPushHook], KeyPushHook.String())
 	ec.Check(err)
 	pushNewBranches, err := ParsePushNewBranches(snapshot[KeyPushNewBranches], KeyPushNewBranches.String())
@@ -67,6 +89,8 @@ func NewPartialConfigFromSnapshot(snapshot SingleSnapshot, updateOutdated bool,
 	ec.Check(err)
 	syncPrototypeStrategy, err := ParseSyncPrototypeStrategy(snapshot[KeySyncPrototypeStrategy])
 	ec.Check(err)
+	syncStrategyOverrides, err := NewSyncStrategyOverridesFromSnapshot(snapshot)
+	ec.Check(err)
 	syncTags, err := ParseSyncTags(snapshot[KeySyncTags], KeySyncTags.String())
 	ec.Check(err)
 	syncUpstream, err := ParseSyncUpstream(snapshot[KeySyncUpstream], KeySyncUpstream.String())
@@ -77,6 +101,9 @@ func NewPartialConfigFromSnapshot(snapshot SingleSnapshot, updateOutdated bool,
 	ec.Check(err)
 	return PartialConfig{
 		Aliases:                  aliases,
+		AzureDevOpsToken:         ParseAzureDevOpsToken(snapshot[KeyAzureDevOpsToken]),
+		BitbucketToken:           ParseBitbucketToken(snapshot[KeyBitbucketToken]),
+		ConflictResolutions:      ConflictResolutions{},
 		ContributionBranches:     gitdomain.ParseLocalBranchNames(snapshot[KeyContributionBranches]),
 		CreatePrototypeBranches:  createPrototypeBranches,
 		GitHubToken:              ParseGitHubToken(snapshot[KeyGithubToken]),
@@ -84,23 +111,35 @@ func NewPartialConfigFromSnapshot(snapshot SingleSnapshot, updateOutdated bool,
 		GitUserEmail:             ParseGitUserEmail(snapshot[KeyGitUserEmail]),
 		GitUserName:              ParseGitUserName(snapshot[KeyGitUserName]),
 		GiteaToken:               ParseGiteaToken(snapshot[KeyGiteaToken]),
+		HostingAPIURL:            ParseHostingAPIURL(snapshot[KeyHostingAPIURL]),
+		HostingCAFile:            ParseHostingCAFile(snapshot[KeyHostingCAFile]),
 		HostingOriginHostname:    ParseHostingOriginHostname(snapshot[KeyHostingOriginHostname]),
 		HostingPlatform:          hostingPlatform,
 		Lineage:                  lineage,
 		MainBranch:               gitdomain.NewLocalBranchNameOption(snapshot[KeyMainBranch]),
 		ObservedBranches:         gitdomain.ParseLocalBranchNames(snapshot[KeyObservedBranches]),
 		Offline:                  offline,
+		ParentSHAs:               NewParentSHAsFromSnapshot(snapshot),
 		ParkedBranches:           gitdomain.ParseLocalBranchNames(snapshot[KeyParkedBranches]),
 		PerennialBranches:        gitdomain.ParseLocalBranchNames(snapshot[KeyPerennialBranches]),
 		PerennialRegex:           perennialRegex,
+		ProposalBodySource:       proposalBodySource,
+		ProposalRemote:           ParseRemote(snapshot[KeyProposalRemote]),
+		ProposalStackSection:     proposalStackSection,
+		ProposeAssignees:         []string{},
+		ProposeDraft:             None[ProposeDraft](),
+		ProposeLabels:            []string{},
+		ProposeReviewers:         []string{},
 		PrototypeBranches:        gitdomain.ParseLocalBranchNames(snapshot[KeyPrototypeBranches]),
 		PushHook:                 pushHook,
 		PushNewBranches:          pushNewBranches,
+		PushRemote:               ParseRemote(snapshot[KeyPushRemote]),
 		ShipDeleteTrackingBranch: shipDeleteTrackingBranch,
 		ShipStrategy:             shipStrategy,
 		SyncFeatureStrategy:      syncFeatureStrategy,
 		SyncPerennialStrategy:    syncPerennialStrategy,
 		SyncPrototypeStrategy:    syncPrototypeStrategy,
+		SyncStrategyOverrides:    syncStrategyOverrides,
 		SyncTags:                 syncTags,
 		SyncUpstream:             syncUpstream,
 	}, ec.Err
@@ -113,30 +152,45 @@ type removeLocalConfigValueFunc func(Key) error
 func (self PartialConfig) Merge(other PartialConfig) PartialConfig {
 	return PartialConfig{
 		Aliases:                  mapstools.Merge(other.Aliases, self.Aliases),
-		ContributionBranches:     append(other.ContributionBranches, self.ContributionBranches...),
+		AzureDevOpsToken:         other.AzureDevOpsToken.Or(self.AzureDevOpsToken),
+		BitbucketToken:           other.BitbucketToken.Or(self.BitbucketToken),
+		ConflictResolutions:      slices.Concat(other.ConflictResolutions, self.ConflictResolutions),
+		ContributionBranches:     slices.Concat(other.ContributionBranches, self.ContributionBranches),
 		CreatePrototypeBranches:  other.CreatePrototypeBranches.Or(self.CreatePrototypeBranches),
 		GitHubToken:              other.GitHubToken.Or(self.GitHubToken),
 		GitLabToken:              other.GitLabToken.Or(self.GitLabToken),
 		GitUserEmail:             other.GitUserEmail.Or(self.GitUserEmail),
 		GitUserName:              other.GitUserName.Or(self.GitUserName),
 		GiteaToken:               other.GiteaToken.Or(self.GiteaToken),
+		HostingAPIURL:            other.HostingAPIURL.Or(self.HostingAPIURL),
+		HostingCAFile:            other.HostingCAFile.Or(self.HostingCAFile),
 		HostingOriginHostname:    other.HostingOriginHostname.Or(self.HostingOriginHostname),
 		HostingPlatform:          other.HostingPlatform.Or(self.HostingPlatform),
 		Lineage:                  other.Lineage.Merge(self.Lineage),
 		MainBranch:               other.MainBranch.Or(self.MainBranch),
-		ObservedBranches:         append(other.ObservedBranches, self.ObservedBranches...),
+		ObservedBranches:         slices.Concat(other.ObservedBranches, self.ObservedBranches),
 		Offline:                  other.Offline.Or(self.Offline),
-		ParkedBranches:           append(other.ParkedBranches, self.ParkedBranches...),
-		PerennialBranches:        append(other.PerennialBranches, self.PerennialBranches...),
+		ParentSHAs:               mapstools.Merge(other.ParentSHAs, self.ParentSHAs),
+		ParkedBranches:           slices.Concat(other.ParkedBranches, self.ParkedBranches),
+		PerennialBranches:        slices.Concat(other.PerennialBranches, self.PerennialBranches),
 		PerennialRegex:           other.PerennialRegex.Or(self.PerennialRegex),
-		PrototypeBranches:        append(other.PrototypeBranches, self.PrototypeBranches...),
+		ProposalBodySource:       other.ProposalBodySource.Or(self.ProposalBodySource),
+		ProposalRemote:           other.ProposalRemote.Or(self.ProposalRemote),
+		ProposalStackSection:     other.ProposalStackSection.Or(self.ProposalStackSection),
+		ProposeAssignees:         slices.Concat(other.ProposeAssignees, self.ProposeAssignees),
+		ProposeDraft:             other.ProposeDraft.Or(self.ProposeDraft),
+		ProposeLabels:            slices.Concat(other.ProposeLabels, self.ProposeLabels),
+		ProposeReviewers:         slices.Concat(other.ProposeReviewers, self.ProposeReviewers),
+		PrototypeBranches:        slices.Concat(other.PrototypeBranches, self.PrototypeBranches),
 		PushHook:                 other.PushHook.Or(self.PushHook),
 		PushNewBranches:          other.PushNewBranches.Or(self.PushNewBranches),
+		PushRemote:               other.PushRemote.Or(self.PushRemote),
 		ShipDeleteTrackingBranch: other.ShipDeleteTrackingBranch.Or(self.ShipDeleteTrackingBranch),
 		ShipStrategy:             other.ShipStrategy.Or(self.ShipStrategy),
 		SyncFeatureStrategy:      other.SyncFeatureStrategy.Or(self.SyncFeatureStrategy),
 		SyncPerennialStrategy:    other.SyncPerennialStrategy.Or(self.SyncPerennialStrategy),
 		SyncPrototypeStrategy:    other.SyncPrototypeStrategy.Or(self.SyncPrototypeStrategy),
+		SyncStrategyOverrides:    slices.Concat(other.SyncStrategyOverrides, self.SyncStrategyOverrides),
 		SyncTags:                 other.SyncTags.Or(self.SyncTags),
 		SyncUpstream:             other.SyncUpstream.Or(self.SyncUpstream),
 	}
@@ -146,6 +200,9 @@ func (self PartialConfig) ToUnvalidatedConfig(defaults UnvalidatedConfig) Unvali
 	syncFeatureStrategy := self.SyncFeatureStrategy.GetOrElse(defaults.SyncFeatureStrategy)
 	return UnvalidatedConfig{
 		Aliases:                  self.Aliases,
+		AzureDevOpsToken:         self.AzureDevOpsToken,
+		BitbucketToken:           self.BitbucketToken,
+		ConflictResolutions:      self.ConflictResolutions,
 		ContributionBranches:     self.ContributionBranches,
 		CreatePrototypeBranches:  self.CreatePrototypeBranches.GetOrElse(defaults.CreatePrototypeBranches),
 		GitHubToken:              self.GitHubToken,
@@ -153,23 +210,35 @@ func (self PartialConfig) ToUnvalidatedConfig(defaults UnvalidatedConfig) Unvali
 		GitUserEmail:             self.GitUserEmail,
 		GitUserName:              self.GitUserName,
 		GiteaToken:               self.GiteaToken,
+		HostingAPIURL:            self.HostingAPIURL,
+		HostingCAFile:            self.HostingCAFile,
 		HostingOriginHostname:    self.HostingOriginHostname,
 		HostingPlatform:          self.HostingPlatform,
 		Lineage:                  self.Lineage,
 		MainBranch:               self.MainBranch,
 		ObservedBranches:         self.ObservedBranches,
 		Offline:                  self.Offline.GetOrElse(defaults.Offline),
+		ParentSHAs:               self.ParentSHAs,
 		ParkedBranches:           self.ParkedBranches,
 		PerennialBranches:        self.PerennialBranches,
 		PerennialRegex:           self.PerennialRegex,
+		ProposalBodySource:       self.ProposalBodySource.GetOrElse(defaults.ProposalBodySource),
+		ProposalRemote:           self.ProposalRemote.GetOrElse(defaults.ProposalRemote),
+		ProposalStackSection:     self.ProposalStackSection.GetOrElse(defaults.ProposalStackSection),
+		ProposeAssignees:         self.ProposeAssignees,
+		ProposeDraft:             self.ProposeDraft.GetOrElse(defaults.ProposeDraft),
+		ProposeLabels:            self.ProposeLabels,
+		ProposeReviewers:         self.ProposeReviewers,
 		PrototypeBranches:        self.PrototypeBranches,
 		PushHook:                 self.PushHook.GetOrElse(defaults.PushHook),
 		PushNewBranches:          self.PushNewBranches.GetOrElse(defaults.PushNewBranches),
+		PushRemote:               self.PushRemote.GetOrElse(defaults.PushRemote),
 		ShipDeleteTrackingBranch: self.ShipDeleteTrackingBranch.GetOrElse(defaults.ShipDeleteTrackingBranch),
 		ShipStrategy:             self.ShipStrategy.GetOrElse(defaults.ShipStrategy),
 		SyncFeatureStrategy:      syncFeatureStrategy,
 		SyncPerennialStrategy:    self.SyncPerennialStrategy.GetOrElse(defaults.SyncPerennialStrategy),
 		SyncPrototypeStrategy:    self.SyncPrototypeStrategy.GetOrElse(NewSyncPrototypeStrategyFromSyncFeatureStrategy(syncFeatureStrategy)),
+		SyncStrategyOverrides:    self.SyncStrategyOverrides,
 		SyncTags:                 self.SyncTags.GetOrElse(defaults.SyncTags),
 		SyncUpstream:             self.SyncUpstream.GetOrElse(defaults.SyncUpstream),
 	}
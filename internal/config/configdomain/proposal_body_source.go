@@ -0,0 +1,43 @@
+package configdomain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/messages"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+const (
+	ProposalBodySourceCommits  ProposalBodySource = "commits"  // build the body of new proposals from the commits in the branch
+	ProposalBodySourceTemplate ProposalBodySource = "template" // use the proposal template of the hosting platform in the repo, if it exists
+)
+
+// ProposalBodySource defines where "git town propose" gets the body of new proposals from
+// when the user doesn't provide one.
+type ProposalBodySource string
+
+func (self ProposalBodySource) String() string {
+	return string(self)
+}
+
+func ParseProposalBodySource(text string) (Option[ProposalBodySource], error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return None[ProposalBodySource](), nil
+	}
+	text = strings.ToLower(text)
+	for _, source := range ProposalBodySources() {
+		if source.String() == text {
+			return Some(source), nil
+		}
+	}
+	return None[ProposalBodySource](), fmt.Errorf(messages.ConfigProposalBodySourceUnknown, text)
+}
+
+func ProposalBodySources() []ProposalBodySource {
+	return []ProposalBodySource{
+		ProposalBodySourceCommits,
+		ProposalBodySourceTemplate,
+	}
+}
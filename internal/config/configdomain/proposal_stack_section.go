@@ -0,0 +1,28 @@
+package configdomain
+
+import (
+	"strconv"
+
+	"github.com/git-town/git-town/v16/internal/gohacks"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// ProposalStackSection contains the configuration setting whether Git Town maintains a section
+// listing the entire stack in the bodies of the proposals of stacked branches.
+type ProposalStackSection bool
+
+func (self ProposalStackSection) IsTrue() bool {
+	return bool(self)
+}
+
+func (self ProposalStackSection) String() string {
+	return strconv.FormatBool(self.IsTrue())
+}
+
+func ParseProposalStackSection(value, source string) (Option[ProposalStackSection], error) {
+	parsedOpt, err := gohacks.ParseBool(value, source)
+	if parsed, has := parsedOpt.Get(); has {
+		return Some(ProposalStackSection(parsed)), err
+	}
+	return None[ProposalStackSection](), err
+}
@@ -0,0 +1,8 @@
+package configdomain
+
+// indicates whether "git town propose" creates proposals as drafts
+type ProposeDraft bool
+
+func (self ProposeDraft) Enabled() bool {
+	return bool(self)
+}
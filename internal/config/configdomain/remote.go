@@ -0,0 +1,17 @@
+package configdomain
+
+import (
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// ParseRemote provides the Git remote with the given name.
+func ParseRemote(value string) Option[gitdomain.Remote] {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return None[gitdomain.Remote]()
+	}
+	return Some(gitdomain.NewRemote(value))
+}
@@ -0,0 +1,8 @@
+package configdomain
+
+// indicates whether "git town ship" should enable auto-merge on the hosting platform instead of merging right away
+type ShipAutoMerge bool
+
+func (self ShipAutoMerge) Enabled() bool {
+	return bool(self)
+}
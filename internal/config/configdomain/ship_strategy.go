@@ -9,13 +9,25 @@ import (
 )
 
 const (
-	ShipStrategyAPI         ShipStrategy = "api"          // shipping via the code hosting API
+	ShipStrategyAPI         ShipStrategy = "api"          // shipping by squash-merging via the code hosting API
+	ShipStrategyAPIMerge    ShipStrategy = "api-merge"    // shipping by merging with a merge commit via the code hosting API
+	ShipStrategyAPIRebase   ShipStrategy = "api-rebase"   // shipping by rebase-merging via the code hosting API
 	ShipStragegyFastForward ShipStrategy = "fast-forward" // shipping by doing a local fast-forward
 	ShipStrategySquashMerge ShipStrategy = "squash-merge" // shipping by doing a local squash-merge
 )
 
 type ShipStrategy string
 
+// UsesAPI indicates whether this ship strategy merges proposals via the API of the hosting platform.
+func (self ShipStrategy) UsesAPI() bool {
+	switch self {
+	case ShipStrategyAPI, ShipStrategyAPIMerge, ShipStrategyAPIRebase:
+		return true
+	case ShipStragegyFastForward, ShipStrategySquashMerge:
+	}
+	return false
+}
+
 func (self ShipStrategy) String() string {
 	return string(self)
 }
@@ -37,6 +49,8 @@ func ParseShipStrategy(text string) (Option[ShipStrategy], error) {
 func ShipStrategies() []ShipStrategy {
 	return []ShipStrategy{
 		ShipStrategyAPI,
+		ShipStrategyAPIMerge,
+		ShipStrategyAPIRebase,
 		ShipStragegyFastForward,
 		ShipStrategySquashMerge,
 	}
@@ -33,3 +33,25 @@ func (self SingleSnapshot) LineageEntries() map[LineageKey]string {
 	}
 	return result
 }
+
+// provides all the keys that describe the parent commits that individual branches were last synced with
+func (self SingleSnapshot) ParentSHAEntries() map[ParentSHAKey]string {
+	result := map[ParentSHAKey]string{}
+	for key, value := range self {
+		if parentSHAKey, isParentSHAKey := NewParentSHAKey(key).Get(); isParentSHAKey {
+			result[parentSHAKey] = value
+		}
+	}
+	return result
+}
+
+// provides all the keys that describe the sync strategies of individual branches
+func (self SingleSnapshot) SyncStrategyEntries() map[SyncStrategyKey]string {
+	result := map[SyncStrategyKey]string{}
+	for key, value := range self {
+		if syncStrategyKey, isSyncStrategyKey := NewSyncStrategyKey(key).Get(); isSyncStrategyKey {
+			result[syncStrategyKey] = value
+		}
+	}
+	return result
+}
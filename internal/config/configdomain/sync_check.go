@@ -0,0 +1,8 @@
+package configdomain
+
+// indicates whether "git town sync" should only check for conflicts without syncing the branches
+type SyncCheck bool
+
+func (self SyncCheck) Enabled() bool {
+	return bool(self)
+}
@@ -0,0 +1,40 @@
+package configdomain
+
+import (
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// a Key that contains the sync strategy of an individual branch
+type SyncStrategyKey Key
+
+// NewSyncStrategyKey indicates using the returned option whether this key is a SyncStrategyKey.
+func NewSyncStrategyKey(key Key) Option[SyncStrategyKey] {
+	if isSyncStrategyKey(key.String()) {
+		return Some(SyncStrategyKey(key))
+	}
+	return None[SyncStrategyKey]()
+}
+
+// provides the name of the branch encoded in this SyncStrategyKey
+func (self SyncStrategyKey) BranchName() Option[gitdomain.LocalBranchName] {
+	return gitdomain.NewLocalBranchNameOption(strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(self.String(), LineageKeyPrefix), SyncStrategyKeySuffix)))
+}
+
+// converts this SyncStrategyKey into a generic Key
+func (self SyncStrategyKey) Key() Key {
+	return Key(self)
+}
+
+func (self SyncStrategyKey) String() string {
+	return string(self)
+}
+
+const SyncStrategyKeySuffix = ".sync-strategy"
+
+// indicates whether the given key value is for a SyncStrategyKey
+func isSyncStrategyKey(key string) bool {
+	return strings.HasPrefix(key, LineageKeyPrefix) && strings.HasSuffix(key, SyncStrategyKeySuffix)
+}
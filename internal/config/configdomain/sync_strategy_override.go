@@ -0,0 +1,133 @@
+package configdomain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// SyncStrategyOverride configures the sync strategy of the branches whose name matches a pattern,
+// overriding the sync strategy for their branch type.
+type SyncStrategyOverride struct {
+	Pattern  string         // the branch name or regular expression as configured by the user
+	Strategy SyncStrategy   // the sync strategy for the matching branches
+	regex    *regexp.Regexp // matches the entire names of the branches that this override applies to
+}
+
+// NewSyncStrategyOverride provides a SyncStrategyOverride that applies only to the branch with the given name.
+func NewSyncStrategyOverride(branch gitdomain.LocalBranchName, strategy SyncStrategy) SyncStrategyOverride {
+	return SyncStrategyOverride{
+		Pattern:  branch.String(),
+		Strategy: strategy,
+		regex:    regexp.MustCompile("^" + regexp.QuoteMeta(branch.String()) + "$"),
+	}
+}
+
+// ParseSyncStrategyOverride provides a SyncStrategyOverride that applies to the branches
+// whose entire name matches the given branch name or regular expression.
+func ParseSyncStrategyOverride(pattern, strategy string) (Option[SyncStrategyOverride], error) {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return None[SyncStrategyOverride](), nil
+	}
+	syncStrategy, err := ParseSyncStrategy(strategy)
+	if err != nil {
+		return None[SyncStrategyOverride](), err
+	}
+	regex, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return None[SyncStrategyOverride](), fmt.Errorf(messages.ConfigSyncStrategyOverrideInvalid, pattern, err)
+	}
+	if syncStrategy, hasSyncStrategy := syncStrategy.Get(); hasSyncStrategy {
+		return Some(SyncStrategyOverride{
+			Pattern:  pattern,
+			Strategy: syncStrategy,
+			regex:    regex,
+		}), nil
+	}
+	return None[SyncStrategyOverride](), nil
+}
+
+// Matches indicates whether this SyncStrategyOverride applies to the given branch.
+func (self SyncStrategyOverride) Matches(branch gitdomain.LocalBranchName) bool {
+	return self.regex.MatchString(branch.String())
+}
+
+func (self SyncStrategyOverride) String() string {
+	return fmt.Sprintf("%s: %s", self.Pattern, self.Strategy)
+}
+
+// SyncStrategyOverrides contains the sync strategies configured for individual branches.
+// Earlier entries take precedence over later ones.
+type SyncStrategyOverrides []SyncStrategyOverride
+
+// NewSyncStrategyOverridesFromSnapshot provides the sync strategies that the given Git metadata configures for individual branches.
+func NewSyncStrategyOverridesFromSnapshot(snapshot SingleSnapshot) (SyncStrategyOverrides, error) {
+	result := SyncStrategyOverrides{}
+	for key, value := range snapshot.SyncStrategyEntries() {
+		branch, hasBranch := key.BranchName().Get()
+		if !hasBranch {
+			continue
+		}
+		syncStrategyOpt, err := ParseSyncStrategy(value)
+		if err != nil {
+			return result, err
+		}
+		if syncStrategy, hasSyncStrategy := syncStrategyOpt.Get(); hasSyncStrategy {
+			result = append(result, NewSyncStrategyOverride(branch, syncStrategy))
+		}
+	}
+	slices.SortFunc(result, func(a, b SyncStrategyOverride) int {
+		return strings.Compare(a.Pattern, b.Pattern)
+	})
+	return result, nil
+}
+
+// ForBranchName provides the override that is configured for exactly the given branch name.
+func (self SyncStrategyOverrides) ForBranchName(branch gitdomain.LocalBranchName) Option[SyncStrategyOverride] {
+	for _, override := range self {
+		if override.Pattern == branch.String() {
+			return Some(override)
+		}
+	}
+	return None[SyncStrategyOverride]()
+}
+
+// RemoveBranchName provides a copy of these overrides without the override
+// that is configured for exactly the given branch name and takes precedence.
+func (self SyncStrategyOverrides) RemoveBranchName(branch gitdomain.LocalBranchName) SyncStrategyOverrides {
+	index := slices.IndexFunc(self, func(override SyncStrategyOverride) bool {
+		return override.Pattern == branch.String()
+	})
+	if index == -1 {
+		return self
+	}
+	return slices.Delete(slices.Clone(self), index, index+1)
+}
+
+func (self SyncStrategyOverrides) String() string {
+	result := make([]string, len(self))
+	for o, override := range self {
+		result[o] = override.String()
+	}
+	return strings.Join(result, ", ")
+}
+
+// SyncStrategy provides the sync strategy that overrides the sync strategy for the branch type of the given branch.
+// Overrides that contain the exact branch name take precedence over overrides whose regular expression matches the branch.
+func (self SyncStrategyOverrides) SyncStrategy(branch gitdomain.LocalBranchName) Option[SyncStrategy] {
+	if override, hasOverride := self.ForBranchName(branch).Get(); hasOverride {
+		return Some(override.Strategy)
+	}
+	for _, override := range self {
+		if override.Matches(branch) {
+			return Some(override.Strategy)
+		}
+	}
+	return None[SyncStrategy]()
+}
@@ -0,0 +1,151 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestSyncStrategyOverride(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ParseSyncStrategyOverride", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("branch name", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.ParseSyncStrategyOverride("feature", "merge")
+			must.NoError(t, err)
+			override := have.GetOrPanic()
+			must.EqOp(t, "feature: merge", override.String())
+			must.True(t, override.Matches("feature"))
+			must.False(t, override.Matches("feature-1"))
+			must.False(t, override.Matches("my-feature"))
+		})
+
+		t.Run("regular expression", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.ParseSyncStrategyOverride("shared-.*", "rebase")
+			must.NoError(t, err)
+			override := have.GetOrPanic()
+			must.EqOp(t, "shared-.*: rebase", override.String())
+			must.True(t, override.Matches("shared-1"))
+			must.True(t, override.Matches("shared-"))
+			must.False(t, override.Matches("unshared-1"))
+		})
+
+		t.Run("alternatives", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.ParseSyncStrategyOverride("one|two", "rebase")
+			must.NoError(t, err)
+			override := have.GetOrPanic()
+			must.True(t, override.Matches("one"))
+			must.True(t, override.Matches("two"))
+			must.False(t, override.Matches("one-two"))
+		})
+
+		t.Run("empty pattern", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.ParseSyncStrategyOverride(" ", "merge")
+			must.NoError(t, err)
+			must.True(t, have.IsNone())
+		})
+
+		t.Run("empty sync strategy", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.ParseSyncStrategyOverride("feature", "")
+			must.NoError(t, err)
+			must.True(t, have.IsNone())
+		})
+
+		t.Run("invalid pattern", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.ParseSyncStrategyOverride("feature-(", "merge")
+			must.Error(t, err)
+		})
+
+		t.Run("invalid sync strategy", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.ParseSyncStrategyOverride("feature", "zonk")
+			must.Error(t, err)
+		})
+	})
+
+	t.Run("ForBranchName", func(t *testing.T) {
+		t.Parallel()
+		overrides := configdomain.SyncStrategyOverrides{
+			mustParseSyncStrategyOverride("shared-.*", "merge"),
+			configdomain.NewSyncStrategyOverride("shared-2", configdomain.SyncStrategyCompress),
+		}
+		must.Eq(t, Some(overrides[1]), overrides.ForBranchName("shared-2"))
+		must.Eq(t, None[configdomain.SyncStrategyOverride](), overrides.ForBranchName("shared-1"))
+	})
+
+	t.Run("NewSyncStrategyOverridesFromSnapshot", func(t *testing.T) {
+		t.Parallel()
+		snapshot := configdomain.SingleSnapshot{
+			configdomain.Key("git-town-branch.beta.sync-strategy"):  "rebase",
+			configdomain.Key("git-town-branch.alpha.sync-strategy"): "merge",
+			configdomain.Key("git-town-branch.alpha.parent"):        "main",
+			configdomain.Key("git-town.sync-feature-strategy"):      "compress",
+		}
+		have, err := configdomain.NewSyncStrategyOverridesFromSnapshot(snapshot)
+		must.NoError(t, err)
+		must.EqOp(t, "alpha: merge, beta: rebase", have.String())
+	})
+
+	t.Run("RemoveBranchName", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("contains an override for the branch", func(t *testing.T) {
+			t.Parallel()
+			overrides := configdomain.SyncStrategyOverrides{
+				configdomain.NewSyncStrategyOverride("alpha", configdomain.SyncStrategyMerge),
+				mustParseSyncStrategyOverride("beta|gamma", "rebase"),
+				configdomain.NewSyncStrategyOverride("beta", configdomain.SyncStrategyCompress),
+			}
+			have := overrides.RemoveBranchName("beta")
+			must.EqOp(t, "alpha: merge, beta|gamma: rebase", have.String())
+			must.EqOp(t, "alpha: merge, beta|gamma: rebase, beta: compress", overrides.String())
+		})
+
+		t.Run("contains no override for the branch", func(t *testing.T) {
+			t.Parallel()
+			overrides := configdomain.SyncStrategyOverrides{
+				mustParseSyncStrategyOverride("beta|gamma", "rebase"),
+			}
+			have := overrides.RemoveBranchName("beta")
+			must.EqOp(t, "beta|gamma: rebase", have.String())
+		})
+	})
+
+	t.Run("SyncStrategy", func(t *testing.T) {
+		t.Parallel()
+		overrides := configdomain.SyncStrategyOverrides{
+			mustParseSyncStrategyOverride("shared-.*", "merge"),
+			configdomain.NewSyncStrategyOverride("shared-2", configdomain.SyncStrategyCompress),
+			mustParseSyncStrategyOverride("shared-.", "rebase"),
+		}
+		tests := map[gitdomain.LocalBranchName]Option[configdomain.SyncStrategy]{
+			"shared-1":  Some(configdomain.SyncStrategyMerge),
+			"shared-2":  Some(configdomain.SyncStrategyCompress),
+			"shared-22": Some(configdomain.SyncStrategyMerge),
+			"feature":   None[configdomain.SyncStrategy](),
+		}
+		for give, want := range tests {
+			have := overrides.SyncStrategy(give)
+			must.Eq(t, want, have)
+		}
+	})
+}
+
+func mustParseSyncStrategyOverride(pattern, strategy string) configdomain.SyncStrategyOverride {
+	override, err := configdomain.ParseSyncStrategyOverride(pattern, strategy)
+	if err != nil {
+		panic(err)
+	}
+	return override.GetOrPanic()
+}
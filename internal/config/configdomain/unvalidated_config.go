@@ -12,6 +12,9 @@ import (
 // If you need this information, validate it into a ValidatedConfig.
 type UnvalidatedConfig struct {
 	Aliases                  Aliases
+	AzureDevOpsToken         Option[AzureDevOpsToken]
+	BitbucketToken           Option[BitbucketToken]
+	ConflictResolutions      ConflictResolutions // rules for resolving conflicts in generated files automatically
 	ContributionBranches     gitdomain.LocalBranchNames
 	CreatePrototypeBranches  CreatePrototypeBranches
 	GitHubToken              Option[GitHubToken]
@@ -19,23 +22,35 @@ type UnvalidatedConfig struct {
 	GitUserEmail             Option[GitUserEmail]
 	GitUserName              Option[GitUserName]
 	GiteaToken               Option[GiteaToken]
+	HostingAPIURL            Option[HostingAPIURL]
+	HostingCAFile            Option[HostingCAFile]
 	HostingOriginHostname    Option[HostingOriginHostname]
 	HostingPlatform          Option[HostingPlatform] // Some = override by user, None = auto-detect
 	Lineage                  Lineage
 	MainBranch               Option[gitdomain.LocalBranchName]
 	ObservedBranches         gitdomain.LocalBranchNames
 	Offline                  Offline
+	ParentSHAs               ParentSHAs // the commits of the parent branches that individual branches were last synced with
 	ParkedBranches           gitdomain.LocalBranchNames
 	PerennialBranches        gitdomain.LocalBranchNames
 	PerennialRegex           Option[PerennialRegex]
+	ProposalBodySource       ProposalBodySource
+	ProposalRemote           gitdomain.Remote // the remote whose repository receives proposals
+	ProposalStackSection     ProposalStackSection
+	ProposeAssignees         []string // usernames that "git town propose" assigns new proposals to
+	ProposeDraft             ProposeDraft
+	ProposeLabels            []string // labels that "git town propose" adds to new proposals
+	ProposeReviewers         []string // usernames that "git town propose" requests reviews from for new proposals
 	PrototypeBranches        gitdomain.LocalBranchNames
 	PushHook                 PushHook
 	PushNewBranches          PushNewBranches
+	PushRemote               gitdomain.Remote // the remote that Git Town pushes new branches to
 	ShipDeleteTrackingBranch ShipDeleteTrackingBranch
 	ShipStrategy             ShipStrategy
 	SyncFeatureStrategy      SyncFeatureStrategy
 	SyncPerennialStrategy    SyncPerennialStrategy
 	SyncPrototypeStrategy    SyncPrototypeStrategy
+	SyncStrategyOverrides    SyncStrategyOverrides // sync strategies for individual branches
 	SyncTags                 SyncTags
 	SyncUpstream             SyncUpstream
 }
@@ -135,6 +150,9 @@ func (self *UnvalidatedConfig) ShouldPushNewBranches() bool {
 func DefaultConfig() UnvalidatedConfig {
 	return UnvalidatedConfig{
 		Aliases:                  Aliases{},
+		AzureDevOpsToken:         None[AzureDevOpsToken](),
+		BitbucketToken:           None[BitbucketToken](),
+		ConflictResolutions:      ConflictResolutions{},
 		ContributionBranches:     gitdomain.NewLocalBranchNames(),
 		CreatePrototypeBranches:  false,
 		GitHubToken:              None[GitHubToken](),
@@ -142,23 +160,35 @@ func DefaultConfig() UnvalidatedConfig {
 		GitUserEmail:             None[GitUserEmail](),
 		GitUserName:              None[GitUserName](),
 		GiteaToken:               None[GiteaToken](),
+		HostingAPIURL:            None[HostingAPIURL](),
+		HostingCAFile:            None[HostingCAFile](),
 		HostingOriginHostname:    None[HostingOriginHostname](),
 		HostingPlatform:          None[HostingPlatform](),
 		Lineage:                  NewLineage(),
 		MainBranch:               None[gitdomain.LocalBranchName](),
 		ObservedBranches:         gitdomain.NewLocalBranchNames(),
 		Offline:                  false,
+		ParentSHAs:               ParentSHAs{},
 		ParkedBranches:           gitdomain.NewLocalBranchNames(),
 		PerennialBranches:        gitdomain.NewLocalBranchNames(),
 		PerennialRegex:           None[PerennialRegex](),
+		ProposalBodySource:       ProposalBodySourceTemplate,
+		ProposalRemote:           gitdomain.RemoteOrigin,
+		ProposalStackSection:     true,
+		ProposeAssignees:         []string{},
+		ProposeDraft:             false,
+		ProposeLabels:            []string{},
+		ProposeReviewers:         []string{},
 		PrototypeBranches:        gitdomain.NewLocalBranchNames(),
 		PushHook:                 true,
 		PushNewBranches:          false,
+		PushRemote:               gitdomain.RemoteOrigin,
 		ShipDeleteTrackingBranch: true,
 		ShipStrategy:             ShipStrategyAPI,
 		SyncFeatureStrategy:      SyncFeatureStrategyMerge,
 		SyncPerennialStrategy:    SyncPerennialStrategyRebase,
 		SyncPrototypeStrategy:    SyncPrototypeStrategyRebase,
+		SyncStrategyOverrides:    SyncStrategyOverrides{},
 		SyncTags:                 true,
 		SyncUpstream:             true,
 	}
@@ -0,0 +1,9 @@
+package configdomain
+
+// indicates whether a Git Town command should talk to the API of the hosting platform
+// instead of opening a browser window
+type UseAPI bool
+
+func (self UseAPI) Enabled() bool {
+	return bool(self)
+}
@@ -4,32 +4,65 @@ package configfile
 type Data struct {
 	Branches                 *Branches     `toml:"branches"`
 	Hosting                  *Hosting      `toml:"hosting"`
+	ProposalBody             *string       `toml:"proposal-body"`
+	ProposalRemote           *string       `toml:"proposal-remote"`
+	ProposalStackSection     *bool         `toml:"proposal-stack-section"`
+	Propose                  *Propose      `toml:"propose"`
 	PushHook                 *bool         `toml:"push-hook"`
 	PushNewbranches          *bool         `toml:"push-new-branches"`
+	PushRemote               *string       `toml:"push-remote"`
 	ShipDeleteTrackingBranch *bool         `toml:"ship-delete-tracking-branch"`
 	ShipStrategy             *string       `toml:"ship-strategy"`
+	Sync                     *Sync         `toml:"sync"`
 	SyncStrategy             *SyncStrategy `toml:"sync-strategy"`
 	SyncTags                 *bool         `toml:"sync-tags"`
 	SyncUpstream             *bool         `toml:"sync-upstream"`
 }
 
 type Branches struct {
-	Main           *string  `toml:"main"`
-	PerennialRegex *string  `toml:"perennial-regex"`
-	Perennials     []string `toml:"perennials"`
+	Main           *string                   `toml:"main"`
+	Overrides      map[string]BranchOverride `toml:"overrides"`
+	PerennialRegex *string                   `toml:"perennial-regex"`
+	Perennials     []string                  `toml:"perennials"`
 }
 
 func (self Branches) IsEmpty() bool {
-	return self.Main == nil && len(self.Perennials) == 0
+	return self.Main == nil && len(self.Overrides) == 0 && len(self.Perennials) == 0
+}
+
+// BranchOverride contains the settings for the branches whose name matches the key of this entry in the overrides table.
+type BranchOverride struct {
+	SyncStrategy *string `toml:"sync-strategy"`
 }
 
 type Hosting struct {
+	APIURL         *string `toml:"api-url"`
+	CAFile         *string `toml:"ca-file"`
 	OriginHostname *string `toml:"origin-hostname"`
 	Platform       *string `toml:"platform"`
 }
 
 func (self Hosting) IsEmpty() bool {
-	return self.Platform == nil && self.OriginHostname == nil
+	return self.APIURL == nil && self.CAFile == nil && self.Platform == nil && self.OriginHostname == nil
+}
+
+// Propose contains the defaults for new proposals.
+type Propose struct {
+	Assignees []string `toml:"assignees"`
+	Draft     *bool    `toml:"draft"`
+	Labels    []string `toml:"labels"`
+	Reviewers []string `toml:"reviewers"`
+}
+
+// Sync contains the settings for syncing branches.
+type Sync struct {
+	Resolve []Resolve `toml:"resolve"`
+}
+
+// Resolve defines a rule that resolves conflicts in generated files automatically.
+type Resolve struct {
+	Command *string  `toml:"command"`
+	Paths   []string `toml:"paths"`
 }
 
 type SyncStrategy struct {
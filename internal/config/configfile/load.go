@@ -5,12 +5,14 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 
 	"github.com/BurntSushi/toml"
 	"github.com/git-town/git-town/v16/internal/config/configdomain"
 	"github.com/git-town/git-town/v16/internal/git/gitdomain"
 	"github.com/git-town/git-town/v16/internal/messages"
 	. "github.com/git-town/git-town/v16/pkg/prelude"
+	"golang.org/x/exp/maps"
 )
 
 // Decode converts the given config file TOML source into Go data.
@@ -48,6 +50,10 @@ func Validate(data Data) (configdomain.PartialConfig, error) {
 			result.MainBranch = gitdomain.NewLocalBranchNameOption(*data.Branches.Main)
 		}
 		result.PerennialBranches = gitdomain.NewLocalBranchNames(data.Branches.Perennials...)
+		result.SyncStrategyOverrides, err = validateSyncStrategyOverrides(data.Branches.Overrides)
+		if err != nil {
+			return result, err
+		}
 		if data.Branches.PerennialRegex != nil {
 			result.PerennialRegex, err = configdomain.ParsePerennialRegex(*data.Branches.PerennialRegex)
 			if err != nil {
@@ -56,6 +62,12 @@ func Validate(data Data) (configdomain.PartialConfig, error) {
 		}
 	}
 	if data.Hosting != nil {
+		if data.Hosting.APIURL != nil {
+			result.HostingAPIURL = configdomain.ParseHostingAPIURL(*data.Hosting.APIURL)
+		}
+		if data.Hosting.CAFile != nil {
+			result.HostingCAFile = configdomain.ParseHostingCAFile(*data.Hosting.CAFile)
+		}
 		if data.Hosting.Platform != nil {
 			result.HostingPlatform, err = configdomain.ParseHostingPlatform(*data.Hosting.Platform)
 		}
@@ -63,6 +75,20 @@ func Validate(data Data) (configdomain.PartialConfig, error) {
 			result.HostingOriginHostname = configdomain.ParseHostingOriginHostname(*data.Hosting.OriginHostname)
 		}
 	}
+	if data.Propose != nil {
+		result.ProposeAssignees = data.Propose.Assignees
+		if data.Propose.Draft != nil {
+			result.ProposeDraft = Some(configdomain.ProposeDraft(*data.Propose.Draft))
+		}
+		result.ProposeLabels = data.Propose.Labels
+		result.ProposeReviewers = data.Propose.Reviewers
+	}
+	if data.Sync != nil {
+		result.ConflictResolutions, err = validateConflictResolutions(data.Sync.Resolve)
+		if err != nil {
+			return result, err
+		}
+	}
 	if data.SyncStrategy != nil {
 		if data.SyncStrategy.FeatureBranches != nil {
 			result.SyncFeatureStrategy, err = configdomain.ParseSyncFeatureStrategy(*data.SyncStrategy.FeatureBranches)
@@ -71,9 +97,24 @@ func Validate(data Data) (configdomain.PartialConfig, error) {
 			result.SyncPerennialStrategy, err = configdomain.ParseSyncPerennialStrategy(*data.SyncStrategy.PerennialBranches)
 		}
 	}
+	if data.ProposalBody != nil {
+		result.ProposalBodySource, err = configdomain.ParseProposalBodySource(*data.ProposalBody)
+		if err != nil {
+			return result, err
+		}
+	}
+	if data.ProposalRemote != nil {
+		result.ProposalRemote = configdomain.ParseRemote(*data.ProposalRemote)
+	}
+	if data.ProposalStackSection != nil {
+		result.ProposalStackSection = Some(configdomain.ProposalStackSection(*data.ProposalStackSection))
+	}
 	if data.PushNewbranches != nil {
 		result.PushNewBranches = Some(configdomain.PushNewBranches(*data.PushNewbranches))
 	}
+	if data.PushRemote != nil {
+		result.PushRemote = configdomain.ParseRemote(*data.PushRemote)
+	}
 	if data.ShipDeleteTrackingBranch != nil {
 		result.ShipDeleteTrackingBranch = Some(configdomain.ShipDeleteTrackingBranch(*data.ShipDeleteTrackingBranch))
 	}
@@ -88,3 +129,41 @@ func Validate(data Data) (configdomain.PartialConfig, error) {
 	}
 	return result, err
 }
+
+// provides the conflict resolutions defined by the given resolve rules
+func validateConflictResolutions(rules []Resolve) (configdomain.ConflictResolutions, error) {
+	result := make(configdomain.ConflictResolutions, 0, len(rules))
+	for _, rule := range rules {
+		command := ""
+		if rule.Command != nil {
+			command = *rule.Command
+		}
+		resolution, err := configdomain.NewConflictResolution(command, rule.Paths)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, resolution)
+	}
+	return result, nil
+}
+
+// provides the sync strategy overrides in the given overrides table, ordered by branch pattern
+func validateSyncStrategyOverrides(overrides map[string]BranchOverride) (configdomain.SyncStrategyOverrides, error) {
+	result := configdomain.SyncStrategyOverrides{}
+	patterns := maps.Keys(overrides)
+	slices.Sort(patterns)
+	for _, pattern := range patterns {
+		syncStrategy := overrides[pattern].SyncStrategy
+		if syncStrategy == nil {
+			continue
+		}
+		override, err := configdomain.ParseSyncStrategyOverride(pattern, *syncStrategy)
+		if err != nil {
+			return result, err
+		}
+		if override, hasOverride := override.Get(); hasOverride {
+			result = append(result, override)
+		}
+	}
+	return result, nil
+}
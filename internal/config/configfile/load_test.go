@@ -16,7 +16,11 @@ func TestConfigfile(t *testing.T) {
 			t.Parallel()
 			give := `
 push-hook = true
+proposal-body = "commits"
+proposal-remote = "upstream"
+proposal-stack-section = true
 push-new-branches = true
+push-remote = "fork"
 ship-delete-tracking-branch = false
 ship-strategy = "api"
 sync-tags = false
@@ -27,22 +31,50 @@ main = "main"
 perennials = [ "public", "staging" ]
 perennial-regex = "release-.*"
 
+[branches.overrides]
+"shared-.*" = { sync-strategy = "merge" }
+
 [hosting]
 platform = "github"
 origin-hostname = "github.com"
+api-url = "https://api.github.example.com"
+ca-file = "/etc/ssl/example.pem"
+
+[propose]
+assignees = [ "alice" ]
+draft = true
+labels = [ "bug", "needs review" ]
+reviewers = [ "bob", "org/team" ]
 
 [sync-strategy]
 feature-branches = "merge"
 perennial-branches = "rebase"
+
+[[sync.resolve]]
+paths = [ "go.sum" ]
+command = "go mod tidy"
+
+[[sync.resolve]]
+paths = [ "package-lock.json", "yarn.lock" ]
+command = "npm install"
 `[1:]
 			have, err := configfile.Decode(give)
 			must.NoError(t, err)
+			apiURL := "https://api.github.example.com"
+			caFile := "/etc/ssl/example.pem"
 			github := "github"
 			githubCom := "github.com"
+			goModTidy := "go mod tidy"
 			main := "main"
 			merge := "merge"
+			npmInstall := "npm install"
+			proposalBody := "commits"
+			proposalRemote := "upstream"
+			proposalStackSection := true
+			proposeDraft := true
 			pushNewBranches := true
 			pushHook := true
+			pushRemote := "fork"
 			rebase := "rebase"
 			releaseRegex := "release-.*"
 			shipDeleteTrackingBranch := false
@@ -51,24 +83,45 @@ perennial-branches = "rebase"
 			syncUpstream := true
 			want := configfile.Data{
 				Branches: &configfile.Branches{
-					Main:           &main,
+					Main: &main,
+					Overrides: map[string]configfile.BranchOverride{
+						"shared-.*": {SyncStrategy: &merge},
+					},
 					Perennials:     []string{"public", "staging"},
 					PerennialRegex: &releaseRegex,
 				},
 				Hosting: &configfile.Hosting{
+					APIURL:         &apiURL,
+					CAFile:         &caFile,
 					Platform:       &github,
 					OriginHostname: &githubCom,
 				},
+				Propose: &configfile.Propose{
+					Assignees: []string{"alice"},
+					Draft:     &proposeDraft,
+					Labels:    []string{"bug", "needs review"},
+					Reviewers: []string{"bob", "org/team"},
+				},
 				SyncStrategy: &configfile.SyncStrategy{
 					FeatureBranches:   &merge,
 					PerennialBranches: &rebase,
 				},
+				ProposalBody:             &proposalBody,
+				ProposalRemote:           &proposalRemote,
+				ProposalStackSection:     &proposalStackSection,
 				PushHook:                 &pushHook,
 				PushNewbranches:          &pushNewBranches,
+				PushRemote:               &pushRemote,
 				ShipDeleteTrackingBranch: &shipDeleteTrackingBranch,
 				ShipStrategy:             &shipStrategy,
-				SyncTags:                 &syncTags,
-				SyncUpstream:             &syncUpstream,
+				Sync: &configfile.Sync{
+					Resolve: []configfile.Resolve{
+						{Command: &goModTidy, Paths: []string{"go.sum"}},
+						{Command: &npmInstall, Paths: []string{"package-lock.json", "yarn.lock"}},
+					},
+				},
+				SyncTags:     &syncTags,
+				SyncUpstream: &syncUpstream,
 			}
 			must.Eq(t, want, *have)
 		})
@@ -85,6 +138,7 @@ main = "main"
 			want := configfile.Data{
 				Branches: &configfile.Branches{
 					Main:           &main,
+					Overrides:      nil,
 					Perennials:     nil,
 					PerennialRegex: nil,
 				},
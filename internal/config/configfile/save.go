@@ -58,6 +58,15 @@ func RenderTOML(config *configdomain.UnvalidatedConfig) string {
 	} else {
 		result.WriteString(fmt.Sprintf("origin-hostname = %q\n", config.HostingOriginHostname))
 	}
+	if apiURL, hasAPIURL := config.HostingAPIURL.Get(); hasAPIURL {
+		result.WriteString("\n# The base URL of the API of your code hosting platform.\n")
+		result.WriteString(fmt.Sprintf("api-url = %q\n", apiURL))
+	}
+	if caFile, hasCAFile := config.HostingCAFile.Get(); hasCAFile {
+		result.WriteString("\n# A PEM file with additional certificate authorities to trust\n")
+		result.WriteString("# when talking to the API of your code hosting platform.\n")
+		result.WriteString(fmt.Sprintf("ca-file = %q\n", caFile))
+	}
 	result.WriteString("\n[sync-strategy]\n\n")
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.SyncFeatureStrategyHelp)) + "\n")
 	result.WriteString(fmt.Sprintf("feature-branches = %q\n\n", config.SyncFeatureStrategy))
@@ -43,6 +43,8 @@ func TestSave(t *testing.T) {
 		t.Parallel()
 		give := configdomain.UnvalidatedConfig{
 			CreatePrototypeBranches:  true,
+			HostingAPIURL:            None[configdomain.HostingAPIURL](),
+			HostingCAFile:            None[configdomain.HostingCAFile](),
 			HostingOriginHostname:    None[configdomain.HostingOriginHostname](),
 			HostingPlatform:          None[configdomain.HostingPlatform](),
 			Lineage:                  configdomain.NewLineage(),
@@ -103,7 +105,9 @@ create-prototype-branches = true
 #
 # Options:
 #
-# - api: merge the proposal on your code hosting platform via the code hosting API
+# - api: squash-merge the proposal on your code hosting platform via the code hosting API
+# - api-merge: merge the proposal with a merge commit on your code hosting platform via the code hosting API
+# - api-rebase: rebase-merge the proposal on your code hosting platform via the code hosting API
 # - fast-forward: in your local repo, fast-forward the parent branch to point to the commits on the feature branch
 # - squash-merge: in your local repo, squash-merge the feature branch into its parent branch
 #
@@ -230,7 +234,9 @@ create-prototype-branches = false
 #
 # Options:
 #
-# - api: merge the proposal on your code hosting platform via the code hosting API
+# - api: squash-merge the proposal on your code hosting platform via the code hosting API
+# - api-merge: merge the proposal with a merge commit on your code hosting platform via the code hosting API
+# - api-rebase: rebase-merge the proposal on your code hosting platform via the code hosting API
 # - fast-forward: in your local repo, fast-forward the parent branch to point to the commits on the feature branch
 # - squash-merge: in your local repo, squash-merge the feature branch into its parent branch
 #
@@ -64,7 +64,7 @@ func (self *Access) RemoveLocalConfigValue(key configdomain.Key) error {
 }
 
 // RemoveLocalGitConfiguration removes all Git Town configuration.
-func (self *Access) RemoveLocalGitConfiguration(lineage configdomain.Lineage) error {
+func (self *Access) RemoveLocalGitConfiguration(lineage configdomain.Lineage, parentSHAs configdomain.ParentSHAs, syncStrategyOverrides configdomain.SyncStrategyOverrides) error {
 	err := self.Run("git", "config", "--remove-section", "git-town")
 	if err != nil {
 		var exitErr *exec.ExitError
@@ -84,6 +84,18 @@ func (self *Access) RemoveLocalGitConfiguration(lineage configdomain.Lineage) er
 			return fmt.Errorf(messages.ConfigRemoveError, err)
 		}
 	}
+	for branch := range parentSHAs {
+		err = self.RemoveLocalConfigValue(configdomain.NewParentSHAKeyForBranch(branch))
+		if err != nil {
+			return fmt.Errorf(messages.ConfigRemoveError, err)
+		}
+	}
+	for _, override := range syncStrategyOverrides {
+		err = self.RemoveLocalConfigValue(configdomain.NewSyncStrategyKeyForBranch(gitdomain.NewLocalBranchName(override.Pattern)))
+		if err != nil {
+			return fmt.Errorf(messages.ConfigRemoveError, err)
+		}
+	}
 	return nil
 }
 
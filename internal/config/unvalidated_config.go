@@ -73,6 +73,30 @@ func (self *UnvalidatedConfig) OriginURL() Option[giturl.Parts] {
 	return self.RemoteURL(gitdomain.RemoteOrigin)
 }
 
+// ParentSHA provides the SHA of the parent commit that the given branch was last synced with.
+func (self *UnvalidatedConfig) ParentSHA(branch gitdomain.LocalBranchName) Option[gitdomain.SHA] {
+	sha, hasSHA := self.Config.Value.ParentSHAs[branch]
+	if !hasSHA {
+		return None[gitdomain.SHA]()
+	}
+	return Some(sha)
+}
+
+// ProposalRemoteURL provides the URL of the remote whose repository receives proposals.
+// Caches its result so can be called repeatedly.
+func (self *UnvalidatedConfig) ProposalRemoteURL() Option[giturl.Parts] {
+	return self.RemoteURL(self.Config.Value.ProposalRemote)
+}
+
+// PushRemoteURL provides the URL of the remote that Git Town pushes new branches to,
+// or nothing if Git Town pushes new branches to the remote that receives proposals.
+func (self *UnvalidatedConfig) PushRemoteURL() Option[giturl.Parts] {
+	if self.Config.Value.PushRemote == self.Config.Value.ProposalRemote {
+		return None[giturl.Parts]()
+	}
+	return self.RemoteURL(self.Config.Value.PushRemote)
+}
+
 // RemoteURL provides the URL for the given remote.
 // Tests can stub this through the GIT_TOWN_REMOTE environment variable.
 // Caches its result so can be called repeatedly.
@@ -135,6 +159,12 @@ func (self *UnvalidatedConfig) RemoveOutdatedConfiguration(localBranches gitdoma
 			self.RemoveParent(entry.Child)
 		}
 	}
+	for _, override := range self.LocalGitConfig.SyncStrategyOverrides {
+		branch := gitdomain.NewLocalBranchName(override.Pattern)
+		if !localBranches.Contains(branch) {
+			self.RemoveSyncStrategyOverride(branch)
+		}
+	}
 	return nil
 }
 
@@ -142,6 +172,10 @@ func (self *UnvalidatedConfig) RemoveOutdatedConfiguration(localBranches gitdoma
 func (self *UnvalidatedConfig) RemoveParent(branch gitdomain.LocalBranchName) {
 	self.LocalGitConfig.Lineage.RemoveBranch(branch)
 	_ = self.GitConfig.RemoveLocalConfigValue(configdomain.NewParentKey(branch))
+	if _, hasParentSHA := self.Config.Value.ParentSHAs[branch]; hasParentSHA {
+		delete(self.Config.Value.ParentSHAs, branch)
+		_ = self.GitConfig.RemoveLocalConfigValue(configdomain.NewParentSHAKeyForBranch(branch))
+	}
 }
 
 func (self *UnvalidatedConfig) RemovePerennialBranches() {
@@ -176,6 +210,16 @@ func (self *UnvalidatedConfig) RemoveSyncPerennialStrategy() {
 	_ = self.GitConfig.RemoveLocalConfigValue(configdomain.KeySyncPerennialStrategy)
 }
 
+// RemoveSyncStrategyOverride removes the sync strategy that the local Git configuration configures for the given branch.
+func (self *UnvalidatedConfig) RemoveSyncStrategyOverride(branch gitdomain.LocalBranchName) {
+	if self.LocalGitConfig.SyncStrategyOverrides.ForBranchName(branch).IsNone() {
+		return
+	}
+	self.LocalGitConfig.SyncStrategyOverrides = self.LocalGitConfig.SyncStrategyOverrides.RemoveBranchName(branch)
+	self.Config.Value.SyncStrategyOverrides = self.Config.Value.SyncStrategyOverrides.RemoveBranchName(branch)
+	_ = self.GitConfig.RemoveLocalConfigValue(configdomain.NewSyncStrategyKeyForBranch(branch))
+}
+
 func (self *UnvalidatedConfig) RemoveSyncTags() {
 	_ = self.GitConfig.RemoveLocalConfigValue(configdomain.KeySyncTags)
 }
@@ -225,6 +269,15 @@ func (self *UnvalidatedConfig) SetParent(branch, parentBranch gitdomain.LocalBra
 	return self.GitConfig.SetLocalConfigValue(configdomain.NewParentKey(branch), parentBranch.String())
 }
 
+// SetParentSHA records the SHA of the parent commit that the given branch is synced with.
+func (self *UnvalidatedConfig) SetParentSHA(branch gitdomain.LocalBranchName, sha gitdomain.SHA) error {
+	if self.DryRun {
+		return nil
+	}
+	self.Config.Value.ParentSHAs[branch] = sha
+	return self.GitConfig.SetLocalConfigValue(configdomain.NewParentSHAKeyForBranch(branch), sha.String())
+}
+
 // SetObservedBranches marks the given branches as perennial branches.
 func (self *UnvalidatedConfig) SetParkedBranches(branches gitdomain.LocalBranchNames) error {
 	self.Config.Value.ParkedBranches = branches
@@ -303,6 +356,17 @@ func (self *UnvalidatedConfig) SetSyncPerennialStrategy(strategy configdomain.Sy
 	return self.GitConfig.SetLocalConfigValue(configdomain.KeySyncPerennialStrategy, strategy.String())
 }
 
+// SetSyncStrategyOverride configures the given sync strategy for the given branch in the local Git configuration.
+func (self *UnvalidatedConfig) SetSyncStrategyOverride(branch gitdomain.LocalBranchName, strategy configdomain.SyncStrategy) error {
+	if self.DryRun {
+		return nil
+	}
+	override := configdomain.NewSyncStrategyOverride(branch, strategy)
+	self.LocalGitConfig.SyncStrategyOverrides = append(configdomain.SyncStrategyOverrides{override}, self.LocalGitConfig.SyncStrategyOverrides.RemoveBranchName(branch)...)
+	self.Config.Value.SyncStrategyOverrides = append(configdomain.SyncStrategyOverrides{override}, self.Config.Value.SyncStrategyOverrides.RemoveBranchName(branch)...)
+	return self.GitConfig.SetLocalConfigValue(configdomain.NewSyncStrategyKeyForBranch(branch), strategy.String())
+}
+
 // SetSyncPerennialStrategy updates the configured sync-perennial strategy.
 func (self *UnvalidatedConfig) SetSyncTags(value configdomain.SyncTags) error {
 	self.Config.Value.SyncTags = value
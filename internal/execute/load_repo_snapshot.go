@@ -55,7 +55,8 @@ func LoadRepoSnapshot(args LoadRepoSnapshotArgs) (gitdomain.BranchesSnapshot, gi
 			return gitdomain.EmptyBranchesSnapshot(), 0, false, err
 		}
 		if remotes.HasOrigin() && args.Repo.IsOffline.IsFalse() {
-			err = args.Git.Fetch(args.Frontend, args.UnvalidatedConfig.Config.Get().SyncTags)
+			config := args.UnvalidatedConfig.Config.Get()
+			err = args.Git.Fetch(args.Frontend, config.SyncTags, config.ProposalRemote, config.PushRemote)
 			if err != nil {
 				return gitdomain.EmptyBranchesSnapshot(), 0, false, err
 			}
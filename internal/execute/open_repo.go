@@ -105,6 +105,7 @@ func OpenRepo(args OpenRepoArgs) (OpenRepoResult, error) {
 		FinalMessages:     finalMessages,
 		Frontend:          frontEndRunner,
 		Git:               gitCommands,
+		GitVersion:        gitdomain.GitVersion{Major: gitVersionMajor, Minor: gitVersionMinor},
 		IsOffline:         isOffline,
 		RootDir:           rootDir,
 		UnvalidatedConfig: unvalidatedConfig,
@@ -127,6 +128,7 @@ type OpenRepoResult struct {
 	FinalMessages     stringslice.Collector
 	Frontend          gitdomain.Runner
 	Git               git.Commands
+	GitVersion        gitdomain.GitVersion
 	IsOffline         configdomain.Offline
 	RootDir           gitdomain.RepoRootDir
 	UnvalidatedConfig config.UnvalidatedConfig
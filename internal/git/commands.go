@@ -1,10 +1,14 @@
 package git
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"regexp"
+	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -34,9 +38,15 @@ func (self *Commands) AbortRebase(runner gitdomain.Runner) error {
 	return runner.Run("git", "rebase", "--abort")
 }
 
+// AddRemote registers a Git remote with the given name and URL.
+func (self *Commands) AddRemote(runner gitdomain.Runner, remote gitdomain.Remote, url string) error {
+	self.RemotesCache.Invalidate()
+	return runner.Run("git", "remote", "add", remote.String(), url)
+}
+
 // BranchAuthors provides the user accounts that contributed to the given branch.
 // Returns lines of "name <email>".
-func (self *Commands) BranchAuthors(querier gitdomain.Querier, branch, parent gitdomain.LocalBranchName) ([]gitdomain.Author, error) {
+func (self *Commands) BranchAuthors(querier gitdomain.Querier, branch, parent gitdomain.BranchName) ([]gitdomain.Author, error) {
 	output, err := querier.QueryTrim("git", "shortlog", "-s", "-n", "-e", parent.String()+".."+branch.String())
 	if err != nil {
 		return []gitdomain.Author(nil), err
@@ -151,6 +161,31 @@ func (self *Commands) CommitStagedChanges(runner gitdomain.Runner, message gitdo
 	return runner.Run("git", "commit", "--no-edit")
 }
 
+// CommitSigningEnabled indicates whether the Git configuration makes Git sign all commits.
+func (self *Commands) CommitSigningEnabled(querier gitdomain.Querier) bool {
+	output, err := querier.QueryTrim("git", "config", "--type=bool", "--get", "commit.gpgsign")
+	return err == nil && output == "true"
+}
+
+// CommitTree creates an unsigned commit with the given tree, message, and parents without updating any branch
+// and provides its abbreviated SHA.
+func (self *Commands) CommitTree(querier gitdomain.Querier, tree gitdomain.SHA, message gitdomain.CommitMessage, parents ...gitdomain.SHA) (gitdomain.SHA, error) {
+	args := []string{"commit-tree", "--no-gpg-sign", tree.String()}
+	for _, parent := range parents {
+		args = append(args, "-p", parent.String())
+	}
+	args = append(args, "-m", message.String())
+	output, err := querier.QueryTrim("git", args...)
+	if err != nil {
+		return "", err
+	}
+	shortSHA, err := querier.QueryTrim("git", "rev-parse", "--short", output)
+	if err != nil {
+		return "", err
+	}
+	return gitdomain.NewSHA(shortSHA), nil
+}
+
 func (self *Commands) CommitsInBranch(querier gitdomain.Querier, branch gitdomain.LocalBranchName, parent Option[gitdomain.LocalBranchName]) (gitdomain.Commits, error) {
 	if parent, hasParent := parent.Get(); hasParent {
 		return self.CommitsInFeatureBranch(querier, branch, parent)
@@ -204,11 +239,31 @@ func (self *Commands) CommitsInPerennialBranch(querier gitdomain.Querier) (gitdo
 	return result, nil
 }
 
+// ConflictingFiles provides the paths of the files that have unresolved conflicts in the ongoing merge or rebase.
+func (self *Commands) ConflictingFiles(querier gitdomain.Querier) ([]string, error) {
+	output, err := querier.QueryTrim("git", "diff", "--name-only", "--diff-filter=U")
+	if err != nil {
+		return []string{}, fmt.Errorf(messages.ConflictingFilesProblem, err)
+	}
+	result := []string{}
+	for _, line := range stringslice.Lines(output) {
+		if line != "" {
+			result = append(result, line)
+		}
+	}
+	return result, nil
+}
+
 // ContinueRebase continues the currently ongoing rebase.
 func (self *Commands) ContinueRebase(runner gitdomain.Runner) error {
 	return runner.Run("git", "rebase", "--continue")
 }
 
+// ContinueRebaseNoEdit continues the currently ongoing rebase without opening the editor for the commit message.
+func (self *Commands) ContinueRebaseNoEdit(runner gitdomain.Runner) error {
+	return runner.Run("git", "-c", "core.editor=true", "rebase", "--continue")
+}
+
 // CreateAndCheckoutBranch creates a new branch with the given name and checks it out using a single Git operation.
 // The created branch is a normal branch.
 // To create feature branches, use CreateFeatureBranch.
@@ -234,17 +289,17 @@ func (self *Commands) CreateBranch(runner gitdomain.Runner, name gitdomain.Local
 	return runner.Run("git", "branch", name.String(), parent.String())
 }
 
-// CreateRemoteBranch creates a remote branch from the given local SHA.
-func (self *Commands) CreateRemoteBranch(runner gitdomain.Runner, localSHA gitdomain.SHA, branch gitdomain.LocalBranchName, noPushHook configdomain.NoPushHook) error {
+// CreateRemoteBranch creates a branch at the given remote from the given local SHA.
+func (self *Commands) CreateRemoteBranch(runner gitdomain.Runner, remote gitdomain.Remote, localSHA gitdomain.SHA, branch gitdomain.LocalBranchName, noPushHook configdomain.NoPushHook) error {
 	args := []string{"push"}
 	if noPushHook {
 		args = append(args, "--no-verify")
 	}
-	args = append(args, gitdomain.RemoteOrigin.String(), localSHA.String()+":refs/heads/"+branch.String())
+	args = append(args, remote.String(), localSHA.String()+":refs/heads/"+branch.String())
 	return runner.Run("git", args...)
 }
 
-// pushes the branch with the given name to origin
+// pushes the branch with the given name to the given remote
 func (self *Commands) CreateTrackingBranch(runner gitdomain.Runner, branch gitdomain.LocalBranchName, remote gitdomain.Remote, noPushHook configdomain.NoPushHook) error {
 	args := []string{"push"}
 	if noPushHook {
@@ -255,6 +310,28 @@ func (self *Commands) CreateTrackingBranch(runner gitdomain.Runner, branch gitdo
 	return runner.Run("git", args...)
 }
 
+// CredentialPassword provides the password that the Git credential helpers configured for the given host store.
+// Only asks Git for credentials if a credential helper is configured for the host,
+// so that Git doesn't prompt the user for them.
+func (self *Commands) CredentialPassword(querier gitdomain.Querier, host string) Option[string] {
+	url := "https://" + host
+	helpers, err := querier.QueryTrim("git", "config", "--get-urlmatch", "credential.helper", url)
+	if err != nil || helpers == "" {
+		return None[string]()
+	}
+	// disable prompts by Git and by interactive credential helpers like the Git Credential Manager
+	output, err := querier.QueryWithInput(fmt.Sprintf("protocol=https\nhost=%s\n\n", host), "git", "-c", "core.askPass=true", "-c", "credential.interactive=false", "credential", "fill")
+	if err != nil {
+		return None[string]()
+	}
+	for _, line := range stringslice.Lines(output) {
+		if password, isPassword := strings.CutPrefix(line, "password="); isPassword {
+			return NewOption(strings.TrimSpace(password))
+		}
+	}
+	return None[string]()
+}
+
 // CurrentBranch provides the name of the currently checked out branch.
 func (self *Commands) CurrentBranch(querier gitdomain.Querier) (gitdomain.LocalBranchName, error) {
 	if !self.CurrentBranchCache.Initialized() {
@@ -269,9 +346,10 @@ func (self *Commands) CurrentBranch(querier gitdomain.Querier) (gitdomain.LocalB
 
 // CurrentBranch provides the currently checked out branch.
 func (self *Commands) CurrentBranchUncached(querier gitdomain.Querier) (gitdomain.LocalBranchName, error) {
-	// first try to detect the current branch the normal way
-	output, err := querier.QueryTrim("git", "rev-parse", "--abbrev-ref", "HEAD")
-	if err == nil && output != "HEAD" {
+	// first try to detect the current branch the normal way,
+	// "git rev-parse --abbrev-ref HEAD" would provide "heads/alice/feature" for a local branch "alice/feature" if remote "alice" has a branch "feature"
+	output, err := querier.QueryTrim("git", "branch", "--show-current")
+	if err == nil && output != "" {
 		return gitdomain.NewLocalBranchName(output), nil
 	}
 	// here we couldn't detect the current branch the normal way --> assume we are in a rebase and try the rebase way
@@ -336,12 +414,36 @@ func (self *Commands) DropStash(runner gitdomain.Runner) error {
 	return runner.Run("git", "stash", "drop")
 }
 
-// Fetch retrieves the updates from the origin repo.
-func (self *Commands) Fetch(runner gitdomain.Runner, syncTags configdomain.SyncTags) error {
+// Fetch retrieves the updates from the given remotes.
+// Without a remote other than origin, it fetches from the default remote.
+func (self *Commands) Fetch(runner gitdomain.Runner, syncTags configdomain.SyncTags, remotes ...gitdomain.Remote) error {
+	args := []string{"fetch", "--prune"}
 	if syncTags.IsTrue() {
-		return runner.Run("git", "fetch", "--prune", "--tags")
+		args = append(args, "--tags")
+	} else {
+		args = append(args, "--no-tags")
+	}
+	if slices.ContainsFunc(remotes, func(remote gitdomain.Remote) bool { return remote != gitdomain.RemoteOrigin }) {
+		// "git fetch" fetches only the remote of the current branch
+		args = append(args, "--multiple")
+		for r, remote := range remotes {
+			if !slices.Contains(remotes[:r], remote) {
+				args = append(args, remote.String())
+			}
+		}
 	}
-	return runner.Run("git", "fetch", "--prune", "--no-tags")
+	return runner.Run("git", args...)
+}
+
+// FetchBranchFromURL fetches the branch with the given name from the repository at the given URL into FETCH_HEAD.
+// This doesn't add a remote or remote-tracking branches.
+func (self *Commands) FetchBranchFromURL(runner gitdomain.Runner, url string, branch gitdomain.LocalBranchName) error {
+	return runner.Run("git", "fetch", url, branch.String())
+}
+
+// FetchRemoteBranch fetches the branch with the given name from the given remote.
+func (self *Commands) FetchRemoteBranch(runner gitdomain.Runner, remote gitdomain.Remote, branch gitdomain.LocalBranchName) error {
+	return runner.Run("git", "fetch", remote.String(), branch.String())
 }
 
 // FetchUpstream fetches updates from the upstream remote.
@@ -349,6 +451,16 @@ func (self *Commands) FetchUpstream(runner gitdomain.Runner, branch gitdomain.Lo
 	return runner.Run("git", "fetch", gitdomain.RemoteUpstream.String(), branch.String())
 }
 
+// FileContent provides the content of the file at the given path in the given branch,
+// or nothing if that file doesn't exist in that branch.
+func (self *Commands) FileContent(querier gitdomain.Querier, branch gitdomain.BranchName, path string) Option[string] {
+	content, err := querier.Query("git", "show", fmt.Sprintf("%s:%s", branch, path))
+	if err != nil {
+		return None[string]()
+	}
+	return Some(content)
+}
+
 // provides the commit message of the first commit in the branch with the given name
 func (self *Commands) FirstCommitMessageInBranch(runner gitdomain.Querier, branch, mainBranch gitdomain.BranchName) (Option[gitdomain.CommitMessage], error) {
 	output, err := runner.QueryTrim("git", "log", fmt.Sprintf("%s..%s", mainBranch, branch), "--format=%h")
@@ -393,6 +505,32 @@ func (self *Commands) HasLocalBranch(runner gitdomain.Runner, name gitdomain.Loc
 	return runner.Run("git", "show-ref", "--quiet", "refs/heads/"+name.String()) == nil
 }
 
+// HasMergeHooks indicates whether this repo has Git hooks that run when creating merge commits.
+func (self *Commands) HasMergeHooks(querier gitdomain.Querier) bool {
+	hooksDir, err := querier.QueryTrim("git", "rev-parse", "--git-path", "hooks")
+	if err != nil {
+		return false
+	}
+	if !filepath.IsAbs(hooksDir) {
+		rootDir, hasRootDir := self.RootDirectory(querier).Get()
+		if !hasRootDir {
+			return false
+		}
+		hooksDir = filepath.Join(rootDir.String(), hooksDir)
+	}
+	for _, hook := range []string{"commit-msg", "post-merge", "pre-merge-commit", "prepare-commit-msg"} {
+		info, err := os.Stat(filepath.Join(hooksDir, hook))
+		if err != nil || info.IsDir() {
+			continue
+		}
+		// Git ignores hook files that aren't executable, Windows doesn't have an executable bit
+		if runtime.GOOS == "windows" || info.Mode()&0o111 != 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // HasMergeInProgress indicates whether this Git repository currently has a merge in progress.
 func (self *Commands) HasMergeInProgress(runner gitdomain.Runner) bool {
 	err := runner.Run("git", "rev-parse", "-q", "--verify", "MERGE_HEAD")
@@ -409,6 +547,12 @@ func (self *Commands) HasShippableChanges(querier gitdomain.Querier, branch, mai
 	return out != "", nil
 }
 
+// IsAncestor indicates whether the given commit is part of the history of the given location.
+func (self *Commands) IsAncestor(querier gitdomain.Querier, sha gitdomain.SHA, location gitdomain.Location) bool {
+	_, err := querier.Query("git", "merge-base", "--is-ancestor", sha.String(), location.String())
+	return err == nil
+}
+
 // LastCommitMessage provides the commit message for the last commit.
 func (self *Commands) LastCommitMessage(querier gitdomain.Querier) (gitdomain.CommitMessage, error) {
 	out, err := querier.QueryTrim("git", "log", "-1", "--format=%B")
@@ -428,6 +572,31 @@ func (self *Commands) MergeFastForward(runner gitdomain.Runner, branch gitdomain
 	return runner.Run("git", "merge", "--ff-only", branch.String())
 }
 
+// MergeTree merges the given commits in memory, without touching the worktree, the index, or any branches.
+// Provides the resulting tree and the names of the files that conflict.
+func (self *Commands) MergeTree(querier gitdomain.Querier, ours, theirs gitdomain.SHA) (tree gitdomain.SHA, conflictingFiles []string, err error) {
+	conflictingFiles = []string{}
+	output, err := querier.Query("git", "merge-tree", "--write-tree", "--name-only", "--no-messages", ours.String(), theirs.String())
+	if err != nil {
+		// Git exits with code 1 if the merge has conflicts
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) || exitErr.ExitCode() != 1 {
+			return "", conflictingFiles, err
+		}
+	}
+	// the first line contains the resulting tree, the following lines the conflicting files
+	lines := stringslice.Lines(strings.TrimSpace(output))
+	if len(lines) == 0 || lines[0] == "" {
+		return "", conflictingFiles, fmt.Errorf(messages.MergeTreeUnexpectedOutput, output)
+	}
+	for _, line := range lines[1:] {
+		if line != "" && !slices.Contains(conflictingFiles, line) {
+			conflictingFiles = append(conflictingFiles, line)
+		}
+	}
+	return gitdomain.NewSHA(lines[0]), conflictingFiles, nil
+}
+
 // NavigateToDir changes into the root directory of the current repository.
 func (self *Commands) NavigateToDir(dir gitdomain.RepoRootDir) error {
 	return os.Chdir(dir.String())
@@ -467,6 +636,16 @@ func (self *Commands) Pull(runner gitdomain.Runner) error {
 	return runner.Run("git", "pull")
 }
 
+// PushBranch pushes the given local branch to the given remote without checking it out.
+func (self *Commands) PushBranch(runner gitdomain.Runner, remote gitdomain.Remote, branch gitdomain.LocalBranchName, noPushHook configdomain.NoPushHook) error {
+	args := []string{"push"}
+	if noPushHook {
+		args = append(args, "--no-verify")
+	}
+	args = append(args, remote.String(), branch.String())
+	return runner.Run("git", args...)
+}
+
 // PushCurrentBranch pushes the current branch to its tracking branch.
 func (self *Commands) PushCurrentBranch(runner gitdomain.Runner, noPushHook configdomain.NoPushHook) error {
 	args := []string{"push"}
@@ -486,6 +665,11 @@ func (self *Commands) Rebase(runner gitdomain.Runner, target gitdomain.BranchNam
 	return runner.Run("git", "rebase", target.String())
 }
 
+// RebaseOnto moves the commits of the current branch that aren't part of the given upstream commit onto the given target branch.
+func (self *Commands) RebaseOnto(runner gitdomain.Runner, target gitdomain.BranchName, upstream gitdomain.SHA) error {
+	return runner.Run("git", "rebase", "--onto", target.String(), upstream.String())
+}
+
 // Remotes provides the names of all Git remotes in this repository.
 func (self *Commands) Remotes(querier gitdomain.Querier) (gitdomain.Remotes, error) {
 	if !self.RemotesCache.Initialized() {
@@ -525,11 +709,27 @@ func (self *Commands) RemoveGitLabToken(runner gitdomain.Runner) error {
 	return runner.Run("git", "config", "--unset", configdomain.KeyGitlabToken.String())
 }
 
+// RemoveAzureDevOpsToken removes the stored token for the Azure DevOps API.
+func (self *Commands) RemoveAzureDevOpsToken(runner gitdomain.Runner) error {
+	return runner.Run("git", "config", "--unset", configdomain.KeyAzureDevOpsToken.String())
+}
+
+// RemoveBitbucketToken removes the stored token for the Bitbucket API.
+func (self *Commands) RemoveBitbucketToken(runner gitdomain.Runner) error {
+	return runner.Run("git", "config", "--unset", configdomain.KeyBitbucketToken.String())
+}
+
 // RemoveHubToken removes the stored token for the GitHub API.
 func (self *Commands) RemoveGiteaToken(runner gitdomain.Runner) error {
 	return runner.Run("git", "config", "--unset", configdomain.KeyGiteaToken.String())
 }
 
+// RemoveRemote removes the Git remote with the given name.
+func (self *Commands) RemoveRemote(runner gitdomain.Runner, remote gitdomain.Remote) error {
+	self.RemotesCache.Invalidate()
+	return runner.Run("git", "remote", "remove", remote.String())
+}
+
 // RepoStatus provides a summary of the state the current workspace is in right now: rebasing, has conflicts, has open changes, etc.
 func (self *Commands) RepoStatus(querier gitdomain.Querier) (gitdomain.RepoStatus, error) {
 	output, err := querier.QueryTrim("git", "status", "--long", "--ignore-submodules")
@@ -565,7 +765,8 @@ func (self *Commands) ResetCurrentBranchToSHA(runner gitdomain.Runner, sha gitdo
 
 // ResetRemoteBranchToSHA sets the given remote branch to the given SHA.
 func (self *Commands) ResetRemoteBranchToSHA(runner gitdomain.Runner, branch gitdomain.RemoteBranchName, sha gitdomain.SHA) error {
-	return runner.Run("git", "push", "--force-with-lease", gitdomain.RemoteOrigin.String(), sha.String()+":"+branch.LocalBranchName().String())
+	remote, localBranch := branch.Parts()
+	return runner.Run("git", "push", "--force-with-lease", remote.String(), sha.String()+":"+localBranch.String())
 }
 
 // RevertCommit reverts the commit with the given SHA.
@@ -597,6 +798,16 @@ func (self *Commands) SetGitAlias(runner gitdomain.Runner, aliasableCommand conf
 	return runner.Run("git", "config", "--global", aliasableCommand.Key().String(), "town "+aliasableCommand.String())
 }
 
+// SetAzureDevOpsToken sets the given API token for the Azure DevOps API.
+func (self *Commands) SetAzureDevOpsToken(runner gitdomain.Runner, value configdomain.AzureDevOpsToken) error {
+	return runner.Run("git", "config", configdomain.KeyAzureDevOpsToken.String(), value.String())
+}
+
+// SetBitbucketToken sets the given API token for the Bitbucket API.
+func (self *Commands) SetBitbucketToken(runner gitdomain.Runner, value configdomain.BitbucketToken) error {
+	return runner.Run("git", "config", configdomain.KeyBitbucketToken.String(), value.String())
+}
+
 // SetGitHubToken sets the given API token for the GitHub API.
 func (self *Commands) SetGitHubToken(runner gitdomain.Runner, value configdomain.GitHubToken) error {
 	return runner.Run("git", "config", configdomain.KeyGithubToken.String(), value.String())
@@ -623,9 +834,9 @@ func (self *Commands) SetOriginHostname(runner gitdomain.Runner, hostname config
 }
 
 // ShouldPushBranch returns whether the local branch with the given name
-// contains commits that have not been pushed to its tracking branch.
-func (self *Commands) ShouldPushBranch(querier gitdomain.Querier, branch gitdomain.LocalBranchName) (bool, error) {
-	out, err := querier.QueryTrim("git", "rev-list", "--left-right", branch.String()+"..."+branch.TrackingBranch().String())
+// contains commits that have not been pushed to the given tracking branch.
+func (self *Commands) ShouldPushBranch(querier gitdomain.Querier, branch gitdomain.LocalBranchName, trackingBranch gitdomain.RemoteBranchName) (bool, error) {
+	out, err := querier.QueryTrim("git", "rev-list", "--left-right", branch.String()+"..."+trackingBranch.String())
 	if err != nil {
 		return false, fmt.Errorf(messages.DiffProblem, branch, branch, err)
 	}
@@ -643,6 +854,11 @@ func (self *Commands) StageFiles(runner gitdomain.Runner, names ...string) error
 	return runner.Run("git", args...)
 }
 
+// StageTrackedChanges adds all changes to tracked files in the entire repository to the Git index.
+func (self *Commands) StageTrackedChanges(runner gitdomain.Runner) error {
+	return runner.Run("git", "add", "--update")
+}
+
 // StartCommit starts a commit and stops at asking the user for the commit message.
 func (self *Commands) StartCommit(runner gitdomain.Runner) error {
 	return runner.Run("git", "commit")
@@ -663,10 +879,30 @@ func (self *Commands) StashSize(querier gitdomain.Querier) (gitdomain.StashSize,
 	return gitdomain.StashSize(len(stringslice.Lines(output))), err
 }
 
+// TrackingBranch provides the remote branch that the given local branch tracks.
+// If Git Town pushes branches to origin, this is the branch with the same name at origin.
+// Otherwise branches can track different remotes, so it asks Git and falls back to the branch at the push remote.
+func (self *Commands) TrackingBranch(querier gitdomain.Querier, branch gitdomain.LocalBranchName, pushRemote gitdomain.Remote) gitdomain.RemoteBranchName {
+	if pushRemote == gitdomain.RemoteOrigin {
+		return branch.TrackingBranch()
+	}
+	output, err := querier.QueryTrim("git", "rev-parse", "--verify", "--quiet", "--symbolic-full-name", branch.String()+"@{upstream}")
+	if trackingBranch, isRemoteBranch := strings.CutPrefix(output, "refs/remotes/"); err == nil && isRemoteBranch {
+		return gitdomain.NewRemoteBranchName(trackingBranch)
+	}
+	return branch.AtRemote(pushRemote)
+}
+
 func (self *Commands) UndoLastCommit(runner gitdomain.Runner) error {
 	return runner.Run("git", "reset", "--soft", "HEAD~1")
 }
 
+// UpdateBranchRef points the given local branch to the given new commit without checking it out.
+// Git only updates the branch if it still points to the given old commit.
+func (self *Commands) UpdateBranchRef(runner gitdomain.Runner, branch gitdomain.LocalBranchName, newSHA, oldSHA gitdomain.SHA) error {
+	return runner.Run("git", "update-ref", "refs/heads/"+branch.String(), newSHA.String(), oldSHA.String())
+}
+
 // Version indicates whether the needed Git version is installed.
 func (self *Commands) Version(querier gitdomain.Querier) (major int, minor int, err error) {
 	versionRegexp := regexp.MustCompile(`git version (\d+).(\d+).(\d+)`)
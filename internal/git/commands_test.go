@@ -1,6 +1,9 @@
 package git_test
 
 import (
+	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/git-town/git-town/v16/internal/git"
@@ -35,7 +38,7 @@ func TestBackendCommands(t *testing.T) {
 			FileName:    "file2",
 			Message:     "second commit",
 		})
-		authors, err := runtime.TestCommands.BranchAuthors(runtime.TestRunner, branch, initial)
+		authors, err := runtime.TestCommands.BranchAuthors(runtime.TestRunner, branch.BranchName(), initial.BranchName())
 		must.NoError(t, err)
 		must.Eq(t, []gitdomain.Author{"user <email@example.com>"}, authors)
 	})
@@ -98,6 +101,27 @@ func TestBackendCommands(t *testing.T) {
 		must.EqOp(t, initial, currentBranch)
 	})
 
+	t.Run("CommitSigningEnabled", func(t *testing.T) {
+		t.Parallel()
+		t.Run("signing not configured", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			must.False(t, runtime.Commands.CommitSigningEnabled(runtime.TestRunner))
+		})
+		t.Run("signing enabled", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			runtime.MustRun("git", "config", "commit.gpgsign", "true")
+			must.True(t, runtime.Commands.CommitSigningEnabled(runtime.TestRunner))
+		})
+		t.Run("signing disabled", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			runtime.MustRun("git", "config", "commit.gpgsign", "false")
+			must.False(t, runtime.Commands.CommitSigningEnabled(runtime.TestRunner))
+		})
+	})
+
 	t.Run("CommitsInBranch", func(t *testing.T) {
 		t.Parallel()
 		t.Run("feature branch contains commits", func(t *testing.T) {
@@ -156,6 +180,24 @@ func TestBackendCommands(t *testing.T) {
 		})
 	})
 
+	t.Run("CredentialPassword", func(t *testing.T) {
+		t.Parallel()
+		t.Run("credential helper knows the host", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			runtime.MustRun("git", "config", "credential.helper", "!f() { echo username=me; echo password=secret; }; f")
+			have := runtime.Commands.CredentialPassword(runtime.TestCommands, "github.com")
+			want := Some("secret")
+			must.Eq(t, want, have)
+		})
+		t.Run("no credential helper configured", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			have := runtime.Commands.CredentialPassword(runtime.TestCommands, "github.com")
+			must.Eq(t, None[string](), have)
+		})
+	})
+
 	t.Run("CurrentBranch", func(t *testing.T) {
 		t.Parallel()
 		runtime := testruntime.Create(t)
@@ -307,6 +349,38 @@ func TestBackendCommands(t *testing.T) {
 		})
 	})
 
+	t.Run("HasMergeHooks", func(t *testing.T) {
+		t.Parallel()
+		t.Run("no hooks", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			must.False(t, runtime.Commands.HasMergeHooks(runtime.TestRunner))
+		})
+		t.Run("executable merge hook", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			runtime.CreateFile(filepath.Join(".git", "hooks", "post-merge"), "#!/bin/sh\nexit 0\n")
+			must.True(t, runtime.Commands.HasMergeHooks(runtime.TestRunner))
+		})
+		t.Run("non-executable merge hook", func(t *testing.T) {
+			t.Parallel()
+			if runtime.GOOS == "windows" {
+				t.Skip("Windows doesn't have an executable bit")
+			}
+			testRuntime := testruntime.Create(t)
+			hooksDir := filepath.Join(testRuntime.WorkingDir, ".git", "hooks")
+			must.NoError(t, os.MkdirAll(hooksDir, 0o700))
+			must.NoError(t, os.WriteFile(filepath.Join(hooksDir, "post-merge"), []byte("#!/bin/sh\nexit 0\n"), 0o600))
+			must.False(t, testRuntime.Commands.HasMergeHooks(testRuntime.TestRunner))
+		})
+		t.Run("executable hook that doesn't run on merges", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			runtime.CreateFile(filepath.Join(".git", "hooks", "pre-push"), "#!/bin/sh\nexit 0\n")
+			must.False(t, runtime.Commands.HasMergeHooks(runtime.TestRunner))
+		})
+	})
+
 	t.Run("HasLocalBranch", func(t *testing.T) {
 		t.Parallel()
 		origin := testruntime.Create(t)
@@ -918,7 +992,7 @@ func TestBackendCommands(t *testing.T) {
 			must.NoError(t, err)
 			err = local.CreateTrackingBranch(local.TestRunner, "branch", gitdomain.RemoteOrigin, false)
 			must.NoError(t, err)
-			shouldPush, err := local.ShouldPushBranch(local.TestRunner, "branch")
+			shouldPush, err := local.ShouldPushBranch(local.TestRunner, "branch", "origin/branch")
 			must.NoError(t, err)
 			must.False(t, shouldPush)
 		})
@@ -936,7 +1010,7 @@ func TestBackendCommands(t *testing.T) {
 				FileName:    "local_file",
 				Message:     "add local file",
 			})
-			shouldPush, err := local.ShouldPushBranch(local.TestRunner, "branch")
+			shouldPush, err := local.ShouldPushBranch(local.TestRunner, "branch", "origin/branch")
 			must.NoError(t, err)
 			must.True(t, shouldPush)
 		})
@@ -955,7 +1029,7 @@ func TestBackendCommands(t *testing.T) {
 				Message:     "add remote file",
 			})
 			local.Fetch()
-			shouldPush, err := local.ShouldPushBranch(local.TestRunner, "branch")
+			shouldPush, err := local.ShouldPushBranch(local.TestRunner, "branch", "origin/branch")
 			must.NoError(t, err)
 			must.True(t, shouldPush)
 		})
@@ -980,7 +1054,7 @@ func TestBackendCommands(t *testing.T) {
 				Message:     "add remote file",
 			})
 			local.Fetch()
-			shouldPush, err := local.ShouldPushBranch(local.TestRunner, "branch")
+			shouldPush, err := local.ShouldPushBranch(local.TestRunner, "branch", "origin/branch")
 			must.NoError(t, err)
 			must.True(t, shouldPush)
 		})
@@ -1009,4 +1083,33 @@ func TestBackendCommands(t *testing.T) {
 			must.EqOp(t, want, have)
 		})
 	})
+
+	t.Run("TrackingBranch", func(t *testing.T) {
+		t.Parallel()
+		t.Run("push remote is origin", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			have := runtime.TrackingBranch(runtime.TestRunner, "branch", gitdomain.RemoteOrigin)
+			must.EqOp(t, "origin/branch", have)
+		})
+		t.Run("branch tracks a branch at another remote than the push remote", func(t *testing.T) {
+			t.Parallel()
+			origin := testruntime.Create(t)
+			local := testruntime.Clone(origin.TestRunner, t.TempDir())
+			err := local.CreateAndCheckoutBranch(local.TestRunner, "branch")
+			must.NoError(t, err)
+			err = local.CreateTrackingBranch(local.TestRunner, "branch", gitdomain.RemoteOrigin, false)
+			must.NoError(t, err)
+			have := local.TrackingBranch(local.TestRunner, "branch", gitdomain.RemoteUpstream)
+			must.EqOp(t, "origin/branch", have)
+		})
+		t.Run("branch has no tracking branch", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			err := runtime.CreateAndCheckoutBranch(runtime.TestRunner, "branch")
+			must.NoError(t, err)
+			have := runtime.TrackingBranch(runtime.TestRunner, "branch", gitdomain.RemoteUpstream)
+			must.EqOp(t, "upstream/branch", have)
+		})
+	})
 }
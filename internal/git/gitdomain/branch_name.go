@@ -25,6 +25,11 @@ func (self BranchName) LocalName() LocalBranchName {
 	return NewLocalBranchName(strings.TrimPrefix(string(self), "origin/"))
 }
 
+// Location widens the type of this BranchName to a more generic Location.
+func (self BranchName) Location() Location {
+	return NewLocation(string(self))
+}
+
 // RemoteName provides the remote version of this branch name.
 func (self BranchName) RemoteName() RemoteBranchName {
 	if strings.HasPrefix(string(self), "origin/") {
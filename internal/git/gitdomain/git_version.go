@@ -0,0 +1,17 @@
+package gitdomain
+
+// GitVersion describes the version of the installed Git executable.
+type GitVersion struct {
+	Major int
+	Minor int
+}
+
+// IsAtLeast indicates whether this Git version is the given version or newer.
+func (self GitVersion) IsAtLeast(major, minor int) bool {
+	return self.Major > major || (self.Major == major && self.Minor >= minor)
+}
+
+// SupportsMergeTree indicates whether this Git version can merge commits without a worktree using "git merge-tree --write-tree".
+func (self GitVersion) SupportsMergeTree() bool {
+	return self.IsAtLeast(2, 38)
+}
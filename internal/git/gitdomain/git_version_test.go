@@ -0,0 +1,29 @@
+package gitdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestGitVersion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("IsAtLeast", func(t *testing.T) {
+		t.Parallel()
+		version := gitdomain.GitVersion{Major: 2, Minor: 38}
+		must.True(t, version.IsAtLeast(2, 30))
+		must.True(t, version.IsAtLeast(2, 38))
+		must.True(t, version.IsAtLeast(1, 40))
+		must.False(t, version.IsAtLeast(2, 39))
+		must.False(t, version.IsAtLeast(3, 0))
+	})
+
+	t.Run("SupportsMergeTree", func(t *testing.T) {
+		t.Parallel()
+		must.False(t, gitdomain.GitVersion{Major: 2, Minor: 37}.SupportsMergeTree())
+		must.True(t, gitdomain.GitVersion{Major: 2, Minor: 38}.SupportsMergeTree())
+		must.True(t, gitdomain.GitVersion{Major: 3, Minor: 0}.SupportsMergeTree())
+	})
+}
@@ -3,4 +3,5 @@ package gitdomain
 type Querier interface {
 	Query(executable string, args ...string) (string, error)
 	QueryTrim(executable string, args ...string) (string, error)
+	QueryWithInput(input string, executable string, args ...string) (string, error)
 }
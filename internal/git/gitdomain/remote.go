@@ -4,12 +4,7 @@ package gitdomain
 type Remote string
 
 func NewRemote(id string) Remote {
-	for _, remote := range AllRemotes {
-		if id == remote.String() {
-			return remote
-		}
-	}
-	return RemoteOther
+	return Remote(id)
 }
 
 // Implementation of the fmt.Stringer interface.
@@ -20,13 +15,5 @@ func (self Remote) String() string {
 const (
 	RemoteNone     = Remote("")
 	RemoteOrigin   = Remote("origin")
-	RemoteOther    = Remote("other")
 	RemoteUpstream = Remote("upstream")
 )
-
-var AllRemotes = []Remote{ //nolint:gochecknoglobals
-	RemoteNone,
-	RemoteOrigin,
-	RemoteOther,
-	RemoteUpstream,
-}
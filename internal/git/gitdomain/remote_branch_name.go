@@ -36,6 +36,11 @@ func (self RemoteBranchName) LocalBranchName() LocalBranchName {
 	return localBranch
 }
 
+// Location widens the type of this RemoteBranchName to a more generic Location.
+func (self RemoteBranchName) Location() Location {
+	return NewLocation(string(self))
+}
+
 func (self RemoteBranchName) Parts() (Remote, LocalBranchName) {
 	parts := strings.SplitN(string(self), "/", 2)
 	return NewRemote(parts[0]), NewLocalBranchName(parts[1])
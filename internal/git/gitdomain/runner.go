@@ -2,6 +2,7 @@ package gitdomain
 
 type Runner interface {
 	Run(executable string, args ...string) error
+	RunInDir(dir string, executable string, args ...string) error
 }
 
 type RunnerQuerier interface {
@@ -2,6 +2,8 @@ package gitdomain
 
 import (
 	"fmt"
+
+	. "github.com/git-town/git-town/v16/pkg/prelude"
 )
 
 // SHA represents a Git SHA as a dedicated data type.
@@ -17,6 +19,14 @@ func NewSHA(id string) SHA {
 	return SHA(id)
 }
 
+// NewSHAOption provides the SHA with the given value, or nothing if the given value isn't a valid SHA.
+func NewSHAOption(id string) Option[SHA] {
+	if validateSHA(id) {
+		return Some(NewSHA(id))
+	}
+	return None[SHA]()
+}
+
 // validateSHA indicates whether the given SHA content is a valid Git SHA.
 func validateSHA(content string) bool {
 	if len(content) < 6 {
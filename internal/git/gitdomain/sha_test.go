@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
 	"github.com/git-town/git-town/v16/test/asserts"
 	"github.com/shoenig/test/must"
 )
@@ -51,6 +52,22 @@ func TestSHA(t *testing.T) {
 		})
 	})
 
+	t.Run("NewSHAOption", func(t *testing.T) {
+		t.Parallel()
+		t.Run("valid SHA", func(t *testing.T) {
+			t.Parallel()
+			have := gitdomain.NewSHAOption("123456")
+			want := Some(gitdomain.NewSHA("123456"))
+			must.Eq(t, want, have)
+		})
+		t.Run("invalid SHA", func(t *testing.T) {
+			t.Parallel()
+			have := gitdomain.NewSHAOption("zonk")
+			want := None[gitdomain.SHA]()
+			must.Eq(t, want, have)
+		})
+	})
+
 	t.Run("TruncateTo", func(t *testing.T) {
 		t.Parallel()
 		t.Run("SHA is longer than the new length", func(t *testing.T) {
@@ -3,6 +3,7 @@ package giturl
 
 import (
 	"regexp"
+	"strings"
 
 	. "github.com/git-town/git-town/v16/pkg/prelude"
 )
@@ -11,6 +12,7 @@ import (
 type Parts struct {
 	User Option[string] // optional username
 	Host string         // hostname of the Git server
+	Port Option[string] // optional port of the Git server
 	Org  string         // name of the organization that the repo is in
 	Repo string         // name of the repository
 }
@@ -23,8 +25,8 @@ func Parse(url string) Option[Parts] {
 		`(?P<user>.*@)?` +
 		// capture "host:" or "host/"
 		`(?P<host>.*?[:/])` +
-		// ignore the port
-		`(?:\d+\/)?` +
+		// capture the port
+		`(?:(?P<port>\d+)\/)?` +
 		// capture "org/"
 		`(?P<org>.*\/)` +
 		// capture "repo"
@@ -39,14 +41,27 @@ func Parse(url string) Option[Parts] {
 		// Remotes on the filesystem are not an error condition.
 		return None[Parts]()
 	}
+	host := trimLast(matches[2])
 	return Some(Parts{
-		Host: trimLast(matches[2]),
-		Org:  trimLast(matches[3]),
-		Repo: matches[4],
+		Host: host,
+		Org:  normalizeOrg(host, trimLast(matches[4])),
+		Port: NewOption(matches[3]),
+		Repo: matches[5],
 		User: NewOption(trimLast(matches[1])),
 	})
 }
 
+// normalizeOrg removes path segments that some hosting platforms add between organization and repo name.
+// Azure DevOps uses URLs like "dev.azure.com/org/project/_git/repo"
+// and SSH URLs like "ssh.dev.azure.com:v3/org/project/repo".
+func normalizeOrg(host, org string) string {
+	org = strings.TrimSuffix(org, "/_git")
+	if host == "ssh.dev.azure.com" || host == "vs-ssh.visualstudio.com" {
+		org = strings.TrimPrefix(org, "v3/")
+	}
+	return org
+}
+
 // trimLast trims the last character of the given text.
 // Handles empty strings gracefully.
 func trimLast(text string) string {
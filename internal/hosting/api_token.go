@@ -0,0 +1,37 @@
+package hosting
+
+import (
+	"os"
+	"sync"
+
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// apiToken provides the token to use for the API of the hosting platform that the given connector args describe.
+// It uses the first of the given tokens that exists,
+// otherwise the Git credential helpers configured for the host of the remote.
+// Because running credential helpers is slow, it determines the token only when a connector calls the returned function
+// and reuses the result for subsequent calls.
+func apiToken[T ~string](args NewConnectorArgs, tokens ...Option[T]) func() Option[T] {
+	return sync.OnceValue(func() Option[T] {
+		for _, token := range tokens {
+			if token.IsSome() {
+				return token
+			}
+		}
+		if password, hasPassword := args.Git.CredentialPassword(args.Backend, args.RemoteURL.Host).Get(); hasPassword {
+			return Some(T(password))
+		}
+		return None[T]()
+	})
+}
+
+// envToken provides the token in the first of the given environment variables that is set.
+func envToken[T ~string](envVars ...string) Option[T] {
+	for _, envVar := range envVars {
+		if token := os.Getenv(envVar); token != "" {
+			return Some(T(token))
+		}
+	}
+	return None[T]()
+}
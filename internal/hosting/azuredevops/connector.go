@@ -0,0 +1,212 @@
+package azuredevops
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/cli/print"
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/git/giturl"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// the version of the Azure DevOps REST API that this connector talks to
+const apiVersion = "7.1"
+
+// Connector provides access to the API of Azure DevOps Repos.
+// The Organization contains the Azure DevOps organization and project, e.g. "org/project".
+type Connector struct {
+	hostingdomain.Data
+	APIToken func() Option[configdomain.AzureDevOpsToken]
+	apiURL   string
+	client   *http.Client
+	log      print.Logger
+}
+
+// NewConnector provides an Azure DevOps connector instance.
+func NewConnector(args NewConnectorArgs) Connector {
+	data := hostingdomain.Data{
+		Hostname:     webHostname(args.RemoteURL.Host),
+		Organization: args.RemoteURL.Org,
+		Repository:   args.RemoteURL.Repo,
+	}
+	return Connector{
+		APIToken: args.APIToken,
+		Data:     data,
+		apiURL:   args.APIURL.GetOrElse("https://" + data.HostnameWithStandardPort()),
+		client:   args.HTTPClient,
+		log:      args.Log,
+	}
+}
+
+type NewConnectorArgs struct {
+	APIToken   func() Option[configdomain.AzureDevOpsToken]
+	APIURL     Option[string] // base URL of the API, derived from the remote URL if not given
+	HTTPClient *http.Client
+	Log        print.Logger
+	RemoteURL  giturl.Parts
+}
+
+func (self Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
+	return fmt.Sprintf("Merged PR %d: %s", proposal.Number, proposal.Title)
+}
+
+func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
+	self.log.Start(messages.APIProposalLookupStart)
+	if proposalURLOverride := hostingdomain.ReadProposalOverride(); len(proposalURLOverride) > 0 {
+		self.log.Success()
+		return hostingdomain.OverriddenProposal(proposalURLOverride, target), nil
+	}
+	query := url.Values{}
+	query.Set("searchCriteria.sourceRefName", branchRef(branch))
+	query.Set("searchCriteria.targetRefName", branchRef(target))
+	query.Set("searchCriteria.status", "active")
+	var response pullRequestList
+	err := self.request(http.MethodGet, "", query, nil, &response)
+	if err != nil {
+		self.log.Failed(err)
+		return None[hostingdomain.Proposal](), err
+	}
+	self.log.Success()
+	switch len(response.Value) {
+	case 0:
+		return None[hostingdomain.Proposal](), nil
+	case 1:
+		return Some(self.parsePullRequest(response.Value[0])), nil
+	default:
+		return None[hostingdomain.Proposal](), fmt.Errorf(messages.ProposalMultipleFound, len(response.Value), branch, target)
+	}
+}
+
+func (self Connector) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchName, _ gitdomain.ProposalTitle, _ gitdomain.ProposalBody, _ hostingdomain.ProposalMetadata) (string, error) {
+	query := url.Values{}
+	query.Set("sourceRef", branch.String())
+	query.Set("targetRef", parentBranch.String())
+	return fmt.Sprintf("%s/pullrequestcreate?%s", self.RepositoryURL(), query.Encode()), nil
+}
+
+func (self Connector) RepositoryURL() string {
+	return fmt.Sprintf("https://%s/%s/_git/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
+}
+
+func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingAzureDevOpsMergingViaAPI, number)
+	// Azure DevOps completes a pull request only if we confirm its current source commit
+	var current pullRequest
+	err := self.request(http.MethodGet, fmt.Sprintf("/%d", number), url.Values{}, nil, &current)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	err = self.request(http.MethodPatch, fmt.Sprintf("/%d", number), url.Values{}, pullRequestCompletion{
+		CompletionOptions: completionOptions{
+			// the branch will be deleted by Git Town
+			DeleteSourceBranch: false,
+			MergeCommitMessage: message.String(),
+			MergeStrategy:      "squash",
+		},
+		LastMergeSourceCommit: current.LastMergeSourceCommit,
+		Status:                "completed",
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) UpdateProposalBody(number int, body gitdomain.ProposalBody) error {
+	self.log.Start(messages.APIProposalBodyUpdateStart, number)
+	if len(hostingdomain.ReadProposalOverride()) > 0 {
+		// test runs simulate proposals only via FindProposal
+		self.log.Success()
+		return nil
+	}
+	err := self.request(http.MethodPatch, fmt.Sprintf("/%d", number), url.Values{}, pullRequestDescription{
+		Description: body.String(),
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	self.log.Start(messages.HostingAzureDevOpsUpdatePRViaAPI, number, target)
+	err := self.request(http.MethodPatch, fmt.Sprintf("/%d", number), url.Values{}, pullRequestRetarget{
+		TargetRefName: branchRef(target),
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// parsePullRequest extracts standardized proposal data from the given Azure DevOps pull request.
+func (self Connector) parsePullRequest(pullRequest pullRequest) hostingdomain.Proposal {
+	return hostingdomain.Proposal{
+		Body:         gitdomain.ProposalBody(pullRequest.Description),
+		Checks:       hostingdomain.ProposalChecksUnknown,
+		Draft:        false,
+		MergeWithAPI: pullRequest.MergeStatus == "succeeded",
+		Mergeable:    None[bool](),
+		Number:       pullRequest.PullRequestID,
+		Review:       hostingdomain.ProposalReviewUnknown,
+		Target:       gitdomain.NewLocalBranchName(strings.TrimPrefix(pullRequest.TargetRefName, "refs/heads/")),
+		Title:        pullRequest.Title,
+		URL:          fmt.Sprintf("%s/pullrequest/%d", self.RepositoryURL(), pullRequest.PullRequestID),
+	}
+}
+
+// request sends a request with the given JSON payload to the pull requests endpoint of the Azure DevOps API
+// and decodes the JSON response into the given result.
+func (self Connector) request(method, path string, query url.Values, payload any, result any) error {
+	query.Set("api-version", apiVersion)
+	return hostingdomain.JSONRequest(hostingdomain.JSONRequestArgs{
+		Authorization: basicAuthorization(self.APIToken()),
+		Client:        self.client,
+		ErrorMessage:  messages.HostingAzureDevOpsAPIError,
+		Method:        method,
+		Payload:       payload,
+		Result:        result,
+		URL:           fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests%s?%s", self.apiURL, self.Organization, url.PathEscape(self.Repository), path, query.Encode()),
+	})
+}
+
+// basicAuthorization provides the content of the Authorization header for the given personal access token.
+// Azure DevOps expects personal access tokens as the password of basic auth with an empty username.
+func basicAuthorization(token Option[configdomain.AzureDevOpsToken]) Option[string] {
+	if value, hasToken := token.Get(); hasToken {
+		return Some("Basic " + base64.StdEncoding.EncodeToString([]byte(":"+value.String())))
+	}
+	return None[string]()
+}
+
+// branchRef provides the fully qualified Git ref of the given branch.
+func branchRef(branch gitdomain.LocalBranchName) string {
+	return "refs/heads/" + branch.String()
+}
+
+// webHostname provides the hostname of the Azure DevOps web UI and API for the given Git remote host.
+func webHostname(remoteHost string) string {
+	switch remoteHost {
+	case "ssh.dev.azure.com", "vs-ssh.visualstudio.com":
+		return "dev.azure.com"
+	}
+	return remoteHost
+}
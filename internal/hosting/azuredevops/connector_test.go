@@ -0,0 +1,100 @@
+package azuredevops_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/git-town/git-town/v16/internal/cli/print"
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/git/giturl"
+	"github.com/git-town/git-town/v16/internal/hosting/azuredevops"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestAzureDevOpsConnector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("DefaultProposalMessage", func(t *testing.T) {
+		t.Parallel()
+		give := hostingdomain.Proposal{
+			MergeWithAPI: true,
+			Number:       1,
+			Target:       "main",
+			Title:        "my title",
+			URL:          "",
+		}
+		have := azuredevops.Connector{}.DefaultProposalMessage(give)
+		want := "Merged PR 1: my title"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("NewConnector", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]hostingdomain.Data{
+			"https://dev.azure.com/org/project/_git/repo": {
+				Hostname:     "dev.azure.com",
+				Organization: "org/project",
+				Repository:   "repo",
+			},
+			"git@ssh.dev.azure.com:v3/org/project/repo": {
+				Hostname:     "dev.azure.com",
+				Organization: "org/project",
+				Repository:   "repo",
+			},
+			"https://org.visualstudio.com/project/_git/repo": {
+				Hostname:     "org.visualstudio.com",
+				Organization: "project",
+				Repository:   "repo",
+			},
+		}
+		for give, want := range tests {
+			url, has := giturl.Parse(give).Get()
+			must.True(t, has)
+			have := azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+				APIToken:   func() Option[configdomain.AzureDevOpsToken] { return None[configdomain.AzureDevOpsToken]() },
+				APIURL:     None[string](),
+				HTTPClient: http.DefaultClient,
+				Log:        print.Logger{},
+				RemoteURL:  url,
+			})
+			must.EqOp(t, want, have.Data)
+		}
+	})
+
+	t.Run("NewProposalURL", func(t *testing.T) {
+		t.Parallel()
+		url, has := giturl.Parse("git@ssh.dev.azure.com:v3/org/project/repo").Get()
+		must.True(t, has)
+		connector := azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+			APIToken:   func() Option[configdomain.AzureDevOpsToken] { return None[configdomain.AzureDevOpsToken]() },
+			APIURL:     None[string](),
+			HTTPClient: http.DefaultClient,
+			Log:        print.Logger{},
+			RemoteURL:  url,
+		})
+		main := gitdomain.NewLocalBranchName("main")
+		have, err := connector.NewProposalURL("feature", main, main, "", "", hostingdomain.ProposalMetadata{})
+		must.NoError(t, err)
+		want := "https://dev.azure.com/org/project/_git/repo/pullrequestcreate?sourceRef=feature&targetRef=main"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("RepositoryURL", func(t *testing.T) {
+		t.Parallel()
+		url, has := giturl.Parse("https://dev.azure.com/org/project/_git/repo").Get()
+		must.True(t, has)
+		connector := azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+			APIToken:   func() Option[configdomain.AzureDevOpsToken] { return None[configdomain.AzureDevOpsToken]() },
+			APIURL:     None[string](),
+			HTTPClient: http.DefaultClient,
+			Log:        print.Logger{},
+			RemoteURL:  url,
+		})
+		have := connector.RepositoryURL()
+		want := "https://dev.azure.com/org/project/_git/repo"
+		must.EqOp(t, want, have)
+	})
+}
@@ -0,0 +1,46 @@
+package azuredevops
+
+// data structures exchanged with the Azure DevOps REST API
+
+type commit struct {
+	CommitID string `json:"commitId"`
+}
+
+// the options for completing a pull request
+type completionOptions struct {
+	DeleteSourceBranch bool   `json:"deleteSourceBranch"`
+	MergeCommitMessage string `json:"mergeCommitMessage"`
+	MergeStrategy      string `json:"mergeStrategy"`
+}
+
+// the payload to complete a pull request
+type pullRequestCompletion struct {
+	CompletionOptions     completionOptions `json:"completionOptions"`
+	LastMergeSourceCommit commit            `json:"lastMergeSourceCommit"`
+	Status                string            `json:"status"`
+}
+
+// a pull request as returned by the Azure DevOps API
+type pullRequest struct {
+	Description           string `json:"description"`
+	LastMergeSourceCommit commit `json:"lastMergeSourceCommit"`
+	MergeStatus           string `json:"mergeStatus"`
+	PullRequestID         int    `json:"pullRequestId"`
+	TargetRefName         string `json:"targetRefName"`
+	Title                 string `json:"title"`
+}
+
+// a list of pull requests as returned by the Azure DevOps API
+type pullRequestList struct {
+	Value []pullRequest `json:"value"`
+}
+
+// the payload to change the description of a pull request
+type pullRequestDescription struct {
+	Description string `json:"description"`
+}
+
+// the payload to change the target branch of a pull request
+type pullRequestRetarget struct {
+	TargetRefName string `json:"targetRefName"`
+}
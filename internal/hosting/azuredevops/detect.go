@@ -0,0 +1,12 @@
+package azuredevops
+
+import (
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/git/giturl"
+)
+
+// Detect indicates whether the current repository is hosted on Azure DevOps.
+func Detect(remoteURL giturl.Parts) bool {
+	return remoteURL.Host == "dev.azure.com" || remoteURL.Host == "ssh.dev.azure.com" || strings.HasSuffix(remoteURL.Host, ".visualstudio.com")
+}
@@ -0,0 +1,27 @@
+package azuredevops_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v16/internal/git/giturl"
+	"github.com/git-town/git-town/v16/internal/hosting/azuredevops"
+	"github.com/shoenig/test/must"
+)
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+	tests := map[string]bool{
+		"https://dev.azure.com/org/project/_git/repo":     true,  // HTTPS URL
+		"git@ssh.dev.azure.com:v3/org/project/repo":       true,  // SSH URL
+		"https://org.visualstudio.com/project/_git/repo":  true,  // legacy HTTPS URL
+		"org@vs-ssh.visualstudio.com:v3/org/project/repo": true,  // legacy SSH URL
+		"git@custom-url.com:git-town/docs.git":            false, // custom URL
+		"git@github.com:git-town/git-town.git":            false, // other hosting service URL
+	}
+	for give, want := range tests {
+		url, has := giturl.Parse(give).Get()
+		must.True(t, has)
+		have := azuredevops.Detect(url)
+		must.EqOp(t, want, have)
+	}
+}
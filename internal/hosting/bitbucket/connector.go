@@ -3,8 +3,11 @@ package bitbucket
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
+	"strings"
 
+	"github.com/git-town/git-town/v16/internal/cli/print"
 	"github.com/git-town/git-town/v16/internal/config/configdomain"
 	"github.com/git-town/git-town/v16/internal/git/gitdomain"
 	"github.com/git-town/git-town/v16/internal/git/giturl"
@@ -13,25 +16,40 @@ import (
 	. "github.com/git-town/git-town/v16/pkg/prelude"
 )
 
+// the default base URL of the Bitbucket Cloud REST API
+const defaultAPIURL = "https://api.bitbucket.org/2.0"
+
 // Connector provides access to the API of Bitbucket installations.
 type Connector struct {
 	hostingdomain.Data
+	APIToken func() Option[configdomain.BitbucketToken]
+	apiURL   string
+	client   *http.Client
+	log      print.Logger
 }
 
 // NewConnector provides a Bitbucket connector instance if the current repo is hosted on Bitbucket,
 // otherwise nil.
 func NewConnector(args NewConnectorArgs) Connector {
 	return Connector{
+		APIToken: args.APIToken,
 		Data: hostingdomain.Data{
 			Hostname:     args.RemoteURL.Host,
 			Organization: args.RemoteURL.Org,
 			Repository:   args.RemoteURL.Repo,
 		},
+		apiURL: args.APIURL.GetOrElse(defaultAPIURL),
+		client: args.HTTPClient,
+		log:    args.Log,
 	}
 }
 
 type NewConnectorArgs struct {
+	APIToken        func() Option[configdomain.BitbucketToken]
+	APIURL          Option[string] // base URL of the API, Bitbucket Cloud if not given
+	HTTPClient      *http.Client
 	HostingPlatform Option[configdomain.HostingPlatform]
+	Log             print.Logger
 	RemoteURL       giturl.Parts
 }
 
@@ -39,11 +57,33 @@ func (self Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) st
 	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
 }
 
-func (self Connector) FindProposal(_, _ gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
-	return None[hostingdomain.Proposal](), errors.New(messages.HostingBitBucketNotImplemented)
+func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
+	self.log.Start(messages.APIProposalLookupStart)
+	if proposalURLOverride := hostingdomain.ReadProposalOverride(); len(proposalURLOverride) > 0 {
+		self.log.Success()
+		return hostingdomain.OverriddenProposal(proposalURLOverride, target), nil
+	}
+	query := url.Values{}
+	query.Set("state", "OPEN")
+	query.Set("q", fmt.Sprintf("source.branch.name=%s AND destination.branch.name=%s", bbqlString(branch.String()), bbqlString(target.String())))
+	var response pullRequestList
+	err := self.request(http.MethodGet, self.pullRequestsPath()+"?"+query.Encode(), nil, &response)
+	if err != nil {
+		self.log.Failed(err)
+		return None[hostingdomain.Proposal](), err
+	}
+	self.log.Success()
+	switch len(response.Values) {
+	case 0:
+		return None[hostingdomain.Proposal](), nil
+	case 1:
+		return Some(parsePullRequest(response.Values[0])), nil
+	default:
+		return None[hostingdomain.Proposal](), fmt.Errorf(messages.ProposalMultipleFound, len(response.Values), branch, target)
+	}
 }
 
-func (self Connector) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchName, _ gitdomain.ProposalTitle, _ gitdomain.ProposalBody) (string, error) {
+func (self Connector) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchName, _ gitdomain.ProposalTitle, _ gitdomain.ProposalBody, _ hostingdomain.ProposalMetadata) (string, error) {
 	return fmt.Sprintf("%s/pull-requests/new?source=%s&dest=%s%%2F%s%%3A%s",
 			self.RepositoryURL(),
 			url.QueryEscape(branch.String()),
@@ -57,10 +97,95 @@ func (self Connector) RepositoryURL() string {
 	return fmt.Sprintf("https://%s/%s/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
 }
 
-func (self Connector) SquashMergeProposal(_ int, _ gitdomain.CommitMessage) error {
-	return errors.New(messages.HostingBitBucketNotImplemented)
+func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingBitbucketMergingViaAPI, number)
+	err := self.request(http.MethodPost, fmt.Sprintf("%s/%d/merge", self.pullRequestsPath(), number), mergeParameters{
+		// the branch will be deleted by Git Town
+		CloseSourceBranch: false,
+		MergeStrategy:     "squash",
+		Message:           message.String(),
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
 }
 
-func (self Connector) UpdateProposalTarget(_ int, _ gitdomain.LocalBranchName) error {
-	return errors.New(messages.HostingBitBucketNotImplemented)
+func (self Connector) UpdateProposalBody(number int, body gitdomain.ProposalBody) error {
+	self.log.Start(messages.APIProposalBodyUpdateStart, number)
+	if len(hostingdomain.ReadProposalOverride()) > 0 {
+		// test runs simulate proposals only via FindProposal
+		self.log.Success()
+		return nil
+	}
+	err := self.request(http.MethodPut, fmt.Sprintf("%s/%d", self.pullRequestsPath(), number), pullRequestDescription{
+		Description: body.String(),
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	self.log.Start(messages.HostingBitbucketUpdatePRViaAPI, number, target)
+	err := self.request(http.MethodPut, fmt.Sprintf("%s/%d", self.pullRequestsPath(), number), pullRequestUpdate{
+		Destination: pullRequestEndpoint{
+			Branch: pullRequestBranch{Name: target.String()},
+		},
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// provides the API path of the pull requests of the current repository
+func (self Connector) pullRequestsPath() string {
+	return fmt.Sprintf("/repositories/%s/%s/pullrequests", url.PathEscape(self.Organization), url.PathEscape(self.Repository))
+}
+
+// request sends a request with the given JSON payload to the Bitbucket API
+// and decodes the JSON response into the given result.
+func (self Connector) request(method, path string, payload any, result any) error {
+	return hostingdomain.JSONRequest(hostingdomain.JSONRequestArgs{
+		Authorization: hostingdomain.BearerAuthorization(self.APIToken()),
+		Client:        self.client,
+		ErrorMessage:  messages.HostingBitbucketAPIError,
+		Method:        method,
+		Payload:       payload,
+		Result:        result,
+		URL:           self.apiURL + path,
+	})
+}
+
+// bbqlString provides the given value as a string literal of the Bitbucket query language.
+// BBQL strings are enclosed in double quotes and escape only double quotes and backslashes.
+func bbqlString(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// parsePullRequest extracts standardized proposal data from the given Bitbucket pull request.
+func parsePullRequest(pullRequest pullRequest) hostingdomain.Proposal {
+	return hostingdomain.Proposal{
+		Body:         gitdomain.ProposalBody(pullRequest.Description),
+		Checks:       hostingdomain.ProposalChecksUnknown,
+		Draft:        false,
+		MergeWithAPI: true,
+		Mergeable:    None[bool](),
+		Number:       pullRequest.ID,
+		Review:       hostingdomain.ProposalReviewUnknown,
+		Target:       gitdomain.NewLocalBranchName(pullRequest.Destination.Branch.Name),
+		Title:        pullRequest.Title,
+		URL:          pullRequest.Links.HTML.Href,
+	}
 }
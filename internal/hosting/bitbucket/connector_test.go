@@ -1,8 +1,13 @@
 package bitbucket_test
 
 import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
+	"github.com/git-town/git-town/v16/internal/cli/print"
 	"github.com/git-town/git-town/v16/internal/config/configdomain"
 	"github.com/git-town/git-town/v16/internal/git/gitdomain"
 	"github.com/git-town/git-town/v16/internal/git/giturl"
@@ -23,7 +28,11 @@ func TestBitbucketConnector(t *testing.T) {
 			url, has := giturl.Parse("username@bitbucket.org:git-town/docs.git").Get()
 			must.True(t, has)
 			have := bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+				APIToken:        func() Option[configdomain.BitbucketToken] { return None[configdomain.BitbucketToken]() },
+				APIURL:          None[string](),
+				HTTPClient:      http.DefaultClient,
 				HostingPlatform: None[configdomain.HostingPlatform](),
+				Log:             print.Logger{},
 				RemoteURL:       url,
 			})
 			wantConfig := hostingdomain.Data{
@@ -39,7 +48,11 @@ func TestBitbucketConnector(t *testing.T) {
 			url, has := giturl.Parse("git@custom-url.com:git-town/docs.git").Get()
 			must.True(t, has)
 			have := bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+				APIToken:        func() Option[configdomain.BitbucketToken] { return None[configdomain.BitbucketToken]() },
+				APIURL:          None[string](),
+				HTTPClient:      http.DefaultClient,
 				HostingPlatform: Some(configdomain.HostingPlatformBitbucket),
+				Log:             print.Logger{},
 				RemoteURL:       url,
 			})
 			wantConfig := hostingdomain.Data{
@@ -56,13 +69,168 @@ func TestBitbucketConnector(t *testing.T) {
 		url, has := giturl.Parse("username@bitbucket.org:org/repo.git").Get()
 		must.True(t, has)
 		connector := bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+			APIToken:        func() Option[configdomain.BitbucketToken] { return None[configdomain.BitbucketToken]() },
+			APIURL:          None[string](),
+			HTTPClient:      http.DefaultClient,
 			HostingPlatform: None[configdomain.HostingPlatform](),
+			Log:             print.Logger{},
 			RemoteURL:       url,
 		})
 		main := gitdomain.NewLocalBranchName("main")
-		have, err := connector.NewProposalURL("branch", gitdomain.NewLocalBranchName("parent-branch"), main, "", "")
+		have, err := connector.NewProposalURL("branch", gitdomain.NewLocalBranchName("parent-branch"), main, "", "", hostingdomain.ProposalMetadata{})
 		must.NoError(t, err)
 		want := "https://bitbucket.org/org/repo/pull-requests/new?source=branch&dest=org%2Frepo%3Aparent-branch"
 		must.EqOp(t, want, have)
 	})
+	t.Run("FindProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("proposal exists", func(t *testing.T) {
+			t.Parallel()
+			connector, requests := newTestConnector(t, http.StatusOK, `{"values": [{"id": 12, "title": "my title", "description": "my body", "destination": {"branch": {"name": "main"}}, "links": {"html": {"href": "https://bitbucket.org/org/repo/pull-requests/12"}}}]}`)
+			have, err := connector.FindProposal("feature", "main")
+			must.NoError(t, err)
+			want := hostingdomain.Proposal{
+				Body:         "my body",
+				Checks:       hostingdomain.ProposalChecksUnknown,
+				Draft:        false,
+				MergeWithAPI: true,
+				Mergeable:    None[bool](),
+				Number:       12,
+				Review:       hostingdomain.ProposalReviewUnknown,
+				Target:       "main",
+				Title:        "my title",
+				URL:          "https://bitbucket.org/org/repo/pull-requests/12",
+			}
+			must.Eq(t, Some(want), have)
+			must.Eq(t, []recordedRequest{
+				{
+					Body:   "",
+					Method: http.MethodGet,
+					Path:   "/repositories/org/repo/pullrequests",
+					Query:  `q=source.branch.name="feature" AND destination.branch.name="main"&state=OPEN`,
+				},
+			}, *requests)
+		})
+
+		t.Run("branch name contains characters that BBQL escapes", func(t *testing.T) {
+			t.Parallel()
+			connector, requests := newTestConnector(t, http.StatusOK, `{"values": []}`)
+			_, err := connector.FindProposal(`say-"hi"-ä`, "main")
+			must.NoError(t, err)
+			must.Len(t, 1, *requests)
+			must.EqOp(t, `q=source.branch.name="say-\"hi\"-ä" AND destination.branch.name="main"&state=OPEN`, (*requests)[0].Query)
+		})
+
+		t.Run("no proposal", func(t *testing.T) {
+			t.Parallel()
+			connector, _ := newTestConnector(t, http.StatusOK, `{"values": []}`)
+			have, err := connector.FindProposal("feature", "main")
+			must.NoError(t, err)
+			must.True(t, have.IsNone())
+		})
+
+		t.Run("multiple proposals", func(t *testing.T) {
+			t.Parallel()
+			connector, _ := newTestConnector(t, http.StatusOK, `{"values": [{"id": 1}, {"id": 2}]}`)
+			_, err := connector.FindProposal("feature", "main")
+			must.Error(t, err)
+		})
+
+		t.Run("API error", func(t *testing.T) {
+			t.Parallel()
+			connector, _ := newTestConnector(t, http.StatusUnauthorized, "invalid token")
+			_, err := connector.FindProposal("feature", "main")
+			must.ErrorContains(t, err, "returned status 401: invalid token")
+		})
+	})
+
+	t.Run("SquashMergeProposal", func(t *testing.T) {
+		t.Parallel()
+		connector, requests := newTestConnector(t, http.StatusOK, "{}")
+		err := connector.SquashMergeProposal(12, "title\n\nbody")
+		must.NoError(t, err)
+		must.Eq(t, []recordedRequest{
+			{
+				Body:   `{"close_source_branch":false,"merge_strategy":"squash","message":"title\n\nbody"}`,
+				Method: http.MethodPost,
+				Path:   "/repositories/org/repo/pullrequests/12/merge",
+				Query:  "",
+			},
+		}, *requests)
+	})
+
+	t.Run("UpdateProposalBody", func(t *testing.T) {
+		t.Parallel()
+		connector, requests := newTestConnector(t, http.StatusOK, "{}")
+		err := connector.UpdateProposalBody(12, "new body")
+		must.NoError(t, err)
+		must.Eq(t, []recordedRequest{
+			{
+				Body:   `{"description":"new body"}`,
+				Method: http.MethodPut,
+				Path:   "/repositories/org/repo/pullrequests/12",
+				Query:  "",
+			},
+		}, *requests)
+	})
+
+	t.Run("UpdateProposalTarget", func(t *testing.T) {
+		t.Parallel()
+		connector, requests := newTestConnector(t, http.StatusOK, "{}")
+		err := connector.UpdateProposalTarget(12, "develop")
+		must.NoError(t, err)
+		must.Eq(t, []recordedRequest{
+			{
+				Body:   `{"destination":{"branch":{"name":"develop"}}}`,
+				Method: http.MethodPut,
+				Path:   "/repositories/org/repo/pullrequests/12",
+				Query:  "",
+			},
+		}, *requests)
+	})
+}
+
+// a request that the fake Bitbucket API received
+type recordedRequest struct {
+	Body   string
+	Method string
+	Path   string
+	Query  string
+}
+
+// provides a connector that talks to a fake Bitbucket API,
+// which answers all requests with the given status and response body
+// and records the requests it receives
+func newTestConnector(t *testing.T, status int, response string) (bitbucket.Connector, *[]recordedRequest) {
+	t.Helper()
+	requests := []recordedRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		query, _ := url.QueryUnescape(r.URL.RawQuery)
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		requests = append(requests, recordedRequest{
+			Body:   string(body),
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Query:  query,
+		})
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, response)
+	}))
+	t.Cleanup(server.Close)
+	remoteURL, has := giturl.Parse("git@bitbucket.org:org/repo.git").Get()
+	must.True(t, has)
+	connector := bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+		APIToken:        func() Option[configdomain.BitbucketToken] { return configdomain.ParseBitbucketToken("token") },
+		APIURL:          Some(server.URL),
+		HTTPClient:      server.Client(),
+		HostingPlatform: None[configdomain.HostingPlatform](),
+		Log:             print.Logger{},
+		RemoteURL:       remoteURL,
+	})
+	return connector, &requests
 }
@@ -0,0 +1,51 @@
+package bitbucket
+
+// data structures exchanged with the Bitbucket Cloud REST API
+
+// the parameters for merging a pull request
+type mergeParameters struct {
+	CloseSourceBranch bool   `json:"close_source_branch"`
+	MergeStrategy     string `json:"merge_strategy"`
+	Message           string `json:"message"`
+}
+
+// a pull request as returned by the Bitbucket API
+type pullRequest struct {
+	Description string              `json:"description"`
+	Destination pullRequestEndpoint `json:"destination"`
+	ID          int                 `json:"id"`
+	Links       pullRequestLinks    `json:"links"`
+	Title       string              `json:"title"`
+}
+
+type pullRequestBranch struct {
+	Name string `json:"name"`
+}
+
+// the source or destination of a pull request
+type pullRequestEndpoint struct {
+	Branch pullRequestBranch `json:"branch"`
+}
+
+// the payload to change the description of a pull request
+type pullRequestDescription struct {
+	Description string `json:"description"`
+}
+
+type pullRequestLink struct {
+	Href string `json:"href"`
+}
+
+type pullRequestLinks struct {
+	HTML pullRequestLink `json:"html"`
+}
+
+// a page of pull requests as returned by the Bitbucket API
+type pullRequestList struct {
+	Values []pullRequest `json:"values"`
+}
+
+// the payload to change the destination branch of a pull request
+type pullRequestUpdate struct {
+	Destination pullRequestEndpoint `json:"destination"`
+}
@@ -0,0 +1,245 @@
+package bitbucketdatacenter
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/cli/print"
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/git/giturl"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// Connector provides access to the API of self-hosted Bitbucket Data Center and Bitbucket Server installations.
+type Connector struct {
+	hostingdomain.Data
+	APIToken func() Option[configdomain.BitbucketToken]
+	apiURL   string
+	client   *http.Client
+	log      print.Logger
+	webHost  string // host and port of the web interface
+}
+
+// NewConnector provides a Bitbucket Data Center connector instance.
+func NewConnector(args NewConnectorArgs) Connector {
+	data := hostingdomain.Data{
+		Hostname:     args.RemoteURL.Host,
+		Organization: projectKey(args.RemoteURL.Org),
+		Repository:   args.RemoteURL.Repo,
+	}
+	webHost := webHost(args.RemoteURL)
+	return Connector{
+		APIToken: args.APIToken,
+		Data:     data,
+		apiURL:   args.APIURL.GetOrElse("https://" + webHost),
+		client:   args.HTTPClient,
+		log:      args.Log,
+		webHost:  webHost,
+	}
+}
+
+type NewConnectorArgs struct {
+	APIToken   func() Option[configdomain.BitbucketToken]
+	APIURL     Option[string] // base URL of the API, derived from the remote URL if not given
+	HTTPClient *http.Client
+	Log        print.Logger
+	RemoteURL  giturl.Parts
+}
+
+func (self Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
+	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
+}
+
+func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
+	self.log.Start(messages.APIProposalLookupStart)
+	if proposalURLOverride := hostingdomain.ReadProposalOverride(); len(proposalURLOverride) > 0 {
+		self.log.Success()
+		return hostingdomain.OverriddenProposal(proposalURLOverride, target), nil
+	}
+	query := url.Values{}
+	query.Set("state", "OPEN")
+	query.Set("direction", "OUTGOING")
+	query.Set("at", branchRef(branch))
+	var response pullRequestList
+	err := self.request(http.MethodGet, self.pullRequestsPath()+"?"+query.Encode(), nil, &response)
+	if err != nil {
+		self.log.Failed(err)
+		return None[hostingdomain.Proposal](), err
+	}
+	self.log.Success()
+	pullRequests := filterPullRequests(response.Values, target)
+	switch len(pullRequests) {
+	case 0:
+		return None[hostingdomain.Proposal](), nil
+	case 1:
+		return Some(parsePullRequest(pullRequests[0])), nil
+	default:
+		return None[hostingdomain.Proposal](), fmt.Errorf(messages.ProposalMultipleFound, len(pullRequests), branch, target)
+	}
+}
+
+func (self Connector) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchName, _ gitdomain.ProposalTitle, _ gitdomain.ProposalBody, _ hostingdomain.ProposalMetadata) (string, error) {
+	query := url.Values{}
+	query.Set("sourceBranch", branchRef(branch))
+	query.Set("targetBranch", branchRef(parentBranch))
+	return fmt.Sprintf("%s/pull-requests?create&%s", self.RepositoryURL(), query.Encode()), nil
+}
+
+func (self Connector) RepositoryURL() string {
+	return fmt.Sprintf("https://%s/projects/%s/repos/%s", self.webHost, self.Organization, self.Repository)
+}
+
+func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingBitbucketMergingViaAPI, number)
+	pullRequest, err := self.loadPullRequest(number)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	path := fmt.Sprintf("%s/%d/merge?version=%d", self.pullRequestsPath(), number, pullRequest.Version)
+	err = self.request(http.MethodPost, path, mergeParameters{
+		Message:    message.String(),
+		StrategyID: "squash",
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) UpdateProposalBody(number int, body gitdomain.ProposalBody) error {
+	self.log.Start(messages.APIProposalBodyUpdateStart, number)
+	if len(hostingdomain.ReadProposalOverride()) > 0 {
+		// test runs simulate proposals only via FindProposal
+		self.log.Success()
+		return nil
+	}
+	pullRequest, err := self.loadPullRequest(number)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	err = self.request(http.MethodPut, fmt.Sprintf("%s/%d", self.pullRequestsPath(), number), pullRequestUpdate{
+		Description: body.String(),
+		Title:       pullRequest.Title,
+		ToRef:       pullRequest.ToRef,
+		Version:     pullRequest.Version,
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	self.log.Start(messages.HostingBitbucketUpdatePRViaAPI, number, target)
+	pullRequest, err := self.loadPullRequest(number)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	err = self.request(http.MethodPut, fmt.Sprintf("%s/%d", self.pullRequestsPath(), number), pullRequestUpdate{
+		Description: pullRequest.Description,
+		Title:       pullRequest.Title,
+		ToRef:       ref{DisplayID: target.String(), ID: branchRef(target)},
+		Version:     pullRequest.Version,
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// loadPullRequest provides the current state of the pull request with the given number.
+// Bitbucket Data Center requires the current version of a pull request to modify it.
+func (self Connector) loadPullRequest(number int) (pullRequest, error) {
+	var result pullRequest
+	err := self.request(http.MethodGet, fmt.Sprintf("%s/%d", self.pullRequestsPath(), number), nil, &result)
+	return result, err
+}
+
+// provides the API path of the pull requests of the current repository
+func (self Connector) pullRequestsPath() string {
+	return fmt.Sprintf("/rest/api/1.0/projects/%s/repos/%s/pull-requests", url.PathEscape(self.Organization), url.PathEscape(self.Repository))
+}
+
+// request sends a request with the given JSON payload to the Bitbucket Data Center API
+// and decodes the JSON response into the given result.
+func (self Connector) request(method, path string, payload any, result any) error {
+	return hostingdomain.JSONRequest(hostingdomain.JSONRequestArgs{
+		Authorization: hostingdomain.BearerAuthorization(self.APIToken()),
+		Client:        self.client,
+		ErrorMessage:  messages.HostingBitbucketAPIError,
+		Method:        method,
+		Payload:       payload,
+		Result:        result,
+		URL:           self.apiURL + path,
+	})
+}
+
+// branchRef provides the fully qualified Git ref of the given branch.
+func branchRef(branch gitdomain.LocalBranchName) string {
+	return "refs/heads/" + branch.String()
+}
+
+// filterPullRequests provides the pull requests that target the given branch.
+func filterPullRequests(pullRequests []pullRequest, target gitdomain.LocalBranchName) []pullRequest {
+	result := []pullRequest(nil)
+	for _, pullRequest := range pullRequests {
+		if pullRequest.ToRef.DisplayID == target.String() {
+			result = append(result, pullRequest)
+		}
+	}
+	return result
+}
+
+// parsePullRequest extracts standardized proposal data from the given Bitbucket Data Center pull request.
+func parsePullRequest(pullRequest pullRequest) hostingdomain.Proposal {
+	proposalURL := ""
+	if len(pullRequest.Links.Self) > 0 {
+		proposalURL = pullRequest.Links.Self[0].Href
+	}
+	return hostingdomain.Proposal{
+		Body:         gitdomain.ProposalBody(pullRequest.Description),
+		Checks:       hostingdomain.ProposalChecksUnknown,
+		Draft:        false,
+		MergeWithAPI: true,
+		Mergeable:    None[bool](),
+		Number:       pullRequest.ID,
+		Review:       hostingdomain.ProposalReviewUnknown,
+		Target:       gitdomain.NewLocalBranchName(pullRequest.ToRef.DisplayID),
+		Title:        pullRequest.Title,
+		URL:          proposalURL,
+	}
+}
+
+// projectKey provides the project key contained in the given organization part of a remote URL.
+// HTTPS remotes of Bitbucket Data Center contain the project key prefixed with "scm/".
+func projectKey(organization string) string {
+	return strings.TrimPrefix(organization, "scm/")
+}
+
+// webHost provides the host and port of the web interface of the Bitbucket Data Center installation that hosts the given remote.
+// HTTPS remotes contain the project key prefixed with "scm/" and use the port of the web interface.
+// SSH remotes use a dedicated port that the web interface doesn't listen on.
+func webHost(remoteURL giturl.Parts) string {
+	if port, hasPort := remoteURL.Port.Get(); hasPort && strings.HasPrefix(remoteURL.Org, "scm/") {
+		return remoteURL.Host + ":" + port
+	}
+	return remoteURL.Host
+}
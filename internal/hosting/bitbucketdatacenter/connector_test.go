@@ -0,0 +1,101 @@
+package bitbucketdatacenter_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/git-town/git-town/v16/internal/cli/print"
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/git/giturl"
+	"github.com/git-town/git-town/v16/internal/hosting/bitbucketdatacenter"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestBitbucketDatacenterConnector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NewConnector", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("SSH remote", func(t *testing.T) {
+			t.Parallel()
+			url, has := giturl.Parse("ssh://git@bitbucket.example.com:7999/proj/repo.git").Get()
+			must.True(t, has)
+			have := bitbucketdatacenter.NewConnector(bitbucketdatacenter.NewConnectorArgs{
+				APIToken:   func() Option[configdomain.BitbucketToken] { return None[configdomain.BitbucketToken]() },
+				APIURL:     None[string](),
+				HTTPClient: http.DefaultClient,
+				Log:        print.Logger{},
+				RemoteURL:  url,
+			})
+			wantConfig := hostingdomain.Data{
+				Hostname:     "bitbucket.example.com",
+				Organization: "proj",
+				Repository:   "repo",
+			}
+			must.EqOp(t, wantConfig, have.Data)
+		})
+
+		t.Run("HTTPS remote", func(t *testing.T) {
+			t.Parallel()
+			url, has := giturl.Parse("https://bitbucket.example.com/scm/proj/repo.git").Get()
+			must.True(t, has)
+			have := bitbucketdatacenter.NewConnector(bitbucketdatacenter.NewConnectorArgs{
+				APIToken:   func() Option[configdomain.BitbucketToken] { return None[configdomain.BitbucketToken]() },
+				APIURL:     None[string](),
+				HTTPClient: http.DefaultClient,
+				Log:        print.Logger{},
+				RemoteURL:  url,
+			})
+			wantConfig := hostingdomain.Data{
+				Hostname:     "bitbucket.example.com",
+				Organization: "proj",
+				Repository:   "repo",
+			}
+			must.EqOp(t, wantConfig, have.Data)
+		})
+	})
+
+	t.Run("NewProposalURL", func(t *testing.T) {
+		t.Parallel()
+		url, has := giturl.Parse("ssh://git@bitbucket.example.com:7999/proj/repo.git").Get()
+		must.True(t, has)
+		connector := bitbucketdatacenter.NewConnector(bitbucketdatacenter.NewConnectorArgs{
+			APIToken:   func() Option[configdomain.BitbucketToken] { return None[configdomain.BitbucketToken]() },
+			APIURL:     None[string](),
+			HTTPClient: http.DefaultClient,
+			Log:        print.Logger{},
+			RemoteURL:  url,
+		})
+		main := gitdomain.NewLocalBranchName("main")
+		have, err := connector.NewProposalURL("branch", gitdomain.NewLocalBranchName("parent-branch"), main, "", "", hostingdomain.ProposalMetadata{})
+		must.NoError(t, err)
+		want := "https://bitbucket.example.com/projects/proj/repos/repo/pull-requests?create&sourceBranch=refs%2Fheads%2Fbranch&targetBranch=refs%2Fheads%2Fparent-branch"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("RepositoryURL", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]string{
+			"ssh://git@bitbucket.example.com:7999/proj/repo.git":   "https://bitbucket.example.com/projects/proj/repos/repo",
+			"https://bitbucket.example.com/scm/proj/repo.git":      "https://bitbucket.example.com/projects/proj/repos/repo",
+			"https://bitbucket.example.com:8443/scm/proj/repo.git": "https://bitbucket.example.com:8443/projects/proj/repos/repo",
+		}
+		for give, want := range tests {
+			url, has := giturl.Parse(give).Get()
+			must.True(t, has)
+			connector := bitbucketdatacenter.NewConnector(bitbucketdatacenter.NewConnectorArgs{
+				APIToken:   func() Option[configdomain.BitbucketToken] { return None[configdomain.BitbucketToken]() },
+				APIURL:     None[string](),
+				HTTPClient: http.DefaultClient,
+				Log:        print.Logger{},
+				RemoteURL:  url,
+			})
+			have := connector.RepositoryURL()
+			must.EqOp(t, want, have)
+		}
+	})
+}
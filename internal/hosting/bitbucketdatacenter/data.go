@@ -0,0 +1,46 @@
+package bitbucketdatacenter
+
+// data structures exchanged with the Bitbucket Data Center REST API
+
+type link struct {
+	Href string `json:"href"`
+}
+
+// the parameters for merging a pull request
+type mergeParameters struct {
+	Message    string `json:"message"`
+	StrategyID string `json:"strategyId"`
+}
+
+// a pull request as returned by the Bitbucket Data Center API
+type pullRequest struct {
+	Description string           `json:"description"`
+	ID          int              `json:"id"`
+	Links       pullRequestLinks `json:"links"`
+	Title       string           `json:"title"`
+	ToRef       ref              `json:"toRef"`
+	Version     int              `json:"version"`
+}
+
+type pullRequestLinks struct {
+	Self []link `json:"self"`
+}
+
+// a page of pull requests as returned by the Bitbucket Data Center API
+type pullRequestList struct {
+	Values []pullRequest `json:"values"`
+}
+
+// the payload to change a pull request
+type pullRequestUpdate struct {
+	Description string `json:"description"`
+	Title       string `json:"title"`
+	ToRef       ref    `json:"toRef"`
+	Version     int    `json:"version"`
+}
+
+// a Git ref that a pull request originates from or targets
+type ref struct {
+	DisplayID string `json:"displayId,omitempty"`
+	ID        string `json:"id"`
+}
@@ -0,0 +1,18 @@
+package bitbucketdatacenter
+
+import (
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/git/giturl"
+)
+
+// the port on which Bitbucket Data Center serves SSH by default
+const sshPort = "7999"
+
+// Detect indicates whether the current repository is hosted on a Bitbucket Data Center server.
+func Detect(remoteURL giturl.Parts) bool {
+	if port, hasPort := remoteURL.Port.Get(); hasPort && port == sshPort {
+		return true
+	}
+	return strings.HasPrefix(remoteURL.Org, "scm/")
+}
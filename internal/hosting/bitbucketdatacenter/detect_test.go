@@ -0,0 +1,26 @@
+package bitbucketdatacenter_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v16/internal/git/giturl"
+	"github.com/git-town/git-town/v16/internal/hosting/bitbucketdatacenter"
+	"github.com/shoenig/test/must"
+)
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+	tests := map[string]bool{
+		"ssh://git@bitbucket.example.com:7999/proj/repo.git": true,  // SSH URL on the default port
+		"https://bitbucket.example.com/scm/proj/repo.git":    true,  // HTTPS URL
+		"ssh://git@git.example.com:4022/proj/repo.git":       false, // SSH URL on another port
+		"username@bitbucket.org:git-town/docs.git":           false, // Bitbucket Cloud URL
+		"git@github.com:git-town/git-town.git":               false, // other hosting service URL
+	}
+	for give, want := range tests {
+		url, has := giturl.Parse(give).Get()
+		must.True(t, has)
+		have := bitbucketdatacenter.Detect(url)
+		must.EqOp(t, want, have)
+	}
+}
@@ -3,7 +3,9 @@ package hosting
 import (
 	"github.com/git-town/git-town/v16/internal/config/configdomain"
 	"github.com/git-town/git-town/v16/internal/git/giturl"
+	"github.com/git-town/git-town/v16/internal/hosting/azuredevops"
 	"github.com/git-town/git-town/v16/internal/hosting/bitbucket"
+	"github.com/git-town/git-town/v16/internal/hosting/bitbucketdatacenter"
 	"github.com/git-town/git-town/v16/internal/hosting/gitea"
 	"github.com/git-town/git-town/v16/internal/hosting/github"
 	"github.com/git-town/git-town/v16/internal/hosting/gitlab"
@@ -14,16 +16,27 @@ func Detect(remoteURL giturl.Parts, userOverride Option[configdomain.HostingPlat
 	if userOverride.IsSome() {
 		return userOverride
 	}
-	detectors := map[configdomain.HostingPlatform]func(giturl.Parts) bool{
-		configdomain.HostingPlatformBitbucket: bitbucket.Detect,
-		configdomain.HostingPlatformGitea:     gitea.Detect,
-		configdomain.HostingPlatformGitHub:    github.Detect,
-		configdomain.HostingPlatformGitLab:    gitlab.Detect,
-	}
-	for platform, detector := range detectors {
-		if detector(remoteURL) {
-			return Some(platform)
+	for _, detector := range detectors {
+		if detector.detect(remoteURL) {
+			return Some(detector.platform)
 		}
 	}
 	return None[configdomain.HostingPlatform]()
 }
+
+// detectors lists the hosting platform detectors in the order in which they run.
+// Detectors that match specific hostnames run before the heuristic Bitbucket Data Center detector
+// so that ambiguous URLs like "gitlab.com/scm/..." always resolve to the same platform.
+var detectors = []platformDetector{ //nolint:gochecknoglobals
+	{detect: azuredevops.Detect, platform: configdomain.HostingPlatformAzureDevOps},
+	{detect: bitbucket.Detect, platform: configdomain.HostingPlatformBitbucket},
+	{detect: gitea.Detect, platform: configdomain.HostingPlatformGitea},
+	{detect: github.Detect, platform: configdomain.HostingPlatformGitHub},
+	{detect: gitlab.Detect, platform: configdomain.HostingPlatformGitLab},
+	{detect: bitbucketdatacenter.Detect, platform: configdomain.HostingPlatformBitbucketDatacenter},
+}
+
+type platformDetector struct {
+	detect   func(giturl.Parts) bool
+	platform configdomain.HostingPlatform
+}
@@ -13,6 +13,15 @@ import (
 func TestDetect(t *testing.T) {
 	t.Parallel()
 
+	t.Run("Azure DevOps, no override", func(t *testing.T) {
+		t.Parallel()
+		url, has := giturl.Parse("git@ssh.dev.azure.com:v3/org/project/repo").Get()
+		must.True(t, has)
+		have := hosting.Detect(url, None[configdomain.HostingPlatform]())
+		want := Some(configdomain.HostingPlatformAzureDevOps)
+		must.Eq(t, want, have)
+	})
+
 	t.Run("BitBucket SAAS, no override", func(t *testing.T) {
 		t.Parallel()
 		url, has := giturl.Parse("username@bitbucket.org:git-town/docs.git").Get()
@@ -31,6 +40,44 @@ func TestDetect(t *testing.T) {
 		must.Eq(t, want, have)
 	})
 
+	t.Run("Bitbucket Data Center SSH remote, no override", func(t *testing.T) {
+		t.Parallel()
+		url, has := giturl.Parse("ssh://git@bitbucket.example.com:7999/proj/repo.git").Get()
+		must.True(t, has)
+		have := hosting.Detect(url, None[configdomain.HostingPlatform]())
+		want := Some(configdomain.HostingPlatformBitbucketDatacenter)
+		must.Eq(t, want, have)
+	})
+
+	t.Run("GitLab SAAS with a Bitbucket Data Center path, no override", func(t *testing.T) {
+		t.Parallel()
+		url, has := giturl.Parse("ssh://git@gitlab.com:7999/scm/git-town/docs.git").Get()
+		must.True(t, has)
+		for range 20 {
+			have := hosting.Detect(url, None[configdomain.HostingPlatform]())
+			want := Some(configdomain.HostingPlatformGitLab)
+			must.Eq(t, want, have)
+		}
+	})
+
+	t.Run("GitHub SAAS with a Bitbucket Data Center path, no override", func(t *testing.T) {
+		t.Parallel()
+		url, has := giturl.Parse("https://github.com/scm/git-town/docs.git").Get()
+		must.True(t, has)
+		have := hosting.Detect(url, None[configdomain.HostingPlatform]())
+		want := Some(configdomain.HostingPlatformGitHub)
+		must.Eq(t, want, have)
+	})
+
+	t.Run("custom URL, override to Bitbucket Data Center", func(t *testing.T) {
+		t.Parallel()
+		url, has := giturl.Parse("username@custom.org:git-town/docs.git").Get()
+		must.True(t, has)
+		have := hosting.Detect(url, Some(configdomain.HostingPlatformBitbucketDatacenter))
+		want := Some(configdomain.HostingPlatformBitbucketDatacenter)
+		must.Eq(t, want, have)
+	})
+
 	t.Run("Gitea SAAS, no override", func(t *testing.T) {
 		t.Parallel()
 		url, has := giturl.Parse("username@gitea.com:git-town/docs.git").Get()
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
+	"slices"
+	"strings"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/git-town/git-town/v16/internal/cli/print"
@@ -17,17 +20,123 @@ import (
 	"golang.org/x/oauth2"
 )
 
+// ProposalTemplatePath is the location of the pull request template in repositories hosted on Gitea.
+const ProposalTemplatePath = ".gitea/pull_request_template.md"
+
 type Connector struct {
 	hostingdomain.Data
-	APIToken Option[configdomain.GiteaToken]
-	client   *gitea.Client
-	log      print.Logger
+	APIToken  func() Option[configdomain.GiteaToken]
+	client    *gitea.Client
+	forkOwner Option[string] // owner of the fork that Git Town pushes branches to, None if branches live in the repo itself
+	log       print.Logger
+}
+
+func (self Connector) CreateProposal(branch, target gitdomain.LocalBranchName, title gitdomain.ProposalTitle, body gitdomain.ProposalBody, metadata hostingdomain.ProposalMetadata) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingGiteaCreatePRViaAPI, branch, target)
+	pullRequestTitle := title.String()
+	if metadata.Draft {
+		// Gitea marks pull requests as work in progress through a prefix in their title
+		pullRequestTitle = "WIP: " + pullRequestTitle
+	}
+	labelIDs, err := self.labelIDs(metadata.Labels)
+	if err != nil {
+		self.log.Failed(err)
+		return hostingdomain.Proposal{}, err
+	}
+	pullRequest, _, err := self.client.CreatePullRequest(self.Organization, self.Repository, gitea.CreatePullRequestOption{
+		Assignees: metadata.Assignees,
+		Base:      target.String(),
+		Body:      body.String(),
+		Head:      self.headBranch(branch),
+		Labels:    labelIDs,
+		Title:     pullRequestTitle,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return hostingdomain.Proposal{}, err
+	}
+	self.log.Success()
+	if len(metadata.Reviewers) > 0 {
+		// the pull request exists at this point, so problems requesting reviews don't fail the proposal
+		_, err = self.client.CreateReviewRequests(self.Organization, self.Repository, pullRequest.Index, gitea.PullReviewRequestOptions{
+			Reviewers: metadata.Reviewers,
+		})
+		if err != nil {
+			self.log.Warning(messages.HostingProposalMetadataProblem, err)
+		}
+	}
+	return parsePullRequest(pullRequest), nil
 }
 
 func (self Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
 	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
 }
 
+func (self Connector) EnableAutoMerge(number int, method hostingdomain.ProposalMergeMethod, message gitdomain.CommitMessage, deleteBranch bool) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	options := gitea.MergePullRequestOption{
+		DeleteBranchAfterMerge: deleteBranch,
+		MergeWhenChecksSucceed: true,
+		Style:                  gitea.MergeStyleSquash,
+	}
+	switch method {
+	case hostingdomain.ProposalMergeMethodMerge:
+		options.Style = gitea.MergeStyleMerge
+	case hostingdomain.ProposalMergeMethodRebase:
+		options.Style = gitea.MergeStyleRebase
+	case hostingdomain.ProposalMergeMethodSquash:
+	}
+	if message != "" {
+		commitMessageParts := message.Parts()
+		options.Message = commitMessageParts.Text
+		options.Title = commitMessageParts.Subject
+	}
+	self.log.Start(messages.HostingGiteaEnableAutoMergeViaAPI, number)
+	_, _, err := self.client.MergePullRequest(self.Organization, self.Repository, int64(number), options)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) FindMergedProposals(branch, target gitdomain.LocalBranchName) ([]hostingdomain.MergedProposal, error) {
+	self.log.Start(messages.APIMergedProposalLookupStart)
+	result := []hostingdomain.MergedProposal{}
+	if len(hostingdomain.ReadProposalOverride()) > 0 {
+		// test runs simulate only open proposals
+		self.log.Success()
+		return result, nil
+	}
+	closedPullRequests, _, err := self.client.ListRepoPullRequests(self.Organization, self.Repository, gitea.ListPullRequestsOptions{
+		ListOptions: gitea.ListOptions{
+			PageSize: 50,
+		},
+		State: gitea.StateClosed,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return result, err
+	}
+	self.log.Success()
+	for _, pullRequest := range FilterPullRequests(closedPullRequests, self.headOwner(), branch, target) {
+		// closed pull requests include the ones that were closed without merging
+		if !pullRequest.HasMerged || pullRequest.Head == nil {
+			continue
+		}
+		if headSHA, hasHeadSHA := gitdomain.NewSHAOption(pullRequest.Head.Sha).Get(); hasHeadSHA {
+			result = append(result, hostingdomain.MergedProposal{
+				HeadSHA: headSHA,
+				Number:  int(pullRequest.Index),
+			})
+		}
+	}
+	return result, nil
+}
+
 func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
 	self.log.Start(messages.APIProposalLookupStart)
 	proposalURLOverride := hostingdomain.ReadProposalOverride()
@@ -37,8 +146,13 @@ func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Op
 			return None[hostingdomain.Proposal](), nil
 		}
 		return Some(hostingdomain.Proposal{
+			Body:         "",
+			Checks:       hostingdomain.ProposalChecksUnknown,
+			Draft:        false,
 			MergeWithAPI: true,
+			Mergeable:    None[bool](),
 			Number:       123,
+			Review:       hostingdomain.ProposalReviewUnknown,
 			Target:       target,
 			Title:        "title",
 			URL:          proposalURLOverride,
@@ -55,39 +169,110 @@ func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Op
 		return None[hostingdomain.Proposal](), err
 	}
 	self.log.Success()
-	pullRequests := FilterPullRequests(openPullRequests, self.Organization, branch, target)
+	pullRequests := FilterPullRequests(openPullRequests, self.headOwner(), branch, target)
 	switch len(pullRequests) {
 	case 0:
 		return None[hostingdomain.Proposal](), nil
 	case 1:
-		pullRequest := pullRequests[0]
-		return Some(hostingdomain.Proposal{
-			MergeWithAPI: pullRequest.Mergeable,
-			Number:       int(pullRequest.Index),
-			Target:       gitdomain.NewLocalBranchName(pullRequest.Base.Ref),
-			Title:        pullRequest.Title,
-			URL:          pullRequest.HTMLURL,
-		}), nil
+		return Some(parsePullRequest(pullRequests[0])), nil
 	default:
 		return None[hostingdomain.Proposal](), fmt.Errorf(messages.ProposalMultipleFound, len(pullRequests), branch, target)
 	}
 }
 
-func (self Connector) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchName, _ gitdomain.ProposalTitle, _ gitdomain.ProposalBody) (string, error) {
-	toCompare := parentBranch.String() + "..." + branch.String()
+func (self Connector) LoadProposal(number int) (Option[hostingdomain.ProposalWithSource], error) {
+	self.log.Start(messages.APIProposalLoadStart, number)
+	pullRequest, response, err := self.client.GetPullRequest(self.Organization, self.Repository, int64(number))
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		self.log.Success()
+		return None[hostingdomain.ProposalWithSource](), nil
+	}
+	if err != nil {
+		self.log.Failed(err)
+		return None[hostingdomain.ProposalWithSource](), err
+	}
+	self.log.Success()
+	return Some(hostingdomain.ProposalWithSource{
+		Proposal: parsePullRequest(pullRequest),
+		Source:   ParseProposalSource(pullRequest),
+	}), nil
+}
+
+func (self Connector) LoadProposalStatus(proposal hostingdomain.Proposal) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.APIProposalStatusLoadStart, proposal.Number)
+	if len(hostingdomain.ReadProposalOverride()) > 0 {
+		// test runs simulate proposals only via FindProposal
+		self.log.Success()
+		return proposal, nil
+	}
+	result, err := self.loadProposalStatus(int64(proposal.Number))
+	if err != nil {
+		self.log.Failed(err)
+		return proposal, err
+	}
+	self.log.Success()
+	return result, nil
+}
+
+func (self Connector) MergeProposal(number int, message gitdomain.CommitMessage) error {
+	commitMessageParts := message.Parts()
+	return self.mergePullRequest(number, gitea.MergePullRequestOption{
+		Message: commitMessageParts.Text,
+		Style:   gitea.MergeStyleMerge,
+		Title:   commitMessageParts.Subject,
+	})
+}
+
+func (self Connector) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchName, _ gitdomain.ProposalTitle, _ gitdomain.ProposalBody, _ hostingdomain.ProposalMetadata) (string, error) {
+	toCompare := parentBranch.String() + "..." + self.headBranch(branch)
 	return fmt.Sprintf("%s/compare/%s", self.RepositoryURL(), url.PathEscape(toCompare)), nil
 }
 
+func (self Connector) RebaseMergeProposal(number int) error {
+	return self.mergePullRequest(number, gitea.MergePullRequestOption{
+		Style: gitea.MergeStyleRebase,
+	})
+}
+
 func (self Connector) RepositoryURL() string {
 	return fmt.Sprintf("https://%s/%s/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
 }
 
+func (self Connector) SearchProposal(branch gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
+	self.log.Start(messages.APIProposalLookupStart)
+	if proposalURLOverride := hostingdomain.ReadProposalOverride(); len(proposalURLOverride) > 0 {
+		// the simulated proposal doesn't know which branch it targets
+		self.log.Success()
+		return hostingdomain.OverriddenProposal(proposalURLOverride, ""), nil
+	}
+	openPullRequests, _, err := self.client.ListRepoPullRequests(self.Organization, self.Repository, gitea.ListPullRequestsOptions{
+		ListOptions: gitea.ListOptions{
+			PageSize: 50,
+		},
+		State: gitea.StateOpen,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return None[hostingdomain.Proposal](), err
+	}
+	self.log.Success()
+	pullRequests := FilterPullRequestsFromBranch(openPullRequests, self.headOwner(), branch)
+	switch len(pullRequests) {
+	case 0:
+		return None[hostingdomain.Proposal](), nil
+	case 1:
+		return Some(parsePullRequest(pullRequests[0])), nil
+	default:
+		return None[hostingdomain.Proposal](), fmt.Errorf(messages.ProposalMultipleFromBranch, len(pullRequests), branch)
+	}
+}
+
 func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) error {
 	if number <= 0 {
 		return errors.New(messages.ProposalNoNumberGiven)
 	}
 	commitMessageParts := message.Parts()
-	self.log.Start(messages.HostingGithubMergingViaAPI, number)
+	self.log.Start(messages.HostingGiteaMergingViaAPI, number)
 	_, _, err := self.client.MergePullRequest(self.Organization, self.Repository, int64(number), gitea.MergePullRequestOption{
 		Style:   gitea.MergeStyleSquash,
 		Title:   commitMessageParts.Subject,
@@ -104,15 +289,135 @@ func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMe
 	return err
 }
 
-func (self Connector) UpdateProposalTarget(_ int, _ gitdomain.LocalBranchName) error {
-	// if self.log != nil {
-	// 	self.log(message.HostingGiteaUpdateBasebranchViaAPI, number, target)
-	// }
-	// _, err := self.client.EditPullRequest(self.owner, self.repository, int64(number), gitea.EditPullRequestOption{
-	// 	Base: newBaseName,
-	// })
-	// return err
-	return errors.New(messages.HostingGiteaNotImplemented)
+func (self Connector) UpdateProposalBody(number int, body gitdomain.ProposalBody) error {
+	self.log.Start(messages.APIProposalBodyUpdateStart, number)
+	if len(hostingdomain.ReadProposalOverride()) > 0 {
+		// test runs simulate proposals only via FindProposal
+		self.log.Success()
+		return nil
+	}
+	options, err := self.currentEditOptions(number)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	options.Body = body.String()
+	_, _, err = self.client.EditPullRequest(self.Organization, self.Repository, int64(number), options)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingGiteaUpdatePRViaAPI, number, target)
+	options, err := self.currentEditOptions(number)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	options.Base = target.String()
+	_, _, err = self.client.EditPullRequest(self.Organization, self.Repository, int64(number), options)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// currentEditOptions provides options to edit the pull request with the given number that contain its current title, body, and base.
+// The Gitea SDK always sends the title and body when editing a pull request,
+// so they must contain the current values to not get erased.
+func (self Connector) currentEditOptions(number int) (gitea.EditPullRequestOption, error) {
+	pullRequest, _, err := self.client.GetPullRequest(self.Organization, self.Repository, int64(number))
+	if err != nil {
+		return gitea.EditPullRequestOption{}, err
+	}
+	return gitea.EditPullRequestOption{
+		Base:  pullRequest.Base.Ref,
+		Body:  pullRequest.Body,
+		Title: pullRequest.Title,
+	}, nil
+}
+
+// headBranch provides how proposals refer to the given branch that they merge.
+// Branches in a fork contain the owner of the fork.
+func (self Connector) headBranch(branch gitdomain.LocalBranchName) string {
+	if forkOwner, hasForkOwner := self.forkOwner.Get(); hasForkOwner {
+		return forkOwner + ":" + branch.String()
+	}
+	return branch.String()
+}
+
+// headOwner provides the owner of the repository that contains the branches that proposals merge.
+func (self Connector) headOwner() string {
+	return self.forkOwner.GetOrElse(self.Organization)
+}
+
+func (self Connector) loadProposalStatus(number int64) (hostingdomain.Proposal, error) {
+	pullRequest, _, err := self.client.GetPullRequest(self.Organization, self.Repository, number)
+	if err != nil {
+		return hostingdomain.Proposal{}, err
+	}
+	combinedStatus, _, err := self.client.GetCombinedStatus(self.Organization, self.Repository, pullRequest.Head.Sha)
+	if err != nil {
+		return hostingdomain.Proposal{}, err
+	}
+	reviews, _, err := self.client.ListPullReviews(self.Organization, self.Repository, number, gitea.ListPullReviewsOptions{})
+	if err != nil {
+		return hostingdomain.Proposal{}, err
+	}
+	result := parsePullRequest(pullRequest)
+	result.Checks = ParseCombinedStatus(combinedStatus)
+	result.Review = ParseReviews(reviews)
+	return result, nil
+}
+
+// labelIDs provides the IDs of the labels with the given names in this repository.
+// Gitea's API for creating pull requests accepts labels only by ID.
+func (self Connector) labelIDs(names []string) ([]int64, error) {
+	result := []int64{}
+	if len(names) == 0 {
+		return result, nil
+	}
+	labels, _, err := self.client.ListRepoLabels(self.Organization, self.Repository, gitea.ListLabelsOptions{
+		ListOptions: gitea.ListOptions{
+			Page:     -1,
+			PageSize: 0,
+		},
+	})
+	if err != nil {
+		return result, err
+	}
+	for _, name := range names {
+		index := slices.IndexFunc(labels, func(label *gitea.Label) bool { return label.Name == name })
+		if index < 0 {
+			return result, fmt.Errorf(messages.HostingGiteaLabelNotFound, name)
+		}
+		result = append(result, labels[index].ID)
+	}
+	return result, nil
+}
+
+// mergePullRequest merges the pull request with the given number using the given options.
+func (self Connector) mergePullRequest(number int, options gitea.MergePullRequestOption) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingGiteaMergingViaAPI, number)
+	_, _, err := self.client.MergePullRequest(self.Organization, self.Repository, int64(number), options)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
 }
 
 func FilterPullRequests(pullRequests []*gitea.PullRequest, organization string, branch, target gitdomain.LocalBranchName) []*gitea.PullRequest {
@@ -127,12 +432,24 @@ func FilterPullRequests(pullRequests []*gitea.PullRequest, organization string,
 	return result
 }
 
+// FilterPullRequestsFromBranch provides the given pull requests that merge the given branch, whatever branch they target.
+func FilterPullRequestsFromBranch(pullRequests []*gitea.PullRequest, organization string, branch gitdomain.LocalBranchName) []*gitea.PullRequest {
+	result := []*gitea.PullRequest(nil)
+	headName := organization + "/" + branch.String()
+	for _, pullRequest := range pullRequests {
+		if pullRequest.Head.Name == headName {
+			result = append(result, pullRequest)
+		}
+	}
+	return result
+}
+
 // NewGiteaConfig provides Gitea configuration data if the current repo is hosted on Gitea,
 // otherwise nil.
 func NewConnector(args NewConnectorArgs) Connector {
-	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: args.APIToken.String()})
-	httpClient := oauth2.NewClient(context.Background(), tokenSource)
-	giteaClient := gitea.NewClientWithHTTP("https://"+args.RemoteURL.Host, httpClient)
+	tokenSource := hostingdomain.OAuthTokenSource[configdomain.GiteaToken](args.APIToken)
+	httpClient := oauth2.NewClient(context.WithValue(context.Background(), oauth2.HTTPClient, args.HTTPClient), tokenSource)
+	giteaClient := gitea.NewClientWithHTTP(args.APIURL.GetOrElse("https://"+args.RemoteURL.Host), httpClient)
 	return Connector{
 		APIToken: args.APIToken,
 		Data: hostingdomain.Data{
@@ -140,13 +457,108 @@ func NewConnector(args NewConnectorArgs) Connector {
 			Organization: args.RemoteURL.Org,
 			Repository:   args.RemoteURL.Repo,
 		},
-		client: giteaClient,
-		log:    args.Log,
+		client:    giteaClient,
+		forkOwner: hostingdomain.ForkOwner(args.RemoteURL, args.PushRemoteURL),
+		log:       args.Log,
 	}
 }
 
 type NewConnectorArgs struct {
-	APIToken  Option[configdomain.GiteaToken]
-	Log       print.Logger
-	RemoteURL giturl.Parts
+	APIToken      func() Option[configdomain.GiteaToken]
+	APIURL        Option[string] // base URL of the API, derived from the remote URL if not given
+	HTTPClient    *http.Client
+	Log           print.Logger
+	PushRemoteURL Option[giturl.Parts]
+	RemoteURL     giturl.Parts
+}
+
+// ParseProposalSource provides the location of the branch that the given pull request merges.
+// Pull requests from forks have a head repository that differs from their base repository.
+func ParseProposalSource(pullRequest *gitea.PullRequest) hostingdomain.ProposalSource {
+	fork := None[hostingdomain.ProposalFork]()
+	if headRepo := pullRequest.Head.Repository; headRepo != nil && pullRequest.Head.RepoID != pullRequest.Base.RepoID {
+		owner := ""
+		if headRepo.Owner != nil {
+			owner = headRepo.Owner.UserName
+		}
+		fork = Some(hostingdomain.ProposalFork{
+			CloneURL: headRepo.CloneURL,
+			Owner:    owner,
+		})
+	}
+	return hostingdomain.ProposalSource{
+		Branch: gitdomain.NewLocalBranchName(pullRequest.Head.Ref),
+		Fork:   fork,
+	}
+}
+
+// ParseReviews provides the review state of a pull request with the given reviews.
+func ParseReviews(reviews []*gitea.PullReview) hostingdomain.ProposalReview {
+	// only the latest review of each reviewer counts, Gitea lists reviews in chronological order
+	latestReviews := map[int64]gitea.ReviewStateType{}
+	for _, review := range reviews {
+		if review.Dismissed || review.Reviewer == nil {
+			continue
+		}
+		switch review.State {
+		case gitea.ReviewStateApproved, gitea.ReviewStateRequestChanges, gitea.ReviewStateRequestReview:
+			latestReviews[review.Reviewer.ID] = review.State
+		case gitea.ReviewStateComment, gitea.ReviewStatePending, gitea.ReviewStateUnknown:
+		}
+	}
+	states := []hostingdomain.ProposalReview{}
+	outstandingReviews := false
+	for _, state := range latestReviews {
+		switch state {
+		case gitea.ReviewStateApproved:
+			states = append(states, hostingdomain.ProposalReviewApproved)
+		case gitea.ReviewStateRequestChanges:
+			states = append(states, hostingdomain.ProposalReviewChangesRequested)
+		case gitea.ReviewStateRequestReview:
+			outstandingReviews = true
+		case gitea.ReviewStateComment, gitea.ReviewStatePending, gitea.ReviewStateUnknown:
+		}
+	}
+	reviewRequired := outstandingReviews && !slices.Contains(states, hostingdomain.ProposalReviewApproved)
+	return hostingdomain.CombineProposalReviews(states, reviewRequired)
+}
+
+// isDraft indicates whether a pull request with the given title is a work in progress.
+// Gitea marks pull requests as work in progress through a prefix in their title.
+func isDraft(title string) bool {
+	return strings.HasPrefix(title, "WIP:") || strings.HasPrefix(title, "[WIP]")
+}
+
+// ParseCombinedStatus provides the state of the CI checks represented by the given combined commit status.
+func ParseCombinedStatus(combinedStatus *gitea.CombinedStatus) hostingdomain.ProposalChecks {
+	if combinedStatus.TotalCount == 0 {
+		return hostingdomain.ProposalChecksNone
+	}
+	switch combinedStatus.State {
+	case gitea.StatusSuccess, gitea.StatusWarning:
+		return hostingdomain.ProposalChecksSuccess
+	case gitea.StatusError, gitea.StatusFailure:
+		return hostingdomain.ProposalChecksFailure
+	case gitea.StatusPending:
+		return hostingdomain.ProposalChecksPending
+	}
+	// newer Gitea and Forgejo versions report additional states like "skipped",
+	// treat them as still running rather than blocking the proposal as failed
+	return hostingdomain.ProposalChecksPending
+}
+
+// parsePullRequest extracts standardized proposal data from the given Gitea pull request.
+func parsePullRequest(pullRequest *gitea.PullRequest) hostingdomain.Proposal {
+	return hostingdomain.Proposal{
+		Body:         gitdomain.ProposalBody(pullRequest.Body),
+		Checks:       hostingdomain.ProposalChecksUnknown,
+		Draft:        isDraft(pullRequest.Title),
+		MergeWithAPI: pullRequest.Mergeable,
+		Mergeable:    Some(pullRequest.Mergeable),
+		Number:       int(pullRequest.Index),
+		Review:       hostingdomain.ProposalReviewUnknown,
+		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.Ref),
+		Title:        pullRequest.Title,
+		URL:          pullRequest.HTMLURL,
+	}
 }
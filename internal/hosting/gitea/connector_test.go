@@ -1,12 +1,19 @@
 package gitea_test
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	giteasdk "code.gitea.io/sdk/gitea"
+	"github.com/git-town/git-town/v16/internal/cli/print"
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
 	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/git/giturl"
 	"github.com/git-town/git-town/v16/internal/hosting/gitea"
 	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
 	"github.com/shoenig/test/must"
 )
 
@@ -64,6 +71,153 @@ func TestFilterGiteaPullRequests(t *testing.T) {
 	must.Eq(t, want, have)
 }
 
+func TestFilterGiteaPullRequestsFromBranch(t *testing.T) {
+	t.Parallel()
+	give := []*giteasdk.PullRequest{
+		// matching branch
+		{
+			Head: &giteasdk.PRBranchInfo{
+				Name: "organization/branch",
+			},
+			Base: &giteasdk.PRBranchInfo{
+				Name: "target",
+			},
+		},
+		// branch with different name
+		{
+			Head: &giteasdk.PRBranchInfo{
+				Name: "organization/other",
+			},
+			Base: &giteasdk.PRBranchInfo{
+				Name: "target",
+			},
+		},
+		// matching branch with different target
+		{
+			Head: &giteasdk.PRBranchInfo{
+				Name: "organization/branch",
+			},
+			Base: &giteasdk.PRBranchInfo{
+				Name: "other",
+			},
+		},
+		// branch with different organization
+		{
+			Head: &giteasdk.PRBranchInfo{
+				Name: "other/branch",
+			},
+			Base: &giteasdk.PRBranchInfo{
+				Name: "target",
+			},
+		},
+	}
+	want := []*giteasdk.PullRequest{
+		{
+			Head: &giteasdk.PRBranchInfo{
+				Name: "organization/branch",
+			},
+			Base: &giteasdk.PRBranchInfo{
+				Name: "target",
+			},
+		},
+		{
+			Head: &giteasdk.PRBranchInfo{
+				Name: "organization/branch",
+			},
+			Base: &giteasdk.PRBranchInfo{
+				Name: "other",
+			},
+		},
+	}
+	have := gitea.FilterPullRequestsFromBranch(give, "organization", gitdomain.NewLocalBranchName("branch"))
+	must.Eq(t, want, have)
+}
+
+func TestGiteaParseProposalSource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("branch in the same repository", func(t *testing.T) {
+		t.Parallel()
+		pullRequest := &giteasdk.PullRequest{
+			Base: &giteasdk.PRBranchInfo{Ref: "main", RepoID: 1},
+			Head: &giteasdk.PRBranchInfo{Ref: "feature", RepoID: 1, Repository: &giteasdk.Repository{ID: 1}},
+		}
+		have := gitea.ParseProposalSource(pullRequest)
+		want := hostingdomain.ProposalSource{
+			Branch: "feature",
+			Fork:   None[hostingdomain.ProposalFork](),
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("branch in a fork", func(t *testing.T) {
+		t.Parallel()
+		pullRequest := &giteasdk.PullRequest{
+			Base: &giteasdk.PRBranchInfo{Ref: "main", RepoID: 1},
+			Head: &giteasdk.PRBranchInfo{Ref: "feature", RepoID: 2, Repository: &giteasdk.Repository{
+				CloneURL: "https://gitea.com/alice/git-town.git",
+				ID:       2,
+				Owner:    &giteasdk.User{UserName: "alice"},
+			}},
+		}
+		have := gitea.ParseProposalSource(pullRequest)
+		want := hostingdomain.ProposalSource{
+			Branch: "feature",
+			Fork: Some(hostingdomain.ProposalFork{
+				CloneURL: "https://gitea.com/alice/git-town.git",
+				Owner:    "alice",
+			}),
+		}
+		must.Eq(t, want, have)
+	})
+}
+
+func TestGiteaParseCombinedStatus(t *testing.T) {
+	t.Parallel()
+	tests := map[giteasdk.StatusState]hostingdomain.ProposalChecks{
+		giteasdk.StatusSuccess: hostingdomain.ProposalChecksSuccess,
+		giteasdk.StatusWarning: hostingdomain.ProposalChecksSuccess,
+		giteasdk.StatusPending: hostingdomain.ProposalChecksPending,
+		giteasdk.StatusError:   hostingdomain.ProposalChecksFailure,
+		giteasdk.StatusFailure: hostingdomain.ProposalChecksFailure,
+		"skipped":              hostingdomain.ProposalChecksPending,
+	}
+	for give, want := range tests {
+		have := gitea.ParseCombinedStatus(&giteasdk.CombinedStatus{State: give, TotalCount: 1})
+		must.EqOp(t, want, have)
+	}
+
+	t.Run("no statuses", func(t *testing.T) {
+		t.Parallel()
+		have := gitea.ParseCombinedStatus(&giteasdk.CombinedStatus{State: "", TotalCount: 0})
+		must.EqOp(t, hostingdomain.ProposalChecksNone, have)
+	})
+}
+
+func TestGiteaParseReviews(t *testing.T) {
+	t.Parallel()
+
+	t.Run("approved after requesting changes", func(t *testing.T) {
+		t.Parallel()
+		reviews := []*giteasdk.PullReview{
+			{State: giteasdk.ReviewStateRequestChanges, Reviewer: &giteasdk.User{ID: 1}},
+			{State: giteasdk.ReviewStateApproved, Reviewer: &giteasdk.User{ID: 1}},
+		}
+		have := gitea.ParseReviews(reviews)
+		must.EqOp(t, hostingdomain.ProposalReviewApproved, have)
+	})
+
+	t.Run("dismissed approval and outstanding review request", func(t *testing.T) {
+		t.Parallel()
+		reviews := []*giteasdk.PullReview{
+			{State: giteasdk.ReviewStateApproved, Reviewer: &giteasdk.User{ID: 1}, Dismissed: true},
+			{State: giteasdk.ReviewStateRequestReview, Reviewer: &giteasdk.User{ID: 2}},
+		}
+		have := gitea.ParseReviews(reviews)
+		must.EqOp(t, hostingdomain.ProposalReviewRequired, have)
+	})
+}
+
 //nolint:paralleltest  // mocks HTTP
 func TestGitea(t *testing.T) {
 	t.Run("DefaultProposalMessage", func(t *testing.T) {
@@ -94,6 +248,23 @@ func TestGitea(t *testing.T) {
 	// 	must.EqOp(t, "https://gitea.com/git-town/docs/compare/parent...feature", have)
 	// })
 
+	t.Run("NewProposalURL from a fork", func(t *testing.T) {
+		remoteURL, has := giturl.Parse("git@gitea.com:git-town/docs.git").Get()
+		must.True(t, has)
+		connector := gitea.NewConnector(gitea.NewConnectorArgs{
+			APIToken:      func() Option[configdomain.GiteaToken] { return None[configdomain.GiteaToken]() },
+			APIURL:        None[string](),
+			HTTPClient:    http.DefaultClient,
+			Log:           print.Logger{},
+			PushRemoteURL: giturl.Parse("git@gitea.com:alice/docs.git"),
+			RemoteURL:     remoteURL,
+		})
+		main := gitdomain.NewLocalBranchName("main")
+		have, err := connector.NewProposalURL(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("parent"), main, "", "", hostingdomain.ProposalMetadata{})
+		must.NoError(t, err)
+		must.EqOp(t, "https://gitea.com/git-town/docs/compare/parent...alice:feature", have)
+	})
+
 	// THIS TEST CONNECTS TO AN EXTERNAL INTERNET HOST,
 	// WHICH MAKES IT SLOW AND FLAKY.
 	// DISABLE AS NEEDED TO DEBUG THE GITEA CONNECTOR.
@@ -109,6 +280,48 @@ func TestGitea(t *testing.T) {
 	// 	have := connector.RepositoryURL()
 	// 	must.EqOp(t, "https://gitea.com/git-town/docs", have)
 	// })
+
+	t.Run("UpdateProposalTarget", func(t *testing.T) {
+		t.Run("no proposal number given", func(t *testing.T) {
+			connector := gitea.Connector{}
+			err := connector.UpdateProposalTarget(0, gitdomain.NewLocalBranchName("main"))
+			must.ErrorContains(t, err, "no proposal number given")
+		})
+
+		t.Run("sends the new base branch", func(t *testing.T) {
+			var editBody map[string]any
+			server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				writer.Header().Set("Content-Type", "application/json")
+				switch {
+				case request.Method == http.MethodGet && request.URL.Path == "/api/v1/version":
+					_, _ = writer.Write([]byte(`{"version": "1.22.0"}`))
+				case request.Method == http.MethodGet && request.URL.Path == "/api/v1/repos/git-town/docs/pulls/1":
+					_, _ = writer.Write([]byte(`{"number": 1, "title": "my title", "body": "my body", "base": {"ref": "old-parent"}}`))
+				case request.Method == http.MethodPatch && request.URL.Path == "/api/v1/repos/git-town/docs/pulls/1":
+					must.NoError(t, json.NewDecoder(request.Body).Decode(&editBody))
+					_, _ = writer.Write([]byte(`{"number": 1}`))
+				default:
+					writer.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+			remoteURL, has := giturl.Parse("git@gitea.com:git-town/docs.git").Get()
+			must.True(t, has)
+			connector := gitea.NewConnector(gitea.NewConnectorArgs{
+				APIToken:      func() Option[configdomain.GiteaToken] { return None[configdomain.GiteaToken]() },
+				APIURL:        Some(server.URL),
+				HTTPClient:    server.Client(),
+				Log:           print.QuietLogger(),
+				PushRemoteURL: None[giturl.Parts](),
+				RemoteURL:     remoteURL,
+			})
+			err := connector.UpdateProposalTarget(1, gitdomain.NewLocalBranchName("new-parent"))
+			must.NoError(t, err)
+			must.Eq(t, "new-parent", editBody["base"])
+			must.Eq(t, "my title", editBody["title"])
+			must.Eq(t, "my body", editBody["body"])
+		})
+	})
 }
 
 func TestNewGiteaConnector(t *testing.T) {
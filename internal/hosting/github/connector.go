@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
-	"os"
+	"slices"
+	"strings"
 
 	"github.com/git-town/git-town/v16/internal/cli/print"
 	"github.com/git-town/git-town/v16/internal/config/configdomain"
@@ -18,19 +20,118 @@ import (
 	"golang.org/x/oauth2"
 )
 
+// the GraphQL mutation that enables auto-merge for a pull request,
+// GitHub's REST API doesn't support this
+const enableAutoMergeMutation = `mutation($pullRequestId: ID!, $mergeMethod: PullRequestMergeMethod!, $commitHeadline: String, $commitBody: String) {
+  enablePullRequestAutoMerge(input: {pullRequestId: $pullRequestId, mergeMethod: $mergeMethod, commitHeadline: $commitHeadline, commitBody: $commitBody}) {
+    clientMutationId
+  }
+}`
+
+// the GraphQL query that loads the review state of a pull request.
+// Only the GraphQL API provides the review decision, which considers the reviews that branch protection rules require.
+const reviewStateQuery = `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) {
+      reviewDecision
+      latestReviews(first: 100) {
+        nodes {
+          state
+        }
+      }
+    }
+  }
+}`
+
+// ProposalTemplatePath is the location of the pull request template in repositories hosted on GitHub.
+const ProposalTemplatePath = ".github/pull_request_template.md"
+
 // Connector provides standardized connectivity for the given repository (github.com/owner/repo)
 // via the GitHub API.
 type Connector struct {
 	hostingdomain.Data
-	APIToken Option[configdomain.GitHubToken]
-	client   *github.Client
-	log      print.Logger
+	APIToken  func() Option[configdomain.GitHubToken]
+	client    *github.Client
+	forkOwner Option[string] // owner of the fork that Git Town pushes branches to, None if branches live in the repo itself
+	log       print.Logger
+}
+
+func (self Connector) CreateProposal(branch, target gitdomain.LocalBranchName, title gitdomain.ProposalTitle, body gitdomain.ProposalBody, metadata hostingdomain.ProposalMetadata) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingGithubCreatePRViaAPI, branch, target)
+	ctx := context.Background()
+	pullRequest, _, err := self.client.PullRequests.Create(ctx, self.Organization, self.Repository, &github.NewPullRequest{
+		Base:  github.String(target.String()),
+		Body:  github.String(body.String()),
+		Draft: github.Bool(metadata.Draft),
+		Head:  github.String(self.headBranch(branch)),
+		Title: github.String(title.String()),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return hostingdomain.Proposal{}, err
+	}
+	self.log.Success()
+	// the pull request exists at this point, so problems with its metadata don't fail the proposal
+	err = self.applyProposalMetadata(ctx, pullRequest.GetNumber(), metadata)
+	if err != nil {
+		self.log.Warning(messages.HostingProposalMetadataProblem, err)
+	}
+	return parsePullRequest(pullRequest), nil
 }
 
 func (self Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
 	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
 }
 
+// GitHub deletes the branch of the merged pull request only if the repository is configured to do so,
+// otherwise "git town sync" deletes it.
+func (self Connector) EnableAutoMerge(number int, method hostingdomain.ProposalMergeMethod, message gitdomain.CommitMessage, _ bool) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingGithubEnableAutoMergeViaAPI, number)
+	err := self.enableAutoMerge(number, method, message)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) FindMergedProposals(branch, target gitdomain.LocalBranchName) ([]hostingdomain.MergedProposal, error) {
+	self.log.Start(messages.APIMergedProposalLookupStart)
+	result := []hostingdomain.MergedProposal{}
+	if len(hostingdomain.ReadProposalOverride()) > 0 {
+		// test runs simulate only open proposals
+		self.log.Success()
+		return result, nil
+	}
+	pullRequests, _, err := self.client.PullRequests.List(context.Background(), self.Organization, self.Repository, &github.PullRequestListOptions{
+		Head:  self.headOwner() + ":" + branch.String(),
+		Base:  target.String(),
+		State: "closed",
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return result, err
+	}
+	self.log.Success()
+	for _, pullRequest := range pullRequests {
+		// closed pull requests include the ones that were closed without merging
+		if pullRequest.MergedAt == nil {
+			continue
+		}
+		if headSHA, hasHeadSHA := gitdomain.NewSHAOption(pullRequest.GetHead().GetSHA()).Get(); hasHeadSHA {
+			result = append(result, hostingdomain.MergedProposal{
+				HeadSHA: headSHA,
+				Number:  pullRequest.GetNumber(),
+			})
+		}
+	}
+	return result, nil
+}
+
 func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
 	self.log.Start(messages.APIProposalLookupStart)
 	proposalURLOverride := hostingdomain.ReadProposalOverride()
@@ -40,15 +141,20 @@ func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Op
 			return None[hostingdomain.Proposal](), nil
 		}
 		return Some(hostingdomain.Proposal{
+			Body:         "",
+			Checks:       hostingdomain.ProposalChecksUnknown,
+			Draft:        false,
 			MergeWithAPI: true,
+			Mergeable:    None[bool](),
 			Number:       123,
+			Review:       hostingdomain.ProposalReviewUnknown,
 			Target:       target,
 			Title:        "title",
 			URL:          proposalURLOverride,
 		}), nil
 	}
 	pullRequests, _, err := self.client.PullRequests.List(context.Background(), self.Organization, self.Repository, &github.PullRequestListOptions{
-		Head:  self.Organization + ":" + branch.String(),
+		Head:  self.headOwner() + ":" + branch.String(),
 		Base:  target.String(),
 		State: "open",
 	})
@@ -67,10 +173,50 @@ func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Op
 	return Some(proposal), nil
 }
 
-func (self Connector) NewProposalURL(branch, parentBranch, mainBranch gitdomain.LocalBranchName, proposalTitle gitdomain.ProposalTitle, proposalBody gitdomain.ProposalBody) (string, error) {
-	toCompare := branch.String()
-	if parentBranch != mainBranch {
-		toCompare = parentBranch.String() + "..." + branch.String()
+func (self Connector) LoadProposal(number int) (Option[hostingdomain.ProposalWithSource], error) {
+	self.log.Start(messages.APIProposalLoadStart, number)
+	pullRequest, response, err := self.client.PullRequests.Get(context.Background(), self.Organization, self.Repository, number)
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		self.log.Success()
+		return None[hostingdomain.ProposalWithSource](), nil
+	}
+	if err != nil {
+		self.log.Failed(err)
+		return None[hostingdomain.ProposalWithSource](), err
+	}
+	self.log.Success()
+	return Some(hostingdomain.ProposalWithSource{
+		Proposal: parsePullRequest(pullRequest),
+		Source:   ParseProposalSource(pullRequest),
+	}), nil
+}
+
+func (self Connector) LoadProposalStatus(proposal hostingdomain.Proposal) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.APIProposalStatusLoadStart, proposal.Number)
+	if len(hostingdomain.ReadProposalOverride()) > 0 {
+		// test runs simulate proposals only via FindProposal
+		self.log.Success()
+		return proposal, nil
+	}
+	result, err := self.loadProposalStatus(proposal.Number)
+	if err != nil {
+		self.log.Failed(err)
+		return proposal, err
+	}
+	self.log.Success()
+	return result, nil
+}
+
+func (self Connector) MergeProposal(number int, message gitdomain.CommitMessage) error {
+	return self.mergeProposal(number, message, "merge")
+}
+
+// NewProposalURL provides the URL of the page to create a new pull request.
+// GitHub supports labels and assignees in this URL, but no reviewers and no draft state.
+func (self Connector) NewProposalURL(branch, parentBranch, mainBranch gitdomain.LocalBranchName, proposalTitle gitdomain.ProposalTitle, proposalBody gitdomain.ProposalBody, metadata hostingdomain.ProposalMetadata) (string, error) {
+	toCompare := self.headBranch(branch)
+	if parentBranch != mainBranch || self.forkOwner.IsSome() {
+		toCompare = parentBranch.String() + "..." + toCompare
 	}
 	result := fmt.Sprintf("%s/compare/%s?expand=1", self.RepositoryURL(), url.PathEscape(toCompare))
 	if proposalTitle != "" {
@@ -79,13 +225,49 @@ func (self Connector) NewProposalURL(branch, parentBranch, mainBranch gitdomain.
 	if proposalBody != "" {
 		result += "&body=" + url.QueryEscape(proposalBody.String())
 	}
+	if len(metadata.Labels) > 0 {
+		result += "&labels=" + url.QueryEscape(strings.Join(metadata.Labels, ","))
+	}
+	if len(metadata.Assignees) > 0 {
+		result += "&assignees=" + url.QueryEscape(strings.Join(metadata.Assignees, ","))
+	}
 	return result, nil
 }
 
+func (self Connector) RebaseMergeProposal(number int) error {
+	return self.mergeProposal(number, "", "rebase")
+}
+
 func (self Connector) RepositoryURL() string {
 	return fmt.Sprintf("https://%s/%s/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
 }
 
+func (self Connector) SearchProposal(branch gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
+	self.log.Start(messages.APIProposalLookupStart)
+	if proposalURLOverride := hostingdomain.ReadProposalOverride(); len(proposalURLOverride) > 0 {
+		// the simulated proposal doesn't know which branch it targets
+		self.log.Success()
+		return hostingdomain.OverriddenProposal(proposalURLOverride, ""), nil
+	}
+	pullRequests, _, err := self.client.PullRequests.List(context.Background(), self.Organization, self.Repository, &github.PullRequestListOptions{
+		Head:  self.headOwner() + ":" + branch.String(),
+		State: "open",
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return None[hostingdomain.Proposal](), err
+	}
+	self.log.Success()
+	switch len(pullRequests) {
+	case 0:
+		return None[hostingdomain.Proposal](), nil
+	case 1:
+		return Some(parsePullRequest(pullRequests[0])), nil
+	default:
+		return None[hostingdomain.Proposal](), fmt.Errorf(messages.ProposalMultipleFromBranch, len(pullRequests), branch)
+	}
+}
+
 func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) (err error) {
 	if number <= 0 {
 		return errors.New(messages.ProposalNoNumberGiven)
@@ -100,6 +282,24 @@ func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMe
 	return err
 }
 
+func (self Connector) UpdateProposalBody(number int, body gitdomain.ProposalBody) error {
+	self.log.Start(messages.APIProposalBodyUpdateStart, number)
+	if len(hostingdomain.ReadProposalOverride()) > 0 {
+		// test runs simulate proposals only via FindProposal
+		self.log.Success()
+		return nil
+	}
+	_, _, err := self.client.PullRequests.Edit(context.Background(), self.Organization, self.Repository, number, &github.PullRequest{
+		Body: github.String(body.String()),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
 func (self Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
 	targetName := target.String()
 	self.log.Start(messages.APIProposalUpdateStart)
@@ -116,32 +316,148 @@ func (self Connector) UpdateProposalTarget(number int, target gitdomain.LocalBra
 	return nil
 }
 
-// getGitHubApiToken returns the GitHub API token to use.
-// It first checks the GITHUB_TOKEN environment variable.
-// If that is not set, it checks the GITHUB_AUTH_TOKEN environment variable.
-// If that is not set, it checks the git config.
-func GetAPIToken(gitConfigToken Option[configdomain.GitHubToken]) Option[configdomain.GitHubToken] {
-	apiToken := os.ExpandEnv("$GITHUB_TOKEN")
-	if apiToken != "" {
-		return Some(configdomain.GitHubToken(apiToken))
+// applyProposalMetadata adds the labels, assignees, and reviewers in the given metadata
+// to the pull request with the given number.
+// GitHub's API for creating pull requests doesn't support them.
+func (self Connector) applyProposalMetadata(ctx context.Context, number int, metadata hostingdomain.ProposalMetadata) error {
+	if len(metadata.Labels) > 0 {
+		_, _, err := self.client.Issues.AddLabelsToIssue(ctx, self.Organization, self.Repository, number, metadata.Labels)
+		if err != nil {
+			return err
+		}
 	}
-	apiToken = os.ExpandEnv("$GITHUB_AUTH_TOKEN")
-	if apiToken != "" {
-		return Some(configdomain.GitHubToken(apiToken))
+	if len(metadata.Assignees) > 0 {
+		_, _, err := self.client.Issues.AddAssignees(ctx, self.Organization, self.Repository, number, metadata.Assignees)
+		if err != nil {
+			return err
+		}
 	}
-	return gitConfigToken
+	if len(metadata.Reviewers) > 0 {
+		_, _, err := self.client.PullRequests.RequestReviewers(ctx, self.Organization, self.Repository, number, ParseReviewers(metadata.Reviewers))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enableAutoMerge enables auto-merge for the pull request with the given number through GitHub's GraphQL API.
+func (self Connector) enableAutoMerge(number int, method hostingdomain.ProposalMergeMethod, message gitdomain.CommitMessage) error {
+	ctx := context.Background()
+	pullRequest, _, err := self.client.PullRequests.Get(ctx, self.Organization, self.Repository, number)
+	if err != nil {
+		return err
+	}
+	variables := map[string]any{
+		"mergeMethod":   strings.ToUpper(method.String()),
+		"pullRequestId": pullRequest.GetNodeID(),
+	}
+	if message != "" {
+		commitMessageParts := message.Parts()
+		variables["commitBody"] = commitMessageParts.Text
+		variables["commitHeadline"] = commitMessageParts.Subject
+	}
+	_, err = self.graphQL(enableAutoMergeMutation, variables)
+	return err
+}
+
+// graphQL sends the given query to GitHub's GraphQL API.
+func (self Connector) graphQL(query string, variables map[string]any) (GraphQLData, error) {
+	// the GraphQL endpoint is at "/graphql" on github.com and at "/api/graphql" on GitHub Enterprise
+	request, err := self.client.NewRequest(http.MethodPost, "../graphql", GraphQLRequest{
+		Query:     query,
+		Variables: variables,
+	})
+	if err != nil {
+		return GraphQLData{}, err
+	}
+	var response GraphQLResponse
+	_, err = self.client.Do(context.Background(), request, &response)
+	if err != nil {
+		return GraphQLData{}, err
+	}
+	if len(response.Errors) > 0 {
+		return GraphQLData{}, fmt.Errorf(messages.HostingGithubGraphQLError, response.Errors[0].Message)
+	}
+	return response.Data, nil
+}
+
+// headBranch provides how proposals refer to the given branch that they merge.
+// Branches in a fork contain the owner of the fork.
+func (self Connector) headBranch(branch gitdomain.LocalBranchName) string {
+	if forkOwner, hasForkOwner := self.forkOwner.Get(); hasForkOwner {
+		return forkOwner + ":" + branch.String()
+	}
+	return branch.String()
+}
+
+// headOwner provides the owner of the repository that contains the branches that proposals merge.
+func (self Connector) headOwner() string {
+	return self.forkOwner.GetOrElse(self.Organization)
+}
+
+func (self Connector) loadProposalStatus(number int) (hostingdomain.Proposal, error) {
+	ctx := context.Background()
+	pullRequest, _, err := self.client.PullRequests.Get(ctx, self.Organization, self.Repository, number)
+	if err != nil {
+		return hostingdomain.Proposal{}, err
+	}
+	headSHA := pullRequest.GetHead().GetSHA()
+	combinedStatus, _, err := self.client.Repositories.GetCombinedStatus(ctx, self.Organization, self.Repository, headSHA, nil)
+	if err != nil {
+		return hostingdomain.Proposal{}, err
+	}
+	checkRuns, _, err := self.client.Checks.ListCheckRunsForRef(ctx, self.Organization, self.Repository, headSHA, nil)
+	if err != nil {
+		return hostingdomain.Proposal{}, err
+	}
+	reviewState, err := self.graphQL(reviewStateQuery, map[string]any{
+		"number": number,
+		"owner":  self.Organization,
+		"repo":   self.Repository,
+	})
+	if err != nil {
+		return hostingdomain.Proposal{}, err
+	}
+	result := parsePullRequest(pullRequest)
+	result.Checks = ParseChecks(combinedStatus, checkRuns.CheckRuns)
+	result.Review = ParseReviews(reviewState.Repository.PullRequest)
+	return result, nil
+}
+
+// mergeProposal merges the proposal with the given number using the given GitHub merge method.
+func (self Connector) mergeProposal(number int, message gitdomain.CommitMessage, mergeMethod string) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingGithubMergingViaAPI, number)
+	commitMessageParts := message.Parts()
+	_, _, err := self.client.PullRequests.Merge(context.Background(), self.Organization, self.Repository, number, commitMessageParts.Text, &github.PullRequestOptions{
+		CommitTitle: commitMessageParts.Subject,
+		MergeMethod: mergeMethod,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
 }
 
 // NewConnector provides a fully configured GithubConnector instance
 // if the current repo is hosted on GitHub, otherwise nil.
 func NewConnector(args NewConnectorArgs) (Connector, error) {
-	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: args.APIToken.String()})
-	httpClient := oauth2.NewClient(context.Background(), tokenSource)
+	tokenSource := hostingdomain.OAuthTokenSource[configdomain.GitHubToken](args.APIToken)
+	httpClient := oauth2.NewClient(context.WithValue(context.Background(), oauth2.HTTPClient, args.HTTPClient), tokenSource)
 	githubClient := github.NewClient(httpClient)
-	if args.RemoteURL.Host != "github.com" {
-		url := "https://" + args.RemoteURL.Host
+	apiURL, hasAPIURL := args.APIURL.Get()
+	if !hasAPIURL && args.RemoteURL.Host != "github.com" {
+		apiURL = "https://" + args.RemoteURL.Host
+		hasAPIURL = true
+	}
+	if hasAPIURL {
 		var err error
-		githubClient, err = githubClient.WithEnterpriseURLs(url, url)
+		githubClient, err = githubClient.WithEnterpriseURLs(apiURL, apiURL)
 		if err != nil {
 			return Connector{}, fmt.Errorf(messages.GitHubEnterpriseInitializeError, err)
 		}
@@ -153,24 +469,163 @@ func NewConnector(args NewConnectorArgs) (Connector, error) {
 			Organization: args.RemoteURL.Org,
 			Repository:   args.RemoteURL.Repo,
 		},
-		client: githubClient,
-		log:    args.Log,
+		client:    githubClient,
+		forkOwner: hostingdomain.ForkOwner(args.RemoteURL, args.PushRemoteURL),
+		log:       args.Log,
 	}, nil
 }
 
 type NewConnectorArgs struct {
-	APIToken  Option[configdomain.GitHubToken]
-	Log       print.Logger
-	RemoteURL giturl.Parts
+	APIToken      func() Option[configdomain.GitHubToken]
+	APIURL        Option[string] // base URL of the API, derived from the remote URL if not given
+	HTTPClient    *http.Client
+	Log           print.Logger
+	PushRemoteURL Option[giturl.Parts]
+	RemoteURL     giturl.Parts
+}
+
+// GraphQLRequest is the body of requests to GitHub's GraphQL API.
+type GraphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+// GraphQLResponse contains the parts of the responses of GitHub's GraphQL API that Git Town uses.
+type GraphQLResponse struct {
+	Data   GraphQLData    `json:"data"`
+	Errors []GraphQLError `json:"errors"`
+}
+
+// GraphQLData contains the data that the GraphQL queries of Git Town load.
+type GraphQLData struct {
+	Repository GraphQLRepository `json:"repository"`
+}
+
+// GraphQLRepository contains the data that the GraphQL queries of Git Town load about a repository.
+type GraphQLRepository struct {
+	PullRequest GraphQLPullRequest `json:"pullRequest"`
+}
+
+// GraphQLPullRequest contains the review state of a pull request.
+type GraphQLPullRequest struct {
+	LatestReviews GraphQLReviews `json:"latestReviews"`
+	// APPROVED, CHANGES_REQUESTED, or REVIEW_REQUIRED,
+	// empty if neither branch protection nor a code owner requires reviews
+	ReviewDecision string `json:"reviewDecision"`
+}
+
+// GraphQLReviews contains the latest review of each reviewer of a pull request.
+type GraphQLReviews struct {
+	Nodes []GraphQLReview `json:"nodes"`
+}
+
+// GraphQLReview contains the state of a review: APPROVED, CHANGES_REQUESTED, COMMENTED, DISMISSED, or PENDING.
+type GraphQLReview struct {
+	State string `json:"state"`
+}
+
+// GraphQLError describes an error reported by GitHub's GraphQL API.
+type GraphQLError struct {
+	Message string `json:"message"`
+}
+
+// ParseChecks provides the combined state of the given commit statuses and check runs.
+func ParseChecks(combinedStatus *github.CombinedStatus, checkRuns []*github.CheckRun) hostingdomain.ProposalChecks {
+	checks := []hostingdomain.ProposalChecks{}
+	if combinedStatus.GetTotalCount() > 0 {
+		switch combinedStatus.GetState() {
+		case "success":
+			checks = append(checks, hostingdomain.ProposalChecksSuccess)
+		case "pending":
+			checks = append(checks, hostingdomain.ProposalChecksPending)
+		default:
+			checks = append(checks, hostingdomain.ProposalChecksFailure)
+		}
+	}
+	for _, checkRun := range checkRuns {
+		switch {
+		case checkRun.GetStatus() != "completed":
+			checks = append(checks, hostingdomain.ProposalChecksPending)
+		case slices.Contains([]string{"success", "neutral", "skipped"}, checkRun.GetConclusion()):
+			checks = append(checks, hostingdomain.ProposalChecksSuccess)
+		default:
+			checks = append(checks, hostingdomain.ProposalChecksFailure)
+		}
+	}
+	return hostingdomain.CombineProposalChecks(checks)
+}
+
+// ParseProposalSource provides the location of the branch that the given pull request merges.
+// Pull requests from forks have a head repository that differs from their base repository.
+func ParseProposalSource(pullRequest *github.PullRequest) hostingdomain.ProposalSource {
+	head := pullRequest.GetHead()
+	fork := None[hostingdomain.ProposalFork]()
+	if headRepo := head.GetRepo(); headRepo != nil && headRepo.GetFullName() != pullRequest.GetBase().GetRepo().GetFullName() {
+		fork = Some(hostingdomain.ProposalFork{
+			CloneURL: headRepo.GetCloneURL(),
+			Owner:    headRepo.GetOwner().GetLogin(),
+		})
+	}
+	return hostingdomain.ProposalSource{
+		Branch: gitdomain.NewLocalBranchName(head.GetRef()),
+		Fork:   fork,
+	}
+}
+
+// ParseReviewers provides the request to add the given reviewers to a pull request.
+// Reviewers in the form "org/team" are teams, all others are users.
+func ParseReviewers(reviewers []string) github.ReviewersRequest {
+	result := github.ReviewersRequest{
+		NodeID:        nil,
+		Reviewers:     []string{},
+		TeamReviewers: []string{},
+	}
+	for _, reviewer := range reviewers {
+		if _, team, isTeam := strings.Cut(reviewer, "/"); isTeam {
+			result.TeamReviewers = append(result.TeamReviewers, team)
+		} else {
+			result.Reviewers = append(result.Reviewers, reviewer)
+		}
+	}
+	return result
+}
+
+// ParseReviews provides the review state of the given pull request.
+// GitHub's review decision tells whether the pull request has the reviews that branch protection requires.
+// Without required reviews, the latest reviews of the individual reviewers determine the state.
+func ParseReviews(pullRequest GraphQLPullRequest) hostingdomain.ProposalReview {
+	switch pullRequest.ReviewDecision {
+	case "APPROVED":
+		return hostingdomain.ProposalReviewApproved
+	case "CHANGES_REQUESTED":
+		return hostingdomain.ProposalReviewChangesRequested
+	case "REVIEW_REQUIRED":
+		return hostingdomain.ProposalReviewRequired
+	}
+	states := []hostingdomain.ProposalReview{}
+	for _, review := range pullRequest.LatestReviews.Nodes {
+		switch review.State {
+		case "APPROVED":
+			states = append(states, hostingdomain.ProposalReviewApproved)
+		case "CHANGES_REQUESTED":
+			states = append(states, hostingdomain.ProposalReviewChangesRequested)
+		}
+	}
+	return hostingdomain.CombineProposalReviews(states, false)
 }
 
 // parsePullRequest extracts standardized proposal data from the given GitHub pull-request.
 func parsePullRequest(pullRequest *github.PullRequest) hostingdomain.Proposal {
 	return hostingdomain.Proposal{
+		Body:         gitdomain.ProposalBody(pullRequest.GetBody()),
+		Checks:       hostingdomain.ProposalChecksUnknown,
+		Draft:        pullRequest.GetDraft(),
+		MergeWithAPI: pullRequest.GetMergeableState() == "clean",
+		Mergeable:    SomeP(pullRequest.Mergeable).ToOption(),
 		Number:       pullRequest.GetNumber(),
+		Review:       hostingdomain.ProposalReviewUnknown,
 		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.GetRef()),
 		Title:        pullRequest.GetTitle(),
-		MergeWithAPI: pullRequest.GetMergeableState() == "clean",
 		URL:          *pullRequest.HTMLURL,
 	}
 }
@@ -1,6 +1,8 @@
 package github_test
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/git-town/git-town/v16/internal/cli/print"
@@ -9,6 +11,8 @@ import (
 	"github.com/git-town/git-town/v16/internal/git/giturl"
 	"github.com/git-town/git-town/v16/internal/hosting/github"
 	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+	githubsdk "github.com/google/go-github/v58/github"
 	"github.com/shoenig/test/must"
 )
 
@@ -30,11 +34,12 @@ func TestConnector(t *testing.T) {
 	t.Run("NewProposalURL", func(t *testing.T) {
 		t.Parallel()
 		tests := map[string]struct {
-			branch gitdomain.LocalBranchName
-			parent gitdomain.LocalBranchName
-			title  gitdomain.ProposalTitle
-			body   gitdomain.ProposalBody
-			want   string
+			branch   gitdomain.LocalBranchName
+			parent   gitdomain.LocalBranchName
+			title    gitdomain.ProposalTitle
+			body     gitdomain.ProposalBody
+			metadata hostingdomain.ProposalMetadata
+			want     string
 		}{
 			"top-level branch": {
 				branch: gitdomain.NewLocalBranchName("feature"),
@@ -78,6 +83,16 @@ func TestConnector(t *testing.T) {
 				body:   "my body",
 				want:   "https://github.com/organization/repo/compare/feature-%23?expand=1&body=my+body",
 			},
+			"provide labels and assignees": {
+				branch: gitdomain.NewLocalBranchName("feature"),
+				parent: gitdomain.NewLocalBranchName("main"),
+				metadata: hostingdomain.ProposalMetadata{
+					Assignees: []string{"alice", "bob"},
+					Labels:    []string{"bug", "needs review"},
+					Reviewers: []string{"carol"},
+				},
+				want: "https://github.com/organization/repo/compare/feature?expand=1&labels=bug%2Cneeds+review&assignees=alice%2Cbob",
+			},
 		}
 		for name, tt := range tests {
 			t.Run(name, func(t *testing.T) {
@@ -88,9 +103,9 @@ func TestConnector(t *testing.T) {
 						Organization: "organization",
 						Repository:   "repo",
 					},
-					APIToken: configdomain.ParseGitHubToken("apiToken"),
+					APIToken: func() Option[configdomain.GitHubToken] { return configdomain.ParseGitHubToken("apiToken") },
 				}
-				have, err := connector.NewProposalURL(tt.branch, tt.parent, main, tt.title, tt.body)
+				have, err := connector.NewProposalURL(tt.branch, tt.parent, main, tt.title, tt.body, tt.metadata)
 				must.NoError(t, err)
 				must.EqOp(t, tt.want, have)
 			})
@@ -112,6 +127,48 @@ func TestConnector(t *testing.T) {
 	})
 }
 
+func TestCreateProposal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("problems applying the metadata don't fail the proposal", func(t *testing.T) {
+		t.Parallel()
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			writer.Header().Set("Content-Type", "application/json")
+			switch {
+			case request.Method == http.MethodPost && request.URL.Path == "/api/v3/repos/git-town/docs/pulls":
+				writer.WriteHeader(http.StatusCreated)
+				_, _ = writer.Write([]byte(`{"number": 1, "title": "my title", "mergeable": true, "html_url": "https://github.com/git-town/docs/pull/1", "base": {"ref": "main"}, "head": {"ref": "feature"}}`))
+			case request.Method == http.MethodPost && request.URL.Path == "/api/v3/repos/git-town/docs/issues/1/labels":
+				writer.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = writer.Write([]byte(`{"message": "Validation Failed"}`))
+			default:
+				writer.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+		remoteURL, has := giturl.Parse("git@github.com:git-town/docs.git").Get()
+		must.True(t, has)
+		connector, err := github.NewConnector(github.NewConnectorArgs{
+			APIToken:      func() Option[configdomain.GitHubToken] { return configdomain.ParseGitHubToken("apiToken") },
+			APIURL:        Some(server.URL),
+			HTTPClient:    server.Client(),
+			Log:           print.QuietLogger(),
+			PushRemoteURL: None[giturl.Parts](),
+			RemoteURL:     remoteURL,
+		})
+		must.NoError(t, err)
+		metadata := hostingdomain.ProposalMetadata{
+			Assignees: []string{},
+			Draft:     false,
+			Labels:    []string{"unknown"},
+			Reviewers: []string{},
+		}
+		have, err := connector.CreateProposal("feature", "main", "my title", "", metadata)
+		must.NoError(t, err)
+		must.EqOp(t, 1, have.Number)
+	})
+}
+
 func TestNewConnector(t *testing.T) {
 	t.Parallel()
 
@@ -120,9 +177,12 @@ func TestNewConnector(t *testing.T) {
 		remoteURL, has := giturl.Parse("git@github.com:git-town/docs.git").Get()
 		must.True(t, has)
 		have, err := github.NewConnector(github.NewConnectorArgs{
-			APIToken:  configdomain.ParseGitHubToken("apiToken"),
-			Log:       print.Logger{},
-			RemoteURL: remoteURL,
+			APIToken:      func() Option[configdomain.GitHubToken] { return configdomain.ParseGitHubToken("apiToken") },
+			APIURL:        None[string](),
+			HTTPClient:    http.DefaultClient,
+			Log:           print.Logger{},
+			PushRemoteURL: None[giturl.Parts](),
+			RemoteURL:     remoteURL,
 		})
 		must.NoError(t, err)
 		wantConfig := hostingdomain.Data{
@@ -138,9 +198,12 @@ func TestNewConnector(t *testing.T) {
 		remoteURL, has := giturl.Parse("git@custom-url.com:git-town/docs.git").Get()
 		must.True(t, has)
 		have, err := github.NewConnector(github.NewConnectorArgs{
-			APIToken:  configdomain.ParseGitHubToken("apiToken"),
-			Log:       print.Logger{},
-			RemoteURL: remoteURL,
+			APIToken:      func() Option[configdomain.GitHubToken] { return configdomain.ParseGitHubToken("apiToken") },
+			APIURL:        None[string](),
+			HTTPClient:    http.DefaultClient,
+			Log:           print.Logger{},
+			PushRemoteURL: None[giturl.Parts](),
+			RemoteURL:     remoteURL,
 		})
 		must.NoError(t, err)
 		wantConfig := hostingdomain.Data{
@@ -150,4 +213,174 @@ func TestNewConnector(t *testing.T) {
 		}
 		must.EqOp(t, wantConfig, have.Data)
 	})
+
+	t.Run("pushing to a fork", func(t *testing.T) {
+		t.Parallel()
+		remoteURL, has := giturl.Parse("git@github.com:git-town/docs.git").Get()
+		must.True(t, has)
+		connector, err := github.NewConnector(github.NewConnectorArgs{
+			APIToken:      func() Option[configdomain.GitHubToken] { return configdomain.ParseGitHubToken("apiToken") },
+			APIURL:        None[string](),
+			HTTPClient:    http.DefaultClient,
+			Log:           print.Logger{},
+			PushRemoteURL: giturl.Parse("git@github.com:alice/docs.git"),
+			RemoteURL:     remoteURL,
+		})
+		must.NoError(t, err)
+		main := gitdomain.NewLocalBranchName("main")
+		have, err := connector.NewProposalURL("feature", main, main, "", "", hostingdomain.ProposalMetadata{})
+		must.NoError(t, err)
+		must.EqOp(t, "https://github.com/git-town/docs/compare/main...alice:feature?expand=1", have)
+	})
+}
+
+func TestParseChecks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no statuses and check runs", func(t *testing.T) {
+		t.Parallel()
+		have := github.ParseChecks(&githubsdk.CombinedStatus{TotalCount: githubsdk.Int(0)}, []*githubsdk.CheckRun{})
+		must.EqOp(t, hostingdomain.ProposalChecksNone, have)
+	})
+
+	t.Run("successful statuses and running check run", func(t *testing.T) {
+		t.Parallel()
+		combinedStatus := githubsdk.CombinedStatus{State: githubsdk.String("success"), TotalCount: githubsdk.Int(1)}
+		checkRuns := []*githubsdk.CheckRun{
+			{Status: githubsdk.String("in_progress")},
+		}
+		have := github.ParseChecks(&combinedStatus, checkRuns)
+		must.EqOp(t, hostingdomain.ProposalChecksPending, have)
+	})
+
+	t.Run("failed check run", func(t *testing.T) {
+		t.Parallel()
+		checkRuns := []*githubsdk.CheckRun{
+			{Status: githubsdk.String("completed"), Conclusion: githubsdk.String("skipped")},
+			{Status: githubsdk.String("completed"), Conclusion: githubsdk.String("failure")},
+		}
+		have := github.ParseChecks(&githubsdk.CombinedStatus{TotalCount: githubsdk.Int(0)}, checkRuns)
+		must.EqOp(t, hostingdomain.ProposalChecksFailure, have)
+	})
+}
+
+func TestParseProposalSource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("branch in the same repository", func(t *testing.T) {
+		t.Parallel()
+		pullRequest := &githubsdk.PullRequest{
+			Base: &githubsdk.PullRequestBranch{Ref: githubsdk.String("main"), Repo: &githubsdk.Repository{FullName: githubsdk.String("git-town/git-town")}},
+			Head: &githubsdk.PullRequestBranch{Ref: githubsdk.String("feature"), Repo: &githubsdk.Repository{FullName: githubsdk.String("git-town/git-town")}},
+		}
+		have := github.ParseProposalSource(pullRequest)
+		want := hostingdomain.ProposalSource{
+			Branch: "feature",
+			Fork:   None[hostingdomain.ProposalFork](),
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("branch in a fork", func(t *testing.T) {
+		t.Parallel()
+		pullRequest := &githubsdk.PullRequest{
+			Base: &githubsdk.PullRequestBranch{Ref: githubsdk.String("main"), Repo: &githubsdk.Repository{FullName: githubsdk.String("git-town/git-town")}},
+			Head: &githubsdk.PullRequestBranch{Ref: githubsdk.String("feature"), Repo: &githubsdk.Repository{
+				CloneURL: githubsdk.String("https://github.com/alice/git-town.git"),
+				FullName: githubsdk.String("alice/git-town"),
+				Owner:    &githubsdk.User{Login: githubsdk.String("alice")},
+			}},
+		}
+		have := github.ParseProposalSource(pullRequest)
+		want := hostingdomain.ProposalSource{
+			Branch: "feature",
+			Fork: Some(hostingdomain.ProposalFork{
+				CloneURL: "https://github.com/alice/git-town.git",
+				Owner:    "alice",
+			}),
+		}
+		must.Eq(t, want, have)
+	})
+}
+
+func TestParseReviewers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("users and teams", func(t *testing.T) {
+		t.Parallel()
+		have := github.ParseReviewers([]string{"alice", "org/team", "bob"})
+		must.Eq(t, []string{"alice", "bob"}, have.Reviewers)
+		must.Eq(t, []string{"team"}, have.TeamReviewers)
+	})
+
+	t.Run("no reviewers", func(t *testing.T) {
+		t.Parallel()
+		have := github.ParseReviewers([]string{})
+		must.Eq(t, []string{}, have.Reviewers)
+		must.Eq(t, []string{}, have.TeamReviewers)
+	})
+}
+
+func TestParseReviews(t *testing.T) {
+	t.Parallel()
+
+	t.Run("branch protection requires reviews", func(t *testing.T) {
+		t.Parallel()
+		pullRequest := github.GraphQLPullRequest{
+			LatestReviews: github.GraphQLReviews{Nodes: []github.GraphQLReview{
+				{State: "APPROVED"},
+			}},
+			ReviewDecision: "REVIEW_REQUIRED",
+		}
+		have := github.ParseReviews(pullRequest)
+		must.EqOp(t, hostingdomain.ProposalReviewRequired, have)
+	})
+
+	t.Run("approved as required by branch protection", func(t *testing.T) {
+		t.Parallel()
+		pullRequest := github.GraphQLPullRequest{
+			LatestReviews: github.GraphQLReviews{Nodes: []github.GraphQLReview{
+				{State: "APPROVED"},
+				{State: "COMMENTED"},
+			}},
+			ReviewDecision: "APPROVED",
+		}
+		have := github.ParseReviews(pullRequest)
+		must.EqOp(t, hostingdomain.ProposalReviewApproved, have)
+	})
+
+	t.Run("changes requested without required reviews", func(t *testing.T) {
+		t.Parallel()
+		pullRequest := github.GraphQLPullRequest{
+			LatestReviews: github.GraphQLReviews{Nodes: []github.GraphQLReview{
+				{State: "APPROVED"},
+				{State: "CHANGES_REQUESTED"},
+			}},
+			ReviewDecision: "",
+		}
+		have := github.ParseReviews(pullRequest)
+		must.EqOp(t, hostingdomain.ProposalReviewChangesRequested, have)
+	})
+
+	t.Run("approved without required reviews", func(t *testing.T) {
+		t.Parallel()
+		pullRequest := github.GraphQLPullRequest{
+			LatestReviews: github.GraphQLReviews{Nodes: []github.GraphQLReview{
+				{State: "APPROVED"},
+			}},
+			ReviewDecision: "",
+		}
+		have := github.ParseReviews(pullRequest)
+		must.EqOp(t, hostingdomain.ProposalReviewApproved, have)
+	})
+
+	t.Run("no reviews and no required reviews", func(t *testing.T) {
+		t.Parallel()
+		pullRequest := github.GraphQLPullRequest{
+			LatestReviews:  github.GraphQLReviews{Nodes: []github.GraphQLReview{}},
+			ReviewDecision: "",
+		}
+		have := github.ParseReviews(pullRequest)
+		must.EqOp(t, hostingdomain.ProposalReviewNone, have)
+	})
 }
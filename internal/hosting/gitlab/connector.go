@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/git-town/git-town/v16/internal/cli/print"
 	"github.com/git-town/git-town/v16/internal/config/configdomain"
@@ -12,9 +13,18 @@ import (
 	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
 	"github.com/git-town/git-town/v16/internal/messages"
 	. "github.com/git-town/git-town/v16/pkg/prelude"
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/xanzy/go-gitlab"
 )
 
+const (
+	rebaseStatusInterval = time.Second      // how often to check whether GitLab has finished rebasing a merge request
+	rebaseTimeout        = 30 * time.Second // how long to wait for GitLab to finish rebasing a merge request
+)
+
+// ProposalTemplatePath is the location of the default merge request template in repositories hosted on GitLab.
+const ProposalTemplatePath = ".gitlab/merge_request_templates/Default.md"
+
 // Connector provides standardized connectivity for the given repository (gitlab.com/owner/repo)
 // via the GitLab API.
 type Connector struct {
@@ -23,6 +33,112 @@ type Connector struct {
 	log print.Logger
 }
 
+func (self Connector) CreateProposal(branch, target gitdomain.LocalBranchName, title gitdomain.ProposalTitle, body gitdomain.ProposalBody, metadata hostingdomain.ProposalMetadata) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingGitlabCreateMRViaAPI, branch, target)
+	mergeRequestTitle := title.String()
+	if metadata.Draft {
+		mergeRequestTitle = draftPrefix + mergeRequestTitle
+	}
+	options := gitlab.CreateMergeRequestOptions{
+		Description:  gitlab.Ptr(body.String()),
+		SourceBranch: gitlab.Ptr(branch.String()),
+		TargetBranch: gitlab.Ptr(target.String()),
+		Title:        gitlab.Ptr(mergeRequestTitle),
+	}
+	if len(metadata.Labels) > 0 {
+		options.Labels = gitlab.Ptr(gitlab.LabelOptions(metadata.Labels))
+	}
+	if len(metadata.Assignees) > 0 {
+		assigneeIDs, err := self.userIDs(metadata.Assignees)
+		if err != nil {
+			self.log.Failed(err)
+			return hostingdomain.Proposal{}, err
+		}
+		options.AssigneeIDs = &assigneeIDs
+	}
+	if len(metadata.Reviewers) > 0 {
+		reviewerIDs, err := self.userIDs(metadata.Reviewers)
+		if err != nil {
+			self.log.Failed(err)
+			return hostingdomain.Proposal{}, err
+		}
+		options.ReviewerIDs = &reviewerIDs
+	}
+	mergeRequest, _, err := self.client.MergeRequests.CreateMergeRequest(self.projectPath(), &options)
+	if err != nil {
+		self.log.Failed(err)
+		return hostingdomain.Proposal{}, err
+	}
+	self.log.Success()
+	return parseMergeRequest(mergeRequest), nil
+}
+
+func (self Connector) EnableAutoMerge(number int, method hostingdomain.ProposalMergeMethod, message gitdomain.CommitMessage, deleteBranch bool) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	options := gitlab.AcceptMergeRequestOptions{
+		MergeWhenPipelineSucceeds: gitlab.Ptr(true),
+		ShouldRemoveSourceBranch:  gitlab.Ptr(deleteBranch),
+		Squash:                    gitlab.Ptr(method == hostingdomain.ProposalMergeMethodSquash),
+	}
+	switch method {
+	case hostingdomain.ProposalMergeMethodMerge:
+		if message != "" {
+			options.MergeCommitMessage = gitlab.Ptr(message.String())
+		}
+	case hostingdomain.ProposalMergeMethodRebase:
+		self.log.Start(messages.HostingGitlabRebasingViaAPI, number)
+		err := self.rebaseMergeRequest(number)
+		if err != nil {
+			self.log.Failed(err)
+			return err
+		}
+		self.log.Success()
+	case hostingdomain.ProposalMergeMethodSquash:
+		if message != "" {
+			options.SquashCommitMessage = gitlab.Ptr(message.String())
+		}
+	}
+	self.log.Start(messages.HostingGitlabEnableAutoMergeViaAPI, number)
+	_, _, err := self.client.MergeRequests.AcceptMergeRequest(self.projectPath(), number, &options)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self Connector) FindMergedProposals(branch, target gitdomain.LocalBranchName) ([]hostingdomain.MergedProposal, error) {
+	self.log.Start(messages.APIMergedProposalLookupStart)
+	result := []hostingdomain.MergedProposal{}
+	if len(hostingdomain.ReadProposalOverride()) > 0 {
+		// test runs simulate only open proposals
+		self.log.Success()
+		return result, nil
+	}
+	mergeRequests, _, err := self.client.MergeRequests.ListProjectMergeRequests(self.projectPath(), &gitlab.ListProjectMergeRequestsOptions{
+		State:        gitlab.Ptr("merged"),
+		SourceBranch: gitlab.Ptr(branch.String()),
+		TargetBranch: gitlab.Ptr(target.String()),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return result, err
+	}
+	self.log.Success()
+	for _, mergeRequest := range mergeRequests {
+		if headSHA, hasHeadSHA := gitdomain.NewSHAOption(mergeRequest.SHA).Get(); hasHeadSHA {
+			result = append(result, hostingdomain.MergedProposal{
+				HeadSHA: headSHA,
+				Number:  mergeRequest.IID,
+			})
+		}
+	}
+	return result, nil
+}
+
 func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
 	self.log.Start(messages.APIProposalLookupStart)
 	proposalURLOverride := hostingdomain.ReadProposalOverride()
@@ -32,8 +148,13 @@ func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Op
 			return None[hostingdomain.Proposal](), nil
 		}
 		return Some(hostingdomain.Proposal{
+			Body:         "",
+			Checks:       hostingdomain.ProposalChecksUnknown,
+			Draft:        false,
 			MergeWithAPI: true,
+			Mergeable:    None[bool](),
 			Number:       123,
+			Review:       hostingdomain.ProposalReviewUnknown,
 			Target:       target,
 			Title:        "title",
 			URL:          proposalURLOverride,
@@ -60,6 +181,120 @@ func (self Connector) FindProposal(branch, target gitdomain.LocalBranchName) (Op
 	}
 }
 
+func (self Connector) LoadProposal(number int) (Option[hostingdomain.ProposalWithSource], error) {
+	self.log.Start(messages.APIProposalLoadStart, number)
+	mergeRequest, response, err := self.client.MergeRequests.GetMergeRequest(self.projectPath(), number, nil)
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		self.log.Success()
+		return None[hostingdomain.ProposalWithSource](), nil
+	}
+	if err != nil {
+		self.log.Failed(err)
+		return None[hostingdomain.ProposalWithSource](), err
+	}
+	source := hostingdomain.ProposalSource{
+		Branch: gitdomain.NewLocalBranchName(mergeRequest.SourceBranch),
+		Fork:   None[hostingdomain.ProposalFork](),
+	}
+	// merge requests from forks originate in a different project than the one they target
+	if mergeRequest.SourceProjectID != mergeRequest.TargetProjectID {
+		project, _, err := self.client.Projects.GetProject(mergeRequest.SourceProjectID, nil)
+		if err != nil {
+			self.log.Failed(err)
+			return None[hostingdomain.ProposalWithSource](), err
+		}
+		source.Fork = Some(parseFork(project))
+	}
+	self.log.Success()
+	return Some(hostingdomain.ProposalWithSource{
+		Proposal: parseMergeRequest(mergeRequest),
+		Source:   source,
+	}), nil
+}
+
+func (self Connector) LoadProposalStatus(proposal hostingdomain.Proposal) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.APIProposalStatusLoadStart, proposal.Number)
+	if len(hostingdomain.ReadProposalOverride()) > 0 {
+		// test runs simulate proposals only via FindProposal
+		self.log.Success()
+		return proposal, nil
+	}
+	mergeRequest, _, err := self.client.MergeRequests.GetMergeRequest(self.projectPath(), proposal.Number, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return proposal, err
+	}
+	approvals, _, err := self.client.MergeRequestApprovals.GetConfiguration(self.projectPath(), proposal.Number)
+	if err != nil {
+		self.log.Failed(err)
+		return proposal, err
+	}
+	self.log.Success()
+	result := parseMergeRequest(mergeRequest)
+	result.Checks = parsePipeline(mergeRequest.HeadPipeline)
+	result.Review = parseApprovals(mergeRequest, approvals)
+	return result, nil
+}
+
+func (self Connector) MergeProposal(number int, message gitdomain.CommitMessage) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	options := gitlab.AcceptMergeRequestOptions{
+		Squash: gitlab.Ptr(false),
+		// the branch will be deleted by Git Town
+		ShouldRemoveSourceBranch: gitlab.Ptr(false),
+	}
+	if message != "" {
+		options.MergeCommitMessage = gitlab.Ptr(message.String())
+	}
+	return self.acceptMergeRequest(number, &options)
+}
+
+func (self Connector) RebaseMergeProposal(number int) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingGitlabRebasingViaAPI, number)
+	err := self.rebaseMergeRequest(number)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return self.acceptMergeRequest(number, &gitlab.AcceptMergeRequestOptions{
+		Squash: gitlab.Ptr(false),
+		// the branch will be deleted by Git Town
+		ShouldRemoveSourceBranch: gitlab.Ptr(false),
+	})
+}
+
+func (self Connector) SearchProposal(branch gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
+	self.log.Start(messages.APIProposalLookupStart)
+	if proposalURLOverride := hostingdomain.ReadProposalOverride(); len(proposalURLOverride) > 0 {
+		// the simulated proposal doesn't know which branch it targets
+		self.log.Success()
+		return hostingdomain.OverriddenProposal(proposalURLOverride, ""), nil
+	}
+	mergeRequests, _, err := self.client.MergeRequests.ListProjectMergeRequests(self.projectPath(), &gitlab.ListProjectMergeRequestsOptions{
+		State:        gitlab.Ptr("opened"),
+		SourceBranch: gitlab.Ptr(branch.String()),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return None[hostingdomain.Proposal](), err
+	}
+	self.log.Success()
+	switch len(mergeRequests) {
+	case 0:
+		return None[hostingdomain.Proposal](), nil
+	case 1:
+		return Some(parseMergeRequest(mergeRequests[0])), nil
+	default:
+		return None[hostingdomain.Proposal](), fmt.Errorf(messages.ProposalMultipleFromBranch, len(mergeRequests), branch)
+	}
+}
+
 func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) error {
 	if number <= 0 {
 		return errors.New(messages.ProposalNoNumberGiven)
@@ -80,6 +315,24 @@ func (self Connector) SquashMergeProposal(number int, message gitdomain.CommitMe
 	return nil
 }
 
+func (self Connector) UpdateProposalBody(number int, body gitdomain.ProposalBody) error {
+	self.log.Start(messages.APIProposalBodyUpdateStart, number)
+	if len(hostingdomain.ReadProposalOverride()) > 0 {
+		// test runs simulate proposals only via FindProposal
+		self.log.Success()
+		return nil
+	}
+	_, _, err := self.client.MergeRequests.UpdateMergeRequest(self.projectPath(), number, &gitlab.UpdateMergeRequestOptions{
+		Description: gitlab.Ptr(body.String()),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
 func (self Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
 	self.log.Start(messages.HostingGitlabUpdateMRViaAPI, number, target)
 	_, _, err := self.client.MergeRequests.UpdateMergeRequest(self.projectPath(), number, &gitlab.UpdateMergeRequestOptions{
@@ -93,20 +346,85 @@ func (self Connector) UpdateProposalTarget(number int, target gitdomain.LocalBra
 	return nil
 }
 
+// acceptMergeRequest merges the merge request with the given number using the given options.
+func (self Connector) acceptMergeRequest(number int, options *gitlab.AcceptMergeRequestOptions) error {
+	self.log.Start(messages.HostingGitlabMergingViaAPI, number)
+	_, _, err := self.client.MergeRequests.AcceptMergeRequest(self.projectPath(), number, options)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// rebaseMergeRequest rebases the merge request with the given number onto its target branch.
+// GitLab rebases asynchronously, so this waits until the rebase is finished
+// and prints a progress indicator while it waits.
+func (self Connector) rebaseMergeRequest(number int) error {
+	_, err := self.client.MergeRequests.RebaseMergeRequest(self.projectPath(), number, nil)
+	if err != nil {
+		return err
+	}
+	deadline := time.Now().Add(rebaseTimeout)
+	for {
+		mergeRequest, _, err := self.client.MergeRequests.GetMergeRequest(self.projectPath(), number, &gitlab.GetMergeRequestsOptions{
+			IncludeRebaseInProgress: gitlab.Ptr(true),
+		})
+		if err != nil {
+			return err
+		}
+		if !mergeRequest.RebaseInProgress {
+			if mergeRequest.MergeError != "" {
+				return errors.New(mergeRequest.MergeError)
+			}
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf(messages.HostingGitlabRebaseTimeout, number, rebaseTimeout)
+		}
+		self.log.Progress()
+		time.Sleep(rebaseStatusInterval)
+	}
+}
+
+// userIDs provides the IDs of the GitLab users with the given usernames.
+// GitLab's API for creating merge requests accepts users only by ID.
+func (self Connector) userIDs(usernames []string) ([]int, error) {
+	result := make([]int, len(usernames))
+	for u, username := range usernames {
+		users, _, err := self.client.Users.ListUsers(&gitlab.ListUsersOptions{
+			Username: gitlab.Ptr(username),
+		})
+		if err != nil {
+			return result, err
+		}
+		if len(users) == 0 {
+			return result, fmt.Errorf(messages.HostingGitlabUserNotFound, username)
+		}
+		result[u] = users[0].ID
+	}
+	return result, nil
+}
+
 // NewGitlabConfig provides GitLab configuration data if the current repo is hosted on GitLab,
 // otherwise nil.
 func NewConnector(args NewConnectorArgs) (Connector, error) {
 	gitlabData := Data{
-		APIToken: args.APIToken,
 		Data: hostingdomain.Data{
 			Hostname:     args.RemoteURL.Host,
 			Organization: args.RemoteURL.Org,
 			Repository:   args.RemoteURL.Repo,
 		},
 	}
-	clientOptFunc := gitlab.WithBaseURL(gitlabData.baseURL())
-	httpClient := gitlab.WithHTTPClient(&http.Client{}) //exhaustruct:ignore
-	client, err := gitlab.NewOAuthClient(gitlabData.APIToken.String(), httpClient, clientOptFunc)
+	clientOptFunc := gitlab.WithBaseURL(args.APIURL.GetOrElse(gitlabData.baseURL()))
+	httpClient := gitlab.WithHTTPClient(args.HTTPClient)
+	// determine the API token only when a request needs it
+	authorization := gitlab.WithRequestOptions(func(request *retryablehttp.Request) error {
+		request.Header.Set("Authorization", "Bearer "+args.APIToken().String())
+		return nil
+	})
+	client, err := gitlab.NewOAuthClient("", httpClient, clientOptFunc, authorization)
 	if err != nil {
 		return Connector{}, err
 	}
@@ -119,17 +437,74 @@ func NewConnector(args NewConnectorArgs) (Connector, error) {
 }
 
 type NewConnectorArgs struct {
-	APIToken  Option[configdomain.GitLabToken]
-	Log       print.Logger
-	RemoteURL giturl.Parts
+	APIToken   func() Option[configdomain.GitLabToken]
+	APIURL     Option[string] // base URL of the API, derived from the remote URL if not given
+	HTTPClient *http.Client
+	Log        print.Logger
+	RemoteURL  giturl.Parts
 }
 
 func parseMergeRequest(mergeRequest *gitlab.MergeRequest) hostingdomain.Proposal {
 	return hostingdomain.Proposal{
+		Body:         gitdomain.ProposalBody(mergeRequest.Description),
+		Checks:       hostingdomain.ProposalChecksUnknown,
+		Draft:        mergeRequest.Draft,
 		MergeWithAPI: true,
+		Mergeable:    parseMergeable(mergeRequest),
 		Number:       mergeRequest.IID,
+		Review:       hostingdomain.ProposalReviewUnknown,
 		Target:       gitdomain.NewLocalBranchName(mergeRequest.TargetBranch),
 		Title:        mergeRequest.Title,
 		URL:          mergeRequest.WebURL,
 	}
 }
+
+// parseApprovals provides the review state of the given merge request with the given approvals.
+func parseApprovals(mergeRequest *gitlab.MergeRequest, approvals *gitlab.MergeRequestApprovals) hostingdomain.ProposalReview {
+	reviews := []hostingdomain.ProposalReview{}
+	if mergeRequest.DetailedMergeStatus == "requested_changes" {
+		reviews = append(reviews, hostingdomain.ProposalReviewChangesRequested)
+	}
+	if len(approvals.ApprovedBy) > 0 {
+		reviews = append(reviews, hostingdomain.ProposalReviewApproved)
+	}
+	return hostingdomain.CombineProposalReviews(reviews, approvals.ApprovalsLeft > 0)
+}
+
+// parseFork provides the fork described by the given project.
+func parseFork(project *gitlab.Project) hostingdomain.ProposalFork {
+	owner := ""
+	if project.Namespace != nil {
+		owner = project.Namespace.Path
+	}
+	return hostingdomain.ProposalFork{
+		CloneURL: project.HTTPURLToRepo,
+		Owner:    owner,
+	}
+}
+
+// parseMergeable indicates whether the given merge request can be merged without conflicts.
+func parseMergeable(mergeRequest *gitlab.MergeRequest) Option[bool] {
+	switch mergeRequest.DetailedMergeStatus {
+	case "", "checking", "unchecked", "preparing":
+		// GitLab hasn't determined mergeability yet
+		return None[bool]()
+	default:
+		return Some(!mergeRequest.HasConflicts)
+	}
+}
+
+// parsePipeline provides the state of the CI checks represented by the given pipeline.
+func parsePipeline(pipeline *gitlab.Pipeline) hostingdomain.ProposalChecks {
+	if pipeline == nil {
+		return hostingdomain.ProposalChecksNone
+	}
+	switch pipeline.Status {
+	case "success", "skipped":
+		return hostingdomain.ProposalChecksSuccess
+	case "failed", "canceled":
+		return hostingdomain.ProposalChecksFailure
+	default:
+		return hostingdomain.ProposalChecksPending
+	}
+}
@@ -1,6 +1,10 @@
 package gitlab_test
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/git-town/git-town/v16/internal/cli/print"
@@ -9,6 +13,7 @@ import (
 	"github.com/git-town/git-town/v16/internal/git/giturl"
 	"github.com/git-town/git-town/v16/internal/hosting/gitlab"
 	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
 	"github.com/shoenig/test/must"
 )
 
@@ -23,7 +28,6 @@ func TestGitlabConnector(t *testing.T) {
 				Organization: "",
 				Repository:   "",
 			},
-			APIToken: configdomain.ParseGitLabToken(""),
 		}
 		give := hostingdomain.Proposal{
 			Number:       1,
@@ -40,9 +44,12 @@ func TestGitlabConnector(t *testing.T) {
 		t.Parallel()
 		main := gitdomain.NewLocalBranchName("main")
 		tests := map[string]struct {
-			branch gitdomain.LocalBranchName
-			parent gitdomain.LocalBranchName
-			want   string
+			branch   gitdomain.LocalBranchName
+			parent   gitdomain.LocalBranchName
+			title    gitdomain.ProposalTitle
+			body     gitdomain.ProposalBody
+			metadata hostingdomain.ProposalMetadata
+			want     string
 		}{
 			"top-level branch": {
 				branch: gitdomain.NewLocalBranchName("feature"),
@@ -59,12 +66,28 @@ func TestGitlabConnector(t *testing.T) {
 				parent: main,
 				want:   "https://gitlab.com/organization/repo/-/merge_requests/new?merge_request%5Bsource_branch%5D=feature-%23&merge_request%5Btarget_branch%5D=main",
 			},
+			"draft with title": {
+				branch:   gitdomain.NewLocalBranchName("feature"),
+				parent:   main,
+				title:    "my title",
+				metadata: hostingdomain.ProposalMetadata{Draft: true},
+				want:     "https://gitlab.com/organization/repo/-/merge_requests/new?merge_request%5Bsource_branch%5D=feature&merge_request%5Btarget_branch%5D=main&merge_request%5Btitle%5D=Draft%3A+my+title",
+			},
+			"body and metadata": {
+				branch: gitdomain.NewLocalBranchName("feature"),
+				parent: main,
+				body:   "my body",
+				metadata: hostingdomain.ProposalMetadata{
+					Assignees: []string{"alice"},
+					Labels:    []string{"bug"},
+				},
+				want: "https://gitlab.com/organization/repo/-/merge_requests/new?merge_request%5Bdescription%5D=my+body%0A%0A%2Flabel+~bug%0A%2Fassign+%40alice&merge_request%5Bsource_branch%5D=feature&merge_request%5Btarget_branch%5D=main",
+			},
 		}
 		for name, tt := range tests {
 			t.Run(name, func(t *testing.T) {
 				connector := gitlab.Connector{
 					Data: gitlab.Data{
-						APIToken: configdomain.ParseGitLabToken("apiToken"),
 						Data: hostingdomain.Data{
 							Hostname:     "gitlab.com",
 							Organization: "organization",
@@ -72,7 +95,7 @@ func TestGitlabConnector(t *testing.T) {
 						},
 					},
 				}
-				have, err := connector.NewProposalURL(tt.branch, tt.parent, main, "", "")
+				have, err := connector.NewProposalURL(tt.branch, tt.parent, main, tt.title, tt.body, tt.metadata)
 				must.NoError(t, err)
 				must.EqOp(t, tt.want, have)
 			})
@@ -80,6 +103,78 @@ func TestGitlabConnector(t *testing.T) {
 	})
 }
 
+func TestRebaseMergeProposal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("waits until GitLab has finished rebasing", func(t *testing.T) {
+		t.Parallel()
+		statusChecks := 0
+		merged := false
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			writer.Header().Set("Content-Type", "application/json")
+			if request.Header.Get("Authorization") != "Bearer apiToken" {
+				writer.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			switch {
+			case request.Method == http.MethodPut && strings.HasSuffix(request.URL.Path, "/merge_requests/1/rebase"):
+				writer.WriteHeader(http.StatusAccepted)
+				_, _ = writer.Write([]byte(`{"rebase_in_progress": true}`))
+			case request.Method == http.MethodGet && strings.HasSuffix(request.URL.Path, "/merge_requests/1"):
+				statusChecks++
+				_, _ = writer.Write([]byte(`{"iid": 1, "rebase_in_progress": ` + strconv.FormatBool(statusChecks < 2) + `}`))
+			case request.Method == http.MethodPut && strings.HasSuffix(request.URL.Path, "/merge_requests/1/merge"):
+				merged = true
+				_, _ = writer.Write([]byte(`{"iid": 1, "state": "merged"}`))
+			default:
+				writer.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+		remoteURL, has := giturl.Parse("git@gitlab.com:git-town/docs.git").Get()
+		must.True(t, has)
+		tokenLookups := 0
+		connector, err := gitlab.NewConnector(gitlab.NewConnectorArgs{
+			APIToken: func() Option[configdomain.GitLabToken] {
+				tokenLookups++
+				return configdomain.ParseGitLabToken("apiToken")
+			},
+			APIURL:     Some(server.URL),
+			HTTPClient: server.Client(),
+			Log:        print.QuietLogger(),
+			RemoteURL:  remoteURL,
+		})
+		must.NoError(t, err)
+		must.EqOp(t, 0, tokenLookups)
+		err = connector.RebaseMergeProposal(1)
+		must.NoError(t, err)
+		must.EqOp(t, 2, statusChecks)
+		must.True(t, merged)
+	})
+}
+
+func TestQuickActions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("labels, assignees, and reviewers", func(t *testing.T) {
+		t.Parallel()
+		have := gitlab.QuickActions(hostingdomain.ProposalMetadata{
+			Assignees: []string{"alice", "bob"},
+			Draft:     false,
+			Labels:    []string{"bug", "needs review"},
+			Reviewers: []string{"carol"},
+		})
+		want := "/label ~bug ~\"needs review\"\n/assign @alice @bob\n/assign_reviewer @carol"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("no metadata", func(t *testing.T) {
+		t.Parallel()
+		have := gitlab.QuickActions(hostingdomain.ProposalMetadata{})
+		must.EqOp(t, "", have)
+	})
+}
+
 func TestNewGitlabConnector(t *testing.T) {
 	t.Parallel()
 
@@ -88,9 +183,11 @@ func TestNewGitlabConnector(t *testing.T) {
 		remoteURL, has := giturl.Parse("git@gitlab.com:git-town/docs.git").Get()
 		must.True(t, has)
 		have, err := gitlab.NewConnector(gitlab.NewConnectorArgs{
-			APIToken:  configdomain.ParseGitLabToken("apiToken"),
-			Log:       print.Logger{},
-			RemoteURL: remoteURL,
+			APIToken:   func() Option[configdomain.GitLabToken] { return configdomain.ParseGitLabToken("apiToken") },
+			APIURL:     None[string](),
+			HTTPClient: http.DefaultClient,
+			Log:        print.Logger{},
+			RemoteURL:  remoteURL,
 		})
 		must.NoError(t, err)
 		wantConfig := gitlab.Data{
@@ -99,7 +196,6 @@ func TestNewGitlabConnector(t *testing.T) {
 				Organization: "git-town",
 				Repository:   "docs",
 			},
-			APIToken: configdomain.ParseGitLabToken("apiToken"),
 		}
 		must.Eq(t, wantConfig, have.Data)
 	})
@@ -109,9 +205,11 @@ func TestNewGitlabConnector(t *testing.T) {
 		remoteURL, has := giturl.Parse("git@custom-url.com:git-town/docs.git").Get()
 		must.True(t, has)
 		have, err := gitlab.NewConnector(gitlab.NewConnectorArgs{
-			APIToken:  configdomain.ParseGitLabToken("apiToken"),
-			Log:       print.Logger{},
-			RemoteURL: remoteURL,
+			APIToken:   func() Option[configdomain.GitLabToken] { return configdomain.ParseGitLabToken("apiToken") },
+			APIURL:     None[string](),
+			HTTPClient: http.DefaultClient,
+			Log:        print.Logger{},
+			RemoteURL:  remoteURL,
 		})
 		must.NoError(t, err)
 		wantConfig := gitlab.Data{
@@ -120,7 +218,6 @@ func TestNewGitlabConnector(t *testing.T) {
 				Organization: "git-town",
 				Repository:   "docs",
 			},
-			APIToken: configdomain.ParseGitLabToken("apiToken"),
 		}
 		must.Eq(t, wantConfig, have.Data)
 	})
@@ -130,9 +227,11 @@ func TestNewGitlabConnector(t *testing.T) {
 		remoteURL, has := giturl.Parse("git@gitlab.domain:1234/group/project").Get()
 		must.True(t, has)
 		have, err := gitlab.NewConnector(gitlab.NewConnectorArgs{
-			APIToken:  configdomain.ParseGitLabToken("apiToken"),
-			Log:       print.Logger{},
-			RemoteURL: remoteURL,
+			APIToken:   func() Option[configdomain.GitLabToken] { return configdomain.ParseGitLabToken("apiToken") },
+			APIURL:     None[string](),
+			HTTPClient: http.DefaultClient,
+			Log:        print.Logger{},
+			RemoteURL:  remoteURL,
 		})
 		must.NoError(t, err)
 		wantConfig := gitlab.Data{
@@ -141,7 +240,6 @@ func TestNewGitlabConnector(t *testing.T) {
 				Organization: "group",
 				Repository:   "project",
 			},
-			APIToken: configdomain.ParseGitLabToken("apiToken"),
 		}
 		must.Eq(t, wantConfig, have.Data)
 	})
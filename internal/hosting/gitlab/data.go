@@ -3,29 +3,70 @@ package gitlab
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
-	"github.com/git-town/git-town/v16/internal/config/configdomain"
 	"github.com/git-town/git-town/v16/internal/git/gitdomain"
 	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
-	. "github.com/git-town/git-town/v16/pkg/prelude"
 )
 
 type Data struct {
 	hostingdomain.Data
-	APIToken Option[configdomain.GitLabToken]
 }
 
 func (self Data) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
 	return fmt.Sprintf("%s (!%d)", proposal.Title, proposal.Number)
 }
 
-func (self Data) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchName, _ gitdomain.ProposalTitle, _ gitdomain.ProposalBody) (string, error) {
+// NewProposalURL provides the URL of the page to create a new merge request.
+// GitLab doesn't support labels, assignees, and reviewers in this URL,
+// so this adds them as quick actions to the description, which GitLab executes when creating the merge request.
+func (self Data) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchName, proposalTitle gitdomain.ProposalTitle, proposalBody gitdomain.ProposalBody, metadata hostingdomain.ProposalMetadata) (string, error) {
 	query := url.Values{}
 	query.Add("merge_request[source_branch]", branch.String())
 	query.Add("merge_request[target_branch]", parentBranch.String())
+	title := proposalTitle.String()
+	if metadata.Draft {
+		title = draftPrefix + title
+	}
+	if title != "" {
+		query.Add("merge_request[title]", title)
+	}
+	description := proposalBody.String()
+	if quickActions := QuickActions(metadata); quickActions != "" {
+		if description != "" {
+			description += "\n\n"
+		}
+		description += quickActions
+	}
+	if description != "" {
+		query.Add("merge_request[description]", description)
+	}
 	return fmt.Sprintf("%s/-/merge_requests/new?%s", self.RepositoryURL(), query.Encode()), nil
 }
 
+// QuickActions provides the GitLab quick actions that apply the labels, assignees, and reviewers in the given metadata.
+func QuickActions(metadata hostingdomain.ProposalMetadata) string {
+	lines := []string{}
+	if len(metadata.Labels) > 0 {
+		labels := make([]string, len(metadata.Labels))
+		for l, label := range metadata.Labels {
+			if strings.Contains(label, " ") {
+				// GitLab references labels with spaces in quotes
+				label = `"` + label + `"`
+			}
+			labels[l] = label
+		}
+		lines = append(lines, "/label "+prefixAll("~", labels))
+	}
+	if len(metadata.Assignees) > 0 {
+		lines = append(lines, "/assign "+prefixAll("@", metadata.Assignees))
+	}
+	if len(metadata.Reviewers) > 0 {
+		lines = append(lines, "/assign_reviewer "+prefixAll("@", metadata.Reviewers))
+	}
+	return strings.Join(lines, "\n")
+}
+
 func (self Data) RepositoryURL() string {
 	return fmt.Sprintf("%s/%s", self.baseURL(), self.projectPath())
 }
@@ -37,3 +78,15 @@ func (self Data) baseURL() string {
 func (self Data) projectPath() string {
 	return fmt.Sprintf("%s/%s", self.Organization, self.Repository)
 }
+
+// GitLab marks merge requests as drafts through this prefix in their title
+const draftPrefix = "Draft: "
+
+// prefixAll provides the given names separated by spaces, each with the given prefix.
+func prefixAll(prefix string, names []string) string {
+	result := make([]string, len(names))
+	for n, name := range names {
+		result[n] = prefix + name
+	}
+	return strings.Join(result, " ")
+}
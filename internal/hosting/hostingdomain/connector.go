@@ -22,11 +22,82 @@ type Connector interface {
 
 	// NewProposalURL provides the URL of the page
 	// to create a new proposal online.
-	NewProposalURL(branch, parentBranch, mainBranch gitdomain.LocalBranchName, proposalTitle gitdomain.ProposalTitle, proposalBody gitdomain.ProposalBody) (string, error)
+	// Encodes the parts of the given metadata that the hosting platform supports in the URL.
+	NewProposalURL(branch, parentBranch, mainBranch gitdomain.LocalBranchName, proposalTitle gitdomain.ProposalTitle, proposalBody gitdomain.ProposalBody, metadata ProposalMetadata) (string, error)
 
 	// RepositoryURL provides the URL where the current repository can be found online.
 	RepositoryURL() string
 
+	// UpdateProposalBody updates the body of the given proposal.
+	UpdateProposalBody(number int, body gitdomain.ProposalBody) error
+
 	// UpdateProposalTarget updates the target branch of the given proposal.
 	UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error
 }
+
+// MergedProposalFinder is implemented by connectors that can look up
+// proposals that have already been merged.
+type MergedProposalFinder interface {
+	// FindMergedProposals provides the merged proposals for the given branch into the given target branch.
+	// A branch can have several merged proposals if its name got reused.
+	FindMergedProposals(branch, target gitdomain.LocalBranchName) ([]MergedProposal, error)
+}
+
+// MergedProposal describes a proposal that the hosting platform has merged.
+type MergedProposal struct {
+	HeadSHA gitdomain.SHA // the commit that the merged branch pointed to when the proposal got merged
+	Number  int
+}
+
+// ProposalAutoMerger is implemented by connectors that can make the hosting platform
+// merge a proposal on its own once it meets all requirements for merging, like passing CI checks.
+type ProposalAutoMerger interface {
+	// EnableAutoMerge makes the hosting platform merge the proposal with the given number
+	// using the given merge method as soon as it meets all requirements for merging.
+	// An empty commit message uses the default commit message of the hosting platform.
+	// If deleteBranch is true, hosting platforms that support it delete the source branch after merging the proposal.
+	EnableAutoMerge(number int, method ProposalMergeMethod, message gitdomain.CommitMessage, deleteBranch bool) error
+}
+
+// ProposalCreator is implemented by connectors that can create proposals
+// through the API of their hosting platform.
+type ProposalCreator interface {
+	// CreateProposal creates a proposal to merge the given branch into the given target branch,
+	// applies the given metadata to it, and provides the newly created proposal.
+	CreateProposal(branch, target gitdomain.LocalBranchName, title gitdomain.ProposalTitle, body gitdomain.ProposalBody, metadata ProposalMetadata) (Proposal, error)
+}
+
+// ProposalLoader is implemented by connectors that can look up proposals by their number.
+type ProposalLoader interface {
+	// LoadProposal provides the proposal with the given number together with the location of the branch that it merges.
+	// Returns nil if no proposal with this number exists.
+	LoadProposal(number int) (Option[ProposalWithSource], error)
+}
+
+// ProposalMerger is implemented by connectors that can merge proposals
+// through merge commits and rebase-merges in addition to squash-merges.
+type ProposalMerger interface {
+	// MergeProposal merges the proposal with the given number using a merge commit.
+	// An empty commit message uses the default merge commit message of the hosting platform.
+	MergeProposal(number int, message gitdomain.CommitMessage) error
+
+	// RebaseMergeProposal merges the proposal with the given number
+	// by rebasing its commits onto the target branch.
+	RebaseMergeProposal(number int) error
+}
+
+// ProposalSearcher is implemented by connectors that can look up proposals
+// independent of the branch they target.
+type ProposalSearcher interface {
+	// SearchProposal provides the open proposal for the given branch, whatever branch it targets.
+	// Returns nil if no proposal exists.
+	SearchProposal(branch gitdomain.LocalBranchName) (Option[Proposal], error)
+}
+
+// ProposalStatusLoader is implemented by connectors that can load
+// the state of the CI checks and reviews of proposals.
+type ProposalStatusLoader interface {
+	// LoadProposalStatus provides the given proposal with the state of its CI checks,
+	// the state of its reviews, and its mergeability as currently known by the hosting platform.
+	LoadProposalStatus(proposal Proposal) (Proposal, error)
+}
@@ -0,0 +1,17 @@
+package hostingdomain
+
+import (
+	"github.com/git-town/git-town/v16/internal/git/giturl"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// ForkOwner provides the owner of the fork that Git Town pushes branches to
+// when proposing them into the repository at the given remote URL.
+// Returns nil if Git Town pushes branches to the repository that receives the proposals.
+func ForkOwner(remoteURL giturl.Parts, pushRemoteURL Option[giturl.Parts]) Option[string] {
+	pushURL, hasPushURL := pushRemoteURL.Get()
+	if !hasPushURL || pushURL.Org == remoteURL.Org {
+		return None[string]()
+	}
+	return Some(pushURL.Org)
+}
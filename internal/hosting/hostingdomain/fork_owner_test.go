@@ -0,0 +1,25 @@
+package hostingdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v16/internal/git/giturl"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestForkOwner(t *testing.T) {
+	t.Parallel()
+	remoteURL, has := giturl.Parse("git@github.com:git-town/git-town.git").Get()
+	must.True(t, has)
+	tests := map[Option[giturl.Parts]]Option[string]{
+		None[giturl.Parts](): None[string](),
+		giturl.Parse("git@github.com:git-town/git-town.git"): None[string](),
+		giturl.Parse("git@github.com:alice/git-town.git"):    Some("alice"),
+	}
+	for give, want := range tests {
+		have := hostingdomain.ForkOwner(remoteURL, give)
+		must.Eq(t, want, have)
+	}
+}
@@ -0,0 +1,38 @@
+package hostingdomain
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/git-town/git-town/v16/internal/messages"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// NewHTTPClient provides the HTTP client that connectors use to talk to the API of code hosting platforms.
+// If a CA file is given, the client trusts the certificates in this PEM file in addition to the system certificates.
+func NewHTTPClient(caFile Option[string]) (*http.Client, error) {
+	path, hasCAFile := caFile.Get()
+	if !hasCAFile {
+		return &http.Client{}, nil //exhaustruct:ignore
+	}
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf(messages.HostingCAFileProblem, path, err)
+	}
+	certPool, err := x509.SystemCertPool()
+	if err != nil {
+		certPool = x509.NewCertPool()
+	}
+	if !certPool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf(messages.HostingCAFileNoCertificates, path)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert
+	transport.TLSClientConfig = &tls.Config{                     //exhaustruct:ignore
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    certPool,
+	}
+	return &http.Client{Transport: transport}, nil //exhaustruct:ignore
+}
@@ -0,0 +1,57 @@
+package hostingdomain_test
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no CA file", func(t *testing.T) {
+		t.Parallel()
+		server := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+		defer server.Close()
+		client, err := hostingdomain.NewHTTPClient(None[string]())
+		must.NoError(t, err)
+		_, err = client.Get(server.URL) //nolint:noctx
+		must.Error(t, err)
+	})
+
+	t.Run("CA file contains the certificate of the server", func(t *testing.T) {
+		t.Parallel()
+		server := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+		defer server.Close()
+		caFile := filepath.Join(t.TempDir(), "ca.pem")
+		certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}) //exhaustruct:ignore
+		must.NoError(t, os.WriteFile(caFile, certPEM, 0o600))
+		client, err := hostingdomain.NewHTTPClient(Some(caFile))
+		must.NoError(t, err)
+		response, err := client.Get(server.URL) //nolint:noctx
+		must.NoError(t, err)
+		defer response.Body.Close()
+		must.EqOp(t, http.StatusOK, response.StatusCode)
+	})
+
+	t.Run("CA file does not exist", func(t *testing.T) {
+		t.Parallel()
+		_, err := hostingdomain.NewHTTPClient(Some(filepath.Join(t.TempDir(), "zonk.pem")))
+		must.Error(t, err)
+	})
+
+	t.Run("CA file contains no certificates", func(t *testing.T) {
+		t.Parallel()
+		caFile := filepath.Join(t.TempDir(), "ca.pem")
+		must.NoError(t, os.WriteFile(caFile, []byte("zonk"), 0o600))
+		_, err := hostingdomain.NewHTTPClient(Some(caFile))
+		must.ErrorContains(t, err, "contains no PEM certificates")
+	})
+}
@@ -0,0 +1,69 @@
+package hostingdomain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// JSONRequest sends a request with the given JSON payload to the REST API of a hosting platform
+// and decodes the JSON response into the given result.
+func JSONRequest(args JSONRequestArgs) error {
+	var body io.Reader
+	if args.Payload != nil {
+		encoded, err := json.Marshal(args.Payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(encoded)
+	}
+	request, err := http.NewRequest(args.Method, args.URL, body) //nolint:noctx
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if args.Payload != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	if authorization, hasAuthorization := args.Authorization.Get(); hasAuthorization {
+		request.Header.Set("Authorization", authorization)
+	}
+	response, err := args.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf(args.ErrorMessage, args.Method, args.URL, response.StatusCode, string(responseBody))
+	}
+	if args.Result == nil {
+		return nil
+	}
+	return json.Unmarshal(responseBody, args.Result)
+}
+
+// BearerAuthorization provides the content of the Authorization header that sends the given token as a bearer token.
+func BearerAuthorization[T ~string](token Option[T]) Option[string] {
+	if value, hasToken := token.Get(); hasToken {
+		return Some("Bearer " + string(value))
+	}
+	return None[string]()
+}
+
+type JSONRequestArgs struct {
+	Authorization Option[string] // content of the Authorization header
+	Client        *http.Client
+	ErrorMessage  string // message for unsuccessful responses, receives the method, URL, status code, and response body
+	Method        string
+	Payload       any // the data to send as JSON, nil sends no request body
+	Result        any // receives the decoded JSON response, nil ignores the response body
+	URL           string
+}
@@ -0,0 +1,14 @@
+package hostingdomain
+
+import (
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+	"golang.org/x/oauth2"
+)
+
+// OAuthTokenSource provides the API token that the given function determines to OAuth2 HTTP clients.
+// The clients call it when they send a request, so the token gets determined only if an API call needs it.
+type OAuthTokenSource[T ~string] func() Option[T]
+
+func (self OAuthTokenSource[T]) Token() (*oauth2.Token, error) {
+	return &oauth2.Token{AccessToken: self().String()}, nil //exhaustruct:ignore
+}
@@ -1,16 +1,35 @@
 package hostingdomain
 
-import "github.com/git-town/git-town/v16/internal/git/gitdomain"
+import (
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
 
 // Proposal contains information about a change request on a code hosting platform.
 // Alternative names are "pull request" or "merge request".
 type Proposal struct {
+	// textual description of the proposal
+	Body gitdomain.ProposalBody
+
+	// combined state of the CI checks of this proposal
+	Checks ProposalChecks
+
+	// whether this proposal is a draft
+	Draft bool
+
 	// whether this proposal can be merged via the API
 	MergeWithAPI bool
 
+	// whether this proposal can be merged without conflicts,
+	// None if the hosting platform hasn't determined this yet
+	Mergeable Option[bool]
+
 	// the number used to identify the proposal on the hosting platform
 	Number int
 
+	// state of the reviews of this proposal
+	Review ProposalReview
+
 	// name of the target branch ("base") of this proposal
 	Target gitdomain.LocalBranchName
 
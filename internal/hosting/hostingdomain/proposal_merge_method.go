@@ -0,0 +1,14 @@
+package hostingdomain
+
+// ProposalMergeMethod describes how the hosting platform merges a proposal.
+type ProposalMergeMethod string
+
+const (
+	ProposalMergeMethodMerge  ProposalMergeMethod = "merge"  // create a merge commit
+	ProposalMergeMethodRebase ProposalMergeMethod = "rebase" // rebase the commits of the proposal onto the target branch
+	ProposalMergeMethodSquash ProposalMergeMethod = "squash" // squash all commits of the proposal into a single commit
+)
+
+func (self ProposalMergeMethod) String() string {
+	return string(self)
+}
@@ -0,0 +1,14 @@
+package hostingdomain
+
+// ProposalMetadata contains the optional details that Git Town applies to new proposals.
+type ProposalMetadata struct {
+	Assignees []string // usernames of the people to assign the proposal to
+	Draft     bool     // whether to create the proposal as a draft
+	Labels    []string // names of the labels to add to the proposal
+	Reviewers []string // usernames of the people to request reviews from, GitHub also accepts teams as "org/team"
+}
+
+// IsEmpty indicates whether this metadata contains any assignees, labels, or reviewers.
+func (self ProposalMetadata) IsEmpty() bool {
+	return len(self.Assignees) == 0 && len(self.Labels) == 0 && len(self.Reviewers) == 0
+}
@@ -1,6 +1,11 @@
 package hostingdomain
 
-import "os"
+import (
+	"os"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
 
 const (
 	// the key under which the proposal API lookup override gets stored in the environment variables
@@ -13,3 +18,23 @@ const (
 func ReadProposalOverride() string {
 	return os.Getenv(OverrideKey)
 }
+
+// OverriddenProposal provides the proposal that end-to-end tests simulate via the given proposal override,
+// i.e. the non-empty result of ReadProposalOverride.
+func OverriddenProposal(proposalURLOverride string, target gitdomain.LocalBranchName) Option[Proposal] {
+	if proposalURLOverride == OverrideNoProposal {
+		return None[Proposal]()
+	}
+	return Some(Proposal{
+		Body:         "",
+		Checks:       ProposalChecksUnknown,
+		Draft:        false,
+		MergeWithAPI: true,
+		Mergeable:    None[bool](),
+		Number:       123,
+		Review:       ProposalReviewUnknown,
+		Target:       target,
+		Title:        "title",
+		URL:          proposalURLOverride,
+	})
+}
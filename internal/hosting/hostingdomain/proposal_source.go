@@ -0,0 +1,24 @@
+package hostingdomain
+
+import (
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// ProposalFork describes the fork that contains the source branch of a proposal.
+type ProposalFork struct {
+	CloneURL string // the URL to fetch the fork from
+	Owner    string // name of the account that owns the fork
+}
+
+// ProposalSource describes where the branch that a proposal merges lives.
+type ProposalSource struct {
+	Branch gitdomain.LocalBranchName // name of the branch that the proposal merges
+	Fork   Option[ProposalFork]      // the fork that contains the branch, None if the branch is in the repository that the proposal targets
+}
+
+// ProposalWithSource is a proposal together with the location of the branch that it merges.
+type ProposalWithSource struct {
+	Proposal Proposal
+	Source   ProposalSource
+}
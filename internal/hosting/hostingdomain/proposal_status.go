@@ -0,0 +1,63 @@
+package hostingdomain
+
+import "slices"
+
+// ProposalChecks describes the combined state of the CI checks of a proposal.
+type ProposalChecks string
+
+const (
+	ProposalChecksFailure ProposalChecks = "failure" // at least one check has failed
+	ProposalChecksNone    ProposalChecks = "none"    // the proposal has no checks
+	ProposalChecksPending ProposalChecks = "pending" // checks are still running and none has failed so far
+	ProposalChecksSuccess ProposalChecks = "success" // all checks have passed
+	ProposalChecksUnknown ProposalChecks = ""        // the state of the checks has not been loaded
+)
+
+func (self ProposalChecks) String() string {
+	return string(self)
+}
+
+// CombineProposalChecks provides the combined state of the given states of individual checks.
+func CombineProposalChecks(checks []ProposalChecks) ProposalChecks {
+	switch {
+	case slices.Contains(checks, ProposalChecksFailure):
+		return ProposalChecksFailure
+	case slices.Contains(checks, ProposalChecksPending):
+		return ProposalChecksPending
+	case slices.Contains(checks, ProposalChecksSuccess):
+		return ProposalChecksSuccess
+	default:
+		return ProposalChecksNone
+	}
+}
+
+// ProposalReview describes the state of the reviews of a proposal.
+type ProposalReview string
+
+const (
+	ProposalReviewApproved         ProposalReview = "approved"          // the proposal has been approved
+	ProposalReviewChangesRequested ProposalReview = "changes-requested" // a reviewer has requested changes
+	ProposalReviewNone             ProposalReview = "none"              // the proposal has no reviews and doesn't require any
+	ProposalReviewRequired         ProposalReview = "review-required"   // the proposal still needs approving reviews
+	ProposalReviewUnknown          ProposalReview = ""                  // the state of the reviews has not been loaded
+)
+
+func (self ProposalReview) String() string {
+	return string(self)
+}
+
+// CombineProposalReviews provides the review state of a proposal
+// with the given latest reviews of its individual reviewers.
+// The hosting platform determines whether the proposal still needs approving reviews.
+func CombineProposalReviews(reviews []ProposalReview, reviewRequired bool) ProposalReview {
+	switch {
+	case slices.Contains(reviews, ProposalReviewChangesRequested):
+		return ProposalReviewChangesRequested
+	case reviewRequired:
+		return ProposalReviewRequired
+	case slices.Contains(reviews, ProposalReviewApproved):
+		return ProposalReviewApproved
+	default:
+		return ProposalReviewNone
+	}
+}
@@ -0,0 +1,45 @@
+package hostingdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestProposalStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("CombineProposalChecks", func(t *testing.T) {
+		t.Parallel()
+		tests := map[hostingdomain.ProposalChecks][]hostingdomain.ProposalChecks{
+			hostingdomain.ProposalChecksNone:    {},
+			hostingdomain.ProposalChecksSuccess: {hostingdomain.ProposalChecksSuccess, hostingdomain.ProposalChecksSuccess},
+			hostingdomain.ProposalChecksPending: {hostingdomain.ProposalChecksSuccess, hostingdomain.ProposalChecksPending},
+			hostingdomain.ProposalChecksFailure: {hostingdomain.ProposalChecksPending, hostingdomain.ProposalChecksFailure, hostingdomain.ProposalChecksSuccess},
+		}
+		for want, give := range tests {
+			have := hostingdomain.CombineProposalChecks(give)
+			must.EqOp(t, want, have)
+		}
+	})
+
+	t.Run("CombineProposalReviews", func(t *testing.T) {
+		t.Parallel()
+		tests := []struct {
+			reviews  []hostingdomain.ProposalReview
+			required bool
+			want     hostingdomain.ProposalReview
+		}{
+			{reviews: []hostingdomain.ProposalReview{}, required: false, want: hostingdomain.ProposalReviewNone},
+			{reviews: []hostingdomain.ProposalReview{}, required: true, want: hostingdomain.ProposalReviewRequired},
+			{reviews: []hostingdomain.ProposalReview{hostingdomain.ProposalReviewApproved}, required: false, want: hostingdomain.ProposalReviewApproved},
+			{reviews: []hostingdomain.ProposalReview{hostingdomain.ProposalReviewApproved}, required: true, want: hostingdomain.ProposalReviewRequired},
+			{reviews: []hostingdomain.ProposalReview{hostingdomain.ProposalReviewApproved, hostingdomain.ProposalReviewChangesRequested}, required: false, want: hostingdomain.ProposalReviewChangesRequested},
+		}
+		for _, test := range tests {
+			have := hostingdomain.CombineProposalReviews(test.reviews, test.required)
+			must.EqOp(t, test.want, have)
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package hostingdomain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+const (
+	// the marker that starts the stack section in proposal bodies
+	StackSectionStart = "<!-- branch-stack-start -->"
+
+	// the marker that ends the stack section in proposal bodies
+	StackSectionEnd = "<!-- branch-stack-end -->"
+)
+
+// StackSectionEntry describes a branch listed in the stack section of proposal bodies.
+type StackSectionEntry struct {
+	// name of the branch
+	Branch gitdomain.LocalBranchName
+
+	// how deep the branch is nested in the stack, the root branch of the stack has depth 0
+	Depth int
+
+	// the proposal of the branch, if one exists
+	Proposal Option[Proposal]
+}
+
+// StackSection provides the marker-delimited section that lists the given branches of a stack
+// for the body of the proposal of the given branch.
+func StackSection(entries []StackSectionEntry, current gitdomain.LocalBranchName) string {
+	result := strings.Builder{}
+	result.WriteString(StackSectionStart + "\n")
+	result.WriteString("This proposal is part of a stack:\n\n")
+	for _, entry := range entries {
+		text := entry.Branch.String()
+		if proposal, hasProposal := entry.Proposal.Get(); hasProposal {
+			text = fmt.Sprintf("[#%d](%s) %s", proposal.Number, proposal.URL, entry.Branch)
+		}
+		if entry.Branch == current {
+			text = "**" + text + "** (this proposal)"
+		}
+		result.WriteString(fmt.Sprintf("%s- %s\n", strings.Repeat("  ", entry.Depth), text))
+	}
+	result.WriteString(StackSectionEnd)
+	return result.String()
+}
+
+// WithStackSection provides the given proposal body with its stack section replaced by the given one.
+// Adds the stack section to the end of the body if the body doesn't contain one yet.
+// Leaves the rest of the body untouched.
+func WithStackSection(body gitdomain.ProposalBody, section string) gitdomain.ProposalBody {
+	text := body.String()
+	start := strings.Index(text, StackSectionStart)
+	end := strings.Index(text, StackSectionEnd)
+	if start == -1 || end < start {
+		if strings.TrimSpace(text) == "" {
+			return gitdomain.ProposalBody(section)
+		}
+		return gitdomain.ProposalBody(strings.TrimRight(text, "\n") + "\n\n" + section)
+	}
+	return gitdomain.ProposalBody(text[:start] + section + text[end+len(StackSectionEnd):])
+}
@@ -0,0 +1,65 @@
+package hostingdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestStackSection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("StackSection", func(t *testing.T) {
+		t.Parallel()
+		entries := []hostingdomain.StackSectionEntry{
+			{
+				Branch:   "main",
+				Depth:    0,
+				Proposal: None[hostingdomain.Proposal](),
+			},
+			{
+				Branch: "alpha",
+				Depth:  1,
+				Proposal: Some(hostingdomain.Proposal{
+					Body:         "",
+					MergeWithAPI: true,
+					Number:       1,
+					Target:       "main",
+					Title:        "alpha",
+					URL:          "https://example.com/pull/1",
+				}),
+			},
+			{
+				Branch:   "beta",
+				Depth:    2,
+				Proposal: None[hostingdomain.Proposal](),
+			},
+		}
+		have := hostingdomain.StackSection(entries, gitdomain.NewLocalBranchName("alpha"))
+		want := `<!-- branch-stack-start -->
+This proposal is part of a stack:
+
+- main
+  - **[#1](https://example.com/pull/1) alpha** (this proposal)
+    - beta
+<!-- branch-stack-end -->`
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("WithStackSection", func(t *testing.T) {
+		t.Parallel()
+		section := hostingdomain.StackSectionStart + "\nnew\n" + hostingdomain.StackSectionEnd
+		tests := map[gitdomain.ProposalBody]gitdomain.ProposalBody{
+			"":              gitdomain.ProposalBody(section),
+			"description\n": gitdomain.ProposalBody("description\n\n" + section),
+			"before\n\n" + hostingdomain.StackSectionStart + "\nold\n" + hostingdomain.StackSectionEnd + "\n\nafter": gitdomain.ProposalBody("before\n\n" + section + "\n\nafter"),
+		}
+		for give, want := range tests {
+			have := hostingdomain.WithStackSection(give, section)
+			must.EqOp(t, want, have)
+		}
+	})
+}
@@ -3,8 +3,12 @@ package hosting
 import (
 	"github.com/git-town/git-town/v16/internal/cli/print"
 	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/git"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
 	"github.com/git-town/git-town/v16/internal/git/giturl"
+	"github.com/git-town/git-town/v16/internal/hosting/azuredevops"
 	"github.com/git-town/git-town/v16/internal/hosting/bitbucket"
+	"github.com/git-town/git-town/v16/internal/hosting/bitbucketdatacenter"
 	"github.com/git-town/git-town/v16/internal/hosting/gitea"
 	"github.com/git-town/git-town/v16/internal/hosting/github"
 	"github.com/git-town/git-town/v16/internal/hosting/gitlab"
@@ -18,35 +22,68 @@ func NewConnector(args NewConnectorArgs) (Option[hostingdomain.Connector], error
 	if !hasPlatform {
 		return None[hostingdomain.Connector](), nil
 	}
+	httpClient, err := hostingdomain.NewHTTPClient(NewOption(args.Config.HostingCAFile.String()))
+	if err != nil {
+		return None[hostingdomain.Connector](), err
+	}
+	apiURL := NewOption(args.Config.HostingAPIURL.String())
 	var connector hostingdomain.Connector
 	switch platform {
+	case configdomain.HostingPlatformAzureDevOps:
+		connector = azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+			APIToken:   apiToken(args, args.Config.AzureDevOpsToken, envToken[configdomain.AzureDevOpsToken]("AZURE_DEVOPS_EXT_PAT")),
+			APIURL:     apiURL,
+			HTTPClient: httpClient,
+			Log:        args.Log,
+			RemoteURL:  args.RemoteURL,
+		})
+		return Some(connector), nil
 	case configdomain.HostingPlatformBitbucket:
 		connector = bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+			APIToken:        apiToken(args, args.Config.BitbucketToken, envToken[configdomain.BitbucketToken]("BITBUCKET_TOKEN")),
+			APIURL:          apiURL,
+			HTTPClient:      httpClient,
 			HostingPlatform: args.HostingPlatform,
+			Log:             args.Log,
 			RemoteURL:       args.RemoteURL,
 		})
 		return Some(connector), nil
+	case configdomain.HostingPlatformBitbucketDatacenter:
+		connector = bitbucketdatacenter.NewConnector(bitbucketdatacenter.NewConnectorArgs{
+			APIToken:   apiToken(args, args.Config.BitbucketToken, envToken[configdomain.BitbucketToken]("BITBUCKET_TOKEN")),
+			APIURL:     apiURL,
+			HTTPClient: httpClient,
+			Log:        args.Log,
+			RemoteURL:  args.RemoteURL,
+		})
+		return Some(connector), nil
 	case configdomain.HostingPlatformGitea:
 		connector = gitea.NewConnector(gitea.NewConnectorArgs{
-			APIToken:  args.Config.GiteaToken,
-			Log:       args.Log,
-			RemoteURL: args.RemoteURL,
+			APIToken:      apiToken(args, args.Config.GiteaToken, envToken[configdomain.GiteaToken]("GITEA_TOKEN")),
+			APIURL:        apiURL,
+			HTTPClient:    httpClient,
+			Log:           args.Log,
+			PushRemoteURL: args.PushRemoteURL,
+			RemoteURL:     args.RemoteURL,
 		})
 		return Some(connector), nil
 	case configdomain.HostingPlatformGitHub:
-		var err error
 		connector, err = github.NewConnector(github.NewConnectorArgs{
-			APIToken:  github.GetAPIToken(args.Config.GitHubToken),
-			Log:       args.Log,
-			RemoteURL: args.RemoteURL,
+			APIToken:      apiToken(args, envToken[configdomain.GitHubToken]("GITHUB_TOKEN", "GITHUB_AUTH_TOKEN"), args.Config.GitHubToken),
+			APIURL:        apiURL,
+			HTTPClient:    httpClient,
+			Log:           args.Log,
+			PushRemoteURL: args.PushRemoteURL,
+			RemoteURL:     args.RemoteURL,
 		})
 		return Some(connector), err
 	case configdomain.HostingPlatformGitLab:
-		var err error
 		connector, err = gitlab.NewConnector(gitlab.NewConnectorArgs{
-			APIToken:  args.Config.GitLabToken,
-			Log:       args.Log,
-			RemoteURL: args.RemoteURL,
+			APIToken:   apiToken(args, args.Config.GitLabToken, envToken[configdomain.GitLabToken]("GITLAB_TOKEN")),
+			APIURL:     apiURL,
+			HTTPClient: httpClient,
+			Log:        args.Log,
+			RemoteURL:  args.RemoteURL,
 		})
 		return Some(connector), err
 	}
@@ -54,8 +91,11 @@ func NewConnector(args NewConnectorArgs) (Option[hostingdomain.Connector], error
 }
 
 type NewConnectorArgs struct {
+	Backend         gitdomain.Querier
 	Config          configdomain.UnvalidatedConfig
+	Git             git.Commands
 	HostingPlatform Option[configdomain.HostingPlatform]
 	Log             print.Logger
-	RemoteURL       giturl.Parts
+	PushRemoteURL   Option[giturl.Parts] // URL of the remote that Git Town pushes branches to, if it differs from RemoteURL
+	RemoteURL       giturl.Parts         // URL of the remote whose repository receives proposals
 }
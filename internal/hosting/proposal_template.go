@@ -0,0 +1,24 @@
+package hosting
+
+import (
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/hosting/gitea"
+	"github.com/git-town/git-town/v16/internal/hosting/github"
+	"github.com/git-town/git-town/v16/internal/hosting/gitlab"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// ProposalTemplatePath provides the location of the proposal template in repositories hosted on the given platform,
+// relative to the repository root.
+func ProposalTemplatePath(platform configdomain.HostingPlatform) Option[string] {
+	switch platform {
+	case configdomain.HostingPlatformGitea:
+		return Some(gitea.ProposalTemplatePath)
+	case configdomain.HostingPlatformGitHub:
+		return Some(github.ProposalTemplatePath)
+	case configdomain.HostingPlatformGitLab:
+		return Some(gitlab.ProposalTemplatePath)
+	case configdomain.HostingPlatformAzureDevOps, configdomain.HostingPlatformBitbucket, configdomain.HostingPlatformBitbucketDatacenter:
+	}
+	return None[string]()
+}
@@ -4,8 +4,15 @@ const (
 	UndoContinueGuidance              = "\n\nTo continue after having resolved conflicts, run \"git town continue\".\nTo go back to where you started, run \"git town undo\".\n"
 	AliasedCommands                   = "Aliased commands: %s\n"
 	ArgumentUnknown                   = "unknown argument: %q"
+	APIMergedProposalLookupStart      = "looking for merged proposal online ... "
+	APIProposalLoadStart              = "loading proposal %d online ... "
 	APIProposalLookupStart            = "looking for proposal online ... "
+	APIProposalBodyUpdateStart        = "updating body of proposal %d online ... "
+	APIProposalStatusLoadStart        = "loading status of proposal %d online ... "
 	APIProposalUpdateStart            = "updating proposal target online ..."
+	APITokenSource                    = "API token source: %s\n"
+	AzureDevOpsToken                  = "Azure DevOps token: %s\n"
+	BitbucketToken                    = "Bitbucket token: %s\n"
 	BranchAlreadyExistsLocally        = "there is already a branch %q"
 	BranchAlreadyExistsRemotely       = "there is already a branch %q at the \"origin\" remote"
 	BranchAuthorMultiple              = "\nMultiple people authored the %q branch.\n\n"
@@ -27,6 +34,11 @@ const (
 	BranchParentChanged               = "branch %q is now a child of %q"
 	BrowserOpen                       = "Please open in a browser: %s\n"
 	CacheUnitialized                  = "using a cached value before initialization"
+	CheckoutProposalBranchExists      = "branch %q of proposal %d exists already locally"
+	CheckoutProposalBranchMissing     = "branch %q of proposal %d doesn't exist at the origin remote"
+	CheckoutProposalNotFound          = "there is no proposal %d"
+	CheckoutProposalNumberInvalid     = "invalid proposal number: %q"
+	CheckoutProposalUnsupported       = "checking out proposals is not supported for this hosting platform"
 	CodeHosting                       = "Code hosting: %s\n"
 	CommandsRun                       = "Ran %d shell commands."
 	CommitMessageProblem              = "cannot determine last commit message: %w"
@@ -48,8 +60,13 @@ const (
 	ConfigNeeded                      = "Git Town needs to be configured\n\n"
 	ConfigScopeUnhandled              = "unhandled config scope"
 	ConfigStorage                     = "Config storage: %s\n"
+	ConfigProposalBodySourceUnknown   = "unknown proposal body source: %q"
 	ConfigShipStrategyUnknown         = "unknown ship strategy: %q"
 	ConfigSyncStrategyUnknown         = "unknown sync strategy: %q"
+	ConfigSyncStrategyOverrideInvalid = "invalid branch pattern %q in the sync strategy overrides: %w"
+	ConfigResolveInvalidPath          = "invalid path %q in the conflict resolution rules: %w"
+	ConfigResolveNoCommand            = "the conflict resolution rule for %s doesn't define a command"
+	ConfigResolveNoPaths              = "the conflict resolution rule with command %q doesn't define any paths"
 	ConfigRemoveError                 = "unexpected error while removing the 'git-town' section from the Git configuration: %w"
 	ContinueMessage                   = `You can run "git town continue" to finish it.`
 	ContinueSkipGuidance              = "To continue by skipping the current branch, run \"git town skip\"."
@@ -64,6 +81,9 @@ const (
 	ValueInvalid                      = "invalid value for %s: %q. Please provide either \"yes\" or \"no\""
 	ValueGlobalInvalid                = "invalid value for global %s: %q. Please provide either \"true\" or \"false\""
 	ConflictDetectionProblem          = "cannot determine conflicts: %w"
+	ConflictResolutionFailed          = "cannot resolve the conflicts automatically, running %q failed: %w"
+	ConflictsResolvedAutomatically    = "Resolved the conflicts in %s automatically."
+	ConflictingFilesProblem           = "cannot determine the files with conflicts: %w"
 	ContinueNothingToDo               = "nothing to continue"
 	ContinueUnresolvedConflicts       = "you must resolve the conflicts before continuing"
 	ContinueUntrackedChanges          = "please stage or commit the untracked changes first"
@@ -106,13 +126,35 @@ END OUTPUT FROM 'git branch -vva'
 	HackBranchIsNowFeature                = "branch %q is now a feature branch\n"
 	HackCannotFeatureMainBranch           = "cannot make the main branch a feature branch"
 	HackCannotFeaturePerennialBranch      = "branch %q is a perennial branch and therefore be a feature branch"
-	HostingBitBucketNotImplemented        = "shipping pull requests via the Bitbucket API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
+	HostingAzureDevOpsAPIError            = "Azure DevOps API: %s %s returned status %d: %s"
+	HostingAzureDevOpsMergingViaAPI       = "Azure DevOps API: Completing PR %d ... "
+	HostingAzureDevOpsUpdatePRViaAPI      = "Azure DevOps API: Updating target branch for PR %d to %q ... "
+	HostingBitbucketAPIError              = "Bitbucket API: %s %s returned status %d: %s"
+	HostingBitbucketMergingViaAPI         = "Bitbucket API: Merging PR #%d ... "
+	HostingBitbucketUpdatePRViaAPI        = "Bitbucket API: Updating target branch for PR #%d to %q ... "
+	HostingCAFileNoCertificates           = "the CA file %q for the hosting API contains no PEM certificates"
+	HostingCAFileProblem                  = "cannot read the CA file %q for the hosting API: %w"
+	HostingGitlabCreateMRViaAPI           = "GitLab API: Creating MR from %q into %q ... "
+	HostingGitlabEnableAutoMergeViaAPI    = "GitLab API: Enabling merge when pipeline succeeds for MR !%d ... "
 	HostingGitlabMergingViaAPI            = "GitLab API: Merging MR !%d ... "
+	HostingGitlabRebaseTimeout            = "GitLab is still rebasing MR !%d after %s, please ship again once the rebase has finished"
+	HostingGitlabRebasingViaAPI           = "GitLab API: Rebasing MR !%d ... "
 	HostingGitlabUpdateMRViaAPI           = "GitLab API: Updating target branch for MR !%d to %q ... "
-	HostingGiteaNotImplemented            = "shipping pull requests via the Gitea API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
-	HostingGiteaUpdatePRViaAPI            = "Gitea API: Updating base branch for PR #%d to #%s"
+	HostingGitlabUserNotFound             = "GitLab has no user with the username %q"
+	HostingGiteaCreatePRViaAPI            = "Gitea API: Creating PR from %q into %q ... "
+	HostingGiteaEnableAutoMergeViaAPI     = "Gitea API: Scheduling merge of PR #%d ... "
+	HostingGiteaLabelNotFound             = "the Gitea repository has no label %q"
+	HostingGiteaMergingViaAPI             = "Gitea API: Merging PR #%d ... "
+	HostingGiteaUpdatePRViaAPI            = "Gitea API: Updating base branch for PR #%d to %q ... "
+	HostingGithubCreatePRViaAPI           = "GitHub API: creating PR from %q into %q ... "
+	HostingGithubEnableAutoMergeViaAPI    = "GitHub API: enabling auto-merge for PR #%d ... "
+	HostingGithubGraphQLError             = "GitHub GraphQL API: %s"
 	HostingGithubMergingViaAPI            = "GitHub API: merging PR #%d ... "
 	HostingPlatformUnknown                = "unknown hosting platform: %q"
+	HostingProposalMetadataProblem        = "Warning: cannot add all labels, assignees, and reviewers to the proposal: %v"
+	ImportLineage                         = "Import lineage: %s\n"
+	ImportLineageNoChanges                = "the parent branches already match the proposals"
+	ImportLineageUnsupported              = "importing the lineage from proposals is not supported for this hosting platform"
 	InputAddOrRemove                      = `invalid argument %q. Please provide either "add" or "remove"`
 	InputYesOrNo                          = `invalid argument: %q. Please provide either "yes" or "no".\n`
 	KillBranchOtherWorktree               = `branch %q is active in another worktree`
@@ -125,6 +167,10 @@ END OUTPUT FROM 'git branch -vva'
 	MainBranchCannotPropose               = "cannot propose the main branch"
 	MainBranchCannotPrototype             = "cannot prototype the main branch"
 	MainBranchCannotShip                  = "cannot ship the main branch"
+	MergeCommitMessageBranch              = "Merge branch '%s'"
+	MergeCommitMessageInto                = "%s into %s"
+	MergeCommitMessageRemoteBranch        = "Merge remote-tracking branch '%s'"
+	MergeTreeUnexpectedOutput             = "unexpected output of \"git merge-tree\": %q"
 	ObservedBranchCannotPark              = "cannot park observed branches"
 	ObservedBranchCannotPropose           = "cannot propose observed branches"
 	ObservedBranchCannotShip              = "cannot ship observed branches"
@@ -147,12 +193,24 @@ END OUTPUT FROM 'git branch -vva'
 	PerennialRegex                        = "Perennial regex: %s\n"
 	PreviousCommandFinished               = "The previous Git Town command (%s) finished successfully.\n"
 	PreviousCommandProblem                = "The last Git Town command (%s) hit a problem %v ago.\n"
+	ProposalBranchType                    = "Branch type: %s\n"
+	ProposalCreateAPIUnsupported          = "creating proposals via the API is not supported for this hosting platform"
 	ProposalMultipleFound                 = "found %d proposals from branch %q to branch %q"
+	ProposalMultipleFromBranch            = "found %d proposals from branch %q"
 	ProposalNoNumberGiven                 = "no proposal number given"
 	ProposalNoParent                      = "branch %q has no parent and can therefore not be proposed"
 	ProposalNotFoundForBranch             = "cannot determine proposal for branch %q: %w"
 	ProposalTargetBranchUpdateProblem     = "cannot update the target branch of proposal %d via the API"
+	ProposalTitleMissing                  = "cannot create a proposal without a title, please provide one via --title"
 	ProposalURLProblem                    = "cannot determine proposal URL from %q to %q: %w"
+	ProposalMergedProblem                 = "cannot determine whether the proposal of branch %q was merged: %v"
+	ProposalStackSectionProblem           = "cannot update the stack section in the proposals: %v"
+	ProposalsBranch                       = "%s  #%d %s (%s)"
+	ProposalsBranchNone                   = "%s  (no proposal)"
+	ProposalsNoBranches                   = "there are no branches with a parent branch"
+	ProposalsTableHeader                  = "BRANCH\tPROPOSAL\tURL"
+	ProposalsTableNone                    = "(none)"
+	ProposalsTargetMismatch               = "warning: the proposal targets %q but the parent branch is %q"
 	PrototypeBranchIsNowPrototype         = "branch %q is now a prototype branch\n"
 	PrototypeRemoved                      = "branch %q is no longer a prototype branch"
 	PullRequestDeprecation                = `DEPRECATION NOTICE
@@ -191,6 +249,8 @@ nd will be removed in future versions of Git Town.`
 	ShipBranchNotInSync            = "branch %q is not in sync"
 	ShipAbortedMergeError          = "aborted because merge exited with error"
 	ShipAPIConnectorRequired       = "shipping via the API requires a connector"
+	ShipAPIAutoMergeEnabled        = "Enabled auto-merge for the proposal of branch %q.\nRun \"git town sync\" after it got merged to remove the branch."
+	ShipAPIAutoMergeUnsupported    = "enabling auto-merge is not supported for this hosting platform"
 	ShipBranchOtherWorktree        = "branch %q is active in another worktree"
 	ShipBranchHasNoParent          = "branch %q has no parent to ship into"
 	ShipBranchNothingToDo          = "the branch %q has no shippable changes"
@@ -198,7 +258,13 @@ nd will be removed in future versions of Git Town.`
 	ShipDeletesTrackingBranches    = "Ship deletes tracking branches: %s\n"
 	ShipAPINoProposal              = "cannot ship branch %q via API because it has no proposal"
 	ShipAPINoRemoteBranch          = "cannot ship branch %q via API because it has no remote branch"
+	ShipAPIProposalChangesRequest  = "cannot ship branch %q because reviewers requested changes to its proposal, ship with --force to ignore this"
+	ShipAPIProposalChecksFailed    = "cannot ship branch %q because the CI checks of its proposal have failed, ship with --force to ignore this"
+	ShipAPIProposalNotApproved     = "cannot ship branch %q because its proposal still needs approving reviews, ship with --force to ignore this"
+	ShipAPIStrategyUnsupported     = "the %q ship strategy is not supported for this hosting platform"
+	ShipMessageWithAPIRebase       = "shipping with the api-rebase strategy does not use the given commit message"
 	ShipMessageWithFastForward     = "shipping with the fast-forward strategy does not use the given commit message"
+	ShipAutoMergeRequiresAPI       = "shipping with --auto requires one of the api ship strategies"
 	ShipOpenChanges                = "you have uncommitted changes. Did you mean to commit them before shipping?"
 	ShippableChangesProblem        = "cannot determine whether branch %q has shippable changes: %w"
 	SkipBranchHasConflicts         = "cannot skip branch that resulted in conflicts"
@@ -214,6 +280,12 @@ nd will be removed in future versions of Git Town.`
 	SquashMessageProblem           = "cannot comment out the squash commit message: %w"
 	StatusFileNotFound             = "No status file found for this repository."
 	SwitchUncommittedChanges       = "uncommitted changes\n"
+	SyncCheckConflicts             = "syncing would run into conflicts"
+	SyncCheckGitVersionTooLow      = "sync --check needs Git 2.38 or higher to simulate merges, you have Git %d.%d"
+	SyncCheckNoConflicts           = "Syncing runs into no conflicts."
+	SyncCheckSimulatedMerge        = "simulated merge of %s into %s"
+	SyncConflictsPreview           = "Syncing will run into conflicts:"
+	SyncConflictsPreviewEntry      = "  branch %q conflicts with %q in %s"
 	SyncFeatureBranches            = "Sync feature branches: %s\n"
 	SyncPerennialBranches          = "Sync perennial branches: %s\n"
 	SyncStatusNotRecognized        = "cannot determine the sync status for Git remote %q and branch name %q"
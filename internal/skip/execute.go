@@ -32,6 +32,8 @@ func Execute(args ExecuteArgs) error {
 		Git:           args.Git,
 		Prog:          args.RunState.AbortProgram,
 	})
+	// the aborted operation is done, skipping another branch later must not abort it again
+	args.RunState.AbortProgram = program.Program{}
 	err := revertChangesToCurrentBranch(args)
 	if err != nil {
 		return err
@@ -73,18 +75,17 @@ type ExecuteArgs struct {
 	Verbose         configdomain.Verbose
 }
 
-// removes the remaining opcodes for the current branch from the given program
+// removes the remaining opcodes for the current branch from the given program,
+// keeping the opcodes of the following branches including the markers that end their programs
 func removeOpcodesForCurrentBranch(prog program.Program) program.Program {
 	result := make(program.Program, 0, len(prog)-1)
 	skipping := true
 	for _, opcode := range prog {
-		if shared.IsEndOfBranchProgramOpcode(opcode) {
-			skipping = false
+		if skipping {
+			skipping = !shared.IsEndOfBranchProgramOpcode(opcode)
 			continue
 		}
-		if !skipping {
-			result.Add(opcode)
-		}
+		result.Add(opcode)
 	}
 	return result
 }
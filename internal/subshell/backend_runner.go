@@ -27,20 +27,31 @@ type BackendRunner struct {
 }
 
 func (self BackendRunner) Query(executable string, args ...string) (string, error) {
-	return self.execute(executable, args...)
+	return self.execute(None[string](), executable, args...)
 }
 
 func (self BackendRunner) QueryTrim(executable string, args ...string) (string, error) {
-	output, err := self.execute(executable, args...)
+	output, err := self.execute(None[string](), executable, args...)
 	return strings.TrimSpace(stripansi.Strip(output)), err
 }
 
+// QueryWithInput provides the output of the given command, which receives the given input via STDIN.
+func (self BackendRunner) QueryWithInput(input string, executable string, args ...string) (string, error) {
+	return self.execute(Some(input), executable, args...)
+}
+
 func (self BackendRunner) Run(executable string, args ...string) error {
-	_, err := self.execute(executable, args...)
+	_, err := self.execute(None[string](), executable, args...)
 	return err
 }
 
-func (self BackendRunner) execute(executable string, args ...string) (string, error) {
+// RunInDir runs the given command in the given directory.
+func (self BackendRunner) RunInDir(dir string, executable string, args ...string) error {
+	self.Dir = Some(dir)
+	return self.Run(executable, args...)
+}
+
+func (self BackendRunner) execute(input Option[string], executable string, args ...string) (string, error) {
 	self.CommandsCounter.Value.Inc()
 	if self.Verbose {
 		printHeader(executable, args...)
@@ -50,6 +61,9 @@ func (self BackendRunner) execute(executable string, args ...string) (string, er
 		subProcess.Dir = dir
 	}
 	subProcess.Env = append(subProcess.Environ(), "LC_ALL=C")
+	if input, hasInput := input.Get(); hasInput {
+		subProcess.Stdin = strings.NewReader(input)
+	}
 	concurrentGitRetriesLeft := concurrentGitRetries
 	var outputText string
 	var outputBytes []byte
@@ -34,3 +34,8 @@ func (self *FrontendDryRunner) Run(executable string, args ...string) error {
 	}
 	return nil
 }
+
+// RunInDir prints the given command as if it ran in the given directory.
+func (self *FrontendDryRunner) RunInDir(_ string, executable string, args ...string) error {
+	return self.Run(executable, args...)
+}
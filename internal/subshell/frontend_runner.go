@@ -59,7 +59,16 @@ func PrintCommand(branch gitdomain.LocalBranchName, printBranch bool, cmd string
 }
 
 // Run runs the given command in this ShellRunner's directory.
-func (self *FrontendRunner) Run(cmd string, args ...string) (err error) {
+func (self *FrontendRunner) Run(cmd string, args ...string) error {
+	return self.execute(None[string](), cmd, args...)
+}
+
+// RunInDir runs the given command in the given directory.
+func (self *FrontendRunner) RunInDir(dir string, cmd string, args ...string) error {
+	return self.execute(Some(dir), cmd, args...)
+}
+
+func (self *FrontendRunner) execute(dir Option[string], cmd string, args ...string) (err error) {
 	self.CommandsCounter.Value.Inc()
 	var branchName gitdomain.LocalBranchName
 	if self.PrintBranchNames {
@@ -78,6 +87,9 @@ func (self *FrontendRunner) Run(cmd string, args ...string) (err error) {
 	concurrentGitRetriesLeft := concurrentGitRetries
 	for {
 		subProcess := exec.Command(cmd, args...)
+		if dir, hasDir := dir.Get(); hasDir {
+			subProcess.Dir = dir
+		}
 		var stderrBuffer bytes.Buffer // we only need to look at STDERR since that's where Git will print error messages
 		subProcess.Stderr = io.MultiWriter(os.Stderr, &stderrBuffer)
 		subProcess.Stdin = os.Stdin
@@ -0,0 +1,178 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v16/internal/config"
+	"github.com/git-town/git-town/v16/internal/git"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+	"github.com/git-town/git-town/v16/internal/vm/opcodes"
+	"github.com/git-town/git-town/v16/internal/vm/program"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// BranchConflict describes a conflict that syncing a branch would run into.
+type BranchConflict struct {
+	Branch gitdomain.BranchName // the branch that receives the conflicting changes
+	Files  []string             // the files that conflict
+	Source gitdomain.BranchName // the branch whose changes conflict
+}
+
+// DetectConflicts simulates the merges and rebases in the given program using "git merge-tree"
+// and provides the conflicts they would run into.
+// This doesn't modify the worktree, the branches, or the runstate.
+func DetectConflicts(args DetectConflictsArgs) ([]BranchConflict, error) {
+	simulation := conflictSimulation{
+		DetectConflictsArgs: args,
+		conflicts:           []BranchConflict{},
+		currentBranch:       args.InitialBranch,
+		merges:              map[gitdomain.BranchName]simulatedMerge{},
+		tips:                map[gitdomain.BranchName]Option[gitdomain.SHA]{},
+	}
+	for _, opcode := range args.Program {
+		var err error
+		switch opcode := opcode.(type) {
+		case *opcodes.Checkout:
+			simulation.currentBranch = opcode.Branch
+		case *opcodes.CheckoutIfExists:
+			simulation.currentBranch = opcode.Branch
+		case *opcodes.Merge:
+			err = simulation.merge(opcode.Branch, false)
+		case *opcodes.MergeParent:
+			if parent, hasParent := simulation.parent(opcode.CurrentBranch, opcode.ParentActiveInOtherWorktree).Get(); hasParent {
+				err = simulation.merge(parent, true)
+			}
+		case *opcodes.RebaseBranch:
+			err = simulation.merge(opcode.Branch, false)
+		case *opcodes.RebaseFeatureTrackingBranch:
+			err = simulation.merge(opcode.RemoteBranch.BranchName(), false)
+		case *opcodes.RebaseParent:
+			if parent, hasParent := simulation.parent(opcode.CurrentBranch, opcode.ParentActiveInOtherWorktree).Get(); hasParent {
+				err = simulation.merge(parent, true)
+			}
+		}
+		if err != nil {
+			return simulation.conflicts, err
+		}
+	}
+	return simulation.conflicts, nil
+}
+
+type DetectConflictsArgs struct {
+	Backend       gitdomain.Querier
+	Config        config.ValidatedConfig
+	Git           git.Commands
+	InitialBranch gitdomain.LocalBranchName
+	Program       program.Program
+}
+
+// conflictSimulation tracks the simulated state of the branches while detecting conflicts.
+//
+// The simulation merges the tip of the synced branch with the tip of the branch it syncs with,
+// like "git merge" would.
+// Rebases are approximated by such a merge as well,
+// which can miss conflicts that only individual commits of the rebased branch run into.
+// Clean merges result in dangling merge commits that subsequent simulated merges build on.
+// The simulation creates these commits only when a subsequent merge needs them.
+type conflictSimulation struct {
+	DetectConflictsArgs
+	conflicts     []BranchConflict
+	currentBranch gitdomain.LocalBranchName
+	merges        map[gitdomain.BranchName]simulatedMerge        // clean merges whose merge commit hasn't been created yet
+	tips          map[gitdomain.BranchName]Option[gitdomain.SHA] // the simulated tip of each branch, None if unknown
+}
+
+// simulatedMerge describes a clean merge whose merge commit hasn't been created yet
+type simulatedMerge struct {
+	message gitdomain.CommitMessage
+	parents []gitdomain.SHA
+	tree    gitdomain.SHA
+}
+
+// simulates merging the given branch into the current branch,
+// autoResolve indicates whether the conflict resolution rules apply to this merge
+func (self *conflictSimulation) merge(source gitdomain.BranchName, autoResolve bool) error {
+	target := self.currentBranch.BranchName()
+	targetTipOpt, err := self.tip(target)
+	if err != nil {
+		return err
+	}
+	sourceTipOpt, err := self.tip(source)
+	if err != nil {
+		return err
+	}
+	targetTip, hasTargetTip := targetTipOpt.Get()
+	sourceTip, hasSourceTip := sourceTipOpt.Get()
+	if !hasTargetTip || !hasSourceTip || targetTip == sourceTip {
+		return nil
+	}
+	if self.Git.IsAncestor(self.Backend, sourceTip, targetTip.Location()) {
+		// the target branch already contains the source branch
+		return nil
+	}
+	if self.Git.IsAncestor(self.Backend, targetTip, sourceTip.Location()) {
+		// fast-forward
+		self.tips[target] = Some(sourceTip)
+		return nil
+	}
+	tree, conflictingFiles, err := self.Git.MergeTree(self.Backend, targetTip, sourceTip)
+	if err != nil {
+		return err
+	}
+	delete(self.tips, target)
+	if len(conflictingFiles) > 0 {
+		if !autoResolve || self.Config.Config.ConflictResolutions.ForFiles(conflictingFiles).IsNone() {
+			self.conflicts = append(self.conflicts, BranchConflict{
+				Branch: target,
+				Files:  conflictingFiles,
+				Source: source,
+			})
+		}
+		// the content of the target branch after resolving the conflicts is unknown
+		self.tips[target] = None[gitdomain.SHA]()
+		return nil
+	}
+	self.merges[target] = simulatedMerge{
+		message: gitdomain.CommitMessage(fmt.Sprintf(messages.SyncCheckSimulatedMerge, source, target)),
+		parents: []gitdomain.SHA{targetTip, sourceTip},
+		tree:    tree,
+	}
+	return nil
+}
+
+// provides the branch that the given branch syncs with
+func (self *conflictSimulation) parent(branch gitdomain.LocalBranchName, parentActiveInOtherWorktree bool) Option[gitdomain.BranchName] {
+	parent, hasParent := self.Config.Config.Lineage.Parent(branch).Get()
+	if !hasParent {
+		return None[gitdomain.BranchName]()
+	}
+	if parentActiveInOtherWorktree {
+		return Some(self.Git.TrackingBranch(self.Backend, parent, self.Config.Config.PushRemote).BranchName())
+	}
+	return Some(parent.BranchName())
+}
+
+// provides the tip of the given branch in the simulation
+func (self *conflictSimulation) tip(branch gitdomain.BranchName) (Option[gitdomain.SHA], error) {
+	if tip, known := self.tips[branch]; known {
+		return tip, nil
+	}
+	if merge, hasMerge := self.merges[branch]; hasMerge {
+		mergeSHA, err := self.Git.CommitTree(self.Backend, merge.tree, merge.message, merge.parents...)
+		if err != nil {
+			return None[gitdomain.SHA](), err
+		}
+		delete(self.merges, branch)
+		self.tips[branch] = Some(mergeSHA)
+		return Some(mergeSHA), nil
+	}
+	sha, err := self.Git.SHAForBranch(self.Backend, branch)
+	if err != nil {
+		// the branch doesn't exist yet, for example because the program creates it
+		self.tips[branch] = None[gitdomain.SHA]()
+		return None[gitdomain.SHA](), nil //nolint:nilerr
+	}
+	self.tips[branch] = Some(sha)
+	return Some(sha), nil
+}
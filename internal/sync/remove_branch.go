@@ -14,6 +14,7 @@ func RemoveBranchFromLineage(args RemoveBranchFromLineageArgs) {
 		args.Program.Value.Add(&opcodes.ChangeParent{Branch: child, Parent: args.Parent})
 	}
 	args.Program.Value.Add(&opcodes.DeleteParentBranch{Branch: args.Branch})
+	args.Program.Value.Add(&opcodes.RemoveSyncStrategyOverride{Branch: args.Branch})
 }
 
 type RemoveBranchFromLineageArgs struct {
@@ -36,6 +36,8 @@ type BranchProgramArgs struct {
 	Program            Mutable[program.Program]
 	PushBranches       configdomain.PushBranches
 	Remotes            gitdomain.Remotes
+	// whether to sync branches that aren't checked out by updating their refs directly where possible
+	SyncWithoutCheckout bool
 }
 
 // ExistingBranchProgram provides the program to sync a particular branch.
@@ -49,8 +51,12 @@ func ExistingBranchProgram(list Mutable[program.Program], branch gitdomain.Branc
 		// perennial branch but no remote --> this branch cannot be synced
 		return
 	}
-	list.Value.Add(&opcodes.Checkout{Branch: localName})
 	branchType := args.Config.BranchType(localName)
+	syncStrategy := branchSyncStrategy(localName, branchType, args.Config)
+	if args.SyncWithoutCheckout && localName != args.InitialBranch {
+		syncBranchWithoutCheckoutProgram(list, branch, branchType, parentOtherWorktree, args)
+	}
+	list.Value.Add(&opcodes.Checkout{Branch: localName})
 	switch branchType {
 	case configdomain.BranchTypeFeatureBranch:
 		FeatureBranchProgram(featureBranchArgs{
@@ -62,7 +68,7 @@ func ExistingBranchProgram(list Mutable[program.Program], branch gitdomain.Branc
 			program:             list,
 			pushBranches:        args.PushBranches,
 			remoteName:          branch.RemoteName,
-			syncStrategy:        args.Config.SyncFeatureStrategy.SyncStrategy(),
+			syncStrategy:        syncStrategy.SyncStrategy(),
 		})
 	case configdomain.BranchTypePerennialBranch, configdomain.BranchTypeMainBranch:
 		PerennialBranchProgram(branch, args)
@@ -76,7 +82,7 @@ func ExistingBranchProgram(list Mutable[program.Program], branch gitdomain.Branc
 			program:             list,
 			pushBranches:        args.PushBranches,
 			remoteName:          branch.RemoteName,
-			syncStrategy:        args.Config.SyncFeatureStrategy.SyncStrategy(),
+			syncStrategy:        syncStrategy.SyncStrategy(),
 		})
 	case configdomain.BranchTypeContributionBranch:
 		ContributionBranchProgram(args.Program, branch)
@@ -92,7 +98,7 @@ func ExistingBranchProgram(list Mutable[program.Program], branch gitdomain.Branc
 			program:             list,
 			pushBranches:        false,
 			remoteName:          branch.RemoteName,
-			syncStrategy:        args.Config.SyncPrototypeStrategy.SyncStrategy(),
+			syncStrategy:        syncStrategy.SyncStrategy(),
 		})
 	}
 	if args.PushBranches.IsTrue() && args.Remotes.HasOrigin() && args.Config.IsOnline() && branchType.ShouldPush(localName == args.InitialBranch) {
@@ -102,9 +108,29 @@ func ExistingBranchProgram(list Mutable[program.Program], branch gitdomain.Branc
 		case isMainOrPerennialBranch:
 			list.Value.Add(&opcodes.PushCurrentBranch{CurrentBranch: localName})
 		default:
-			pushFeatureBranchProgram(list, localName, args.Config.SyncFeatureStrategy)
+			pushFeatureBranchProgram(list, localName, syncStrategy)
+		}
+	}
+}
+
+// branchSyncStrategy provides the sync strategy for the given branch that syncs with its parent like a feature branch.
+// Sync strategies configured for individual feature, parked, and prototype branches override the sync strategy for their branch type.
+func branchSyncStrategy(branch gitdomain.LocalBranchName, branchType configdomain.BranchType, config configdomain.ValidatedConfig) configdomain.SyncFeatureStrategy {
+	override, hasOverride := config.SyncStrategyOverrides.SyncStrategy(branch).Get()
+	switch branchType {
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch:
+		if hasOverride {
+			return configdomain.SyncFeatureStrategy(override)
+		}
+	case configdomain.BranchTypePrototypeBranch:
+		if hasOverride {
+			return configdomain.SyncFeatureStrategy(override)
 		}
+		return configdomain.SyncFeatureStrategy(config.SyncPrototypeStrategy)
+	case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypePerennialBranch:
+		// these branches don't sync with a parent branch, overrides don't apply to them
 	}
+	return config.SyncFeatureStrategy
 }
 
 // pullParentBranchOfCurrentFeatureBranchOpcode adds the opcode to pull updates from the parent branch of the current feature branch into the current feature branch.
@@ -137,6 +163,44 @@ func pushFeatureBranchProgram(list Mutable[program.Program], branch gitdomain.Lo
 	}
 }
 
+// syncBranchWithoutCheckoutProgram adds the opcode to sync the given branch without checking it out, if that is possible for this type of branch.
+// The regular opcodes that follow it sync the branch if the branch needs a checkout at runtime.
+func syncBranchWithoutCheckoutProgram(list Mutable[program.Program], branch gitdomain.BranchInfo, branchType configdomain.BranchType, parentOtherWorktree bool, args BranchProgramArgs) {
+	localName := branch.LocalName.GetOrPanic()
+	trackingBranch := branch.RemoteName
+	var syncStrategy configdomain.SyncStrategy
+	switch branchType {
+	case configdomain.BranchTypeFeatureBranch:
+		syncStrategy = branchSyncStrategy(localName, branchType, args.Config).SyncStrategy()
+		if syncStrategy == configdomain.SyncStrategyRebase && args.Config.Offline.IsTrue() {
+			trackingBranch = None[gitdomain.RemoteBranchName]()
+		}
+	case configdomain.BranchTypePrototypeBranch:
+		syncStrategy = branchSyncStrategy(localName, branchType, args.Config).SyncStrategy()
+	case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
+		if localName == args.Config.MainBranch && args.Remotes.HasUpstream() && args.Config.SyncUpstream.IsTrue() {
+			return
+		}
+		syncStrategy = args.Config.SyncPerennialStrategy.SyncStrategy()
+	case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch:
+		return
+	}
+	if syncStrategy == configdomain.SyncStrategyCompress {
+		return
+	}
+	pushBranch := args.PushBranches.IsTrue() && args.Remotes.HasOrigin() && args.Config.IsOnline() && branchType.ShouldPush(false)
+	if pushBranch && !branch.HasTrackingBranch() {
+		return
+	}
+	list.Value.Add(&opcodes.SyncBranchWithoutCheckout{
+		Branch:                      localName,
+		ParentActiveInOtherWorktree: parentOtherWorktree,
+		PushBranch:                  pushBranch,
+		SyncStrategy:                syncStrategy,
+		TrackingBranch:              trackingBranch,
+	})
+}
+
 // updateCurrentPerennialBranchOpcode provides the opcode to update the current perennial branch with changes from the given other branch.
 func updateCurrentPerennialBranchOpcode(list Mutable[program.Program], otherBranch gitdomain.RemoteBranchName, strategy configdomain.SyncPerennialStrategy) {
 	switch strategy {
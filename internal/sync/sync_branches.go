@@ -12,7 +12,18 @@ import (
 func BranchesProgram(args BranchesProgramArgs) {
 	for _, branchToSync := range args.BranchesToSync {
 		args.BranchProgramArgs.FirstCommitMessage = branchToSync.FirstCommitMessage
-		BranchProgram(branchToSync.BranchInfo, args.BranchProgramArgs)
+		branch := branchToSync.BranchInfo
+		if branchToSync.ProposalMerged {
+			// the branch was shipped on the code hosting platform, sync it like a branch that was deleted at the remote
+			branch.SyncStatus = gitdomain.SyncStatusDeletedAtRemote
+			// the hosting platform didn't delete the tracking branch, delete it like "git town ship" does,
+			// but only if the user allows Git Town to push
+			remoteName, hasRemoteName := branch.RemoteName.Get()
+			if hasRemoteName && args.Config.ShipDeleteTrackingBranch.IsTrue() && args.PushBranches.IsTrue() && args.Config.IsOnline() {
+				args.Program.Value.Add(&opcodes.DeleteTrackingBranch{Branch: remoteName})
+			}
+		}
+		BranchProgram(branch, args.BranchProgramArgs)
 	}
 	previousbranchCandidates := []Option[gitdomain.LocalBranchName]{args.PreviousBranch}
 	// TODO: make this a list of options
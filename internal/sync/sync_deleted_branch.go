@@ -13,9 +13,10 @@ import (
 
 // syncDeletedBranchProgram adds opcodes that sync a branch that was deleted at origin to the given program.
 func syncDeletedBranchProgram(list Mutable[program.Program], branch gitdomain.LocalBranchName, parentOtherWorktree bool, args BranchProgramArgs) {
-	switch args.Config.BranchType(branch) {
+	branchType := args.Config.BranchType(branch)
+	switch branchType {
 	case configdomain.BranchTypeFeatureBranch:
-		syncDeletedFeatureBranchProgram(list, branch, parentOtherWorktree, args)
+		syncDeletedFeatureBranchProgram(list, branch, branchType, parentOtherWorktree, args)
 	case configdomain.BranchTypePerennialBranch, configdomain.BranchTypeMainBranch:
 		syncDeletedPerennialBranchProgram(list, branch, args)
 	case configdomain.BranchTypeObservedBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeParkedBranch:
@@ -27,13 +28,13 @@ func syncDeletedBranchProgram(list Mutable[program.Program], branch gitdomain.Lo
 
 // syncDeletedFeatureBranchProgram syncs a feare branch whose remote has been deleted.
 // The parent branch must have been fully synced before calling this function.
-func syncDeletedFeatureBranchProgram(list Mutable[program.Program], branch gitdomain.LocalBranchName, parentOtherWorktree bool, args BranchProgramArgs) {
+func syncDeletedFeatureBranchProgram(list Mutable[program.Program], branch gitdomain.LocalBranchName, branchType configdomain.BranchType, parentOtherWorktree bool, args BranchProgramArgs) {
 	list.Value.Add(&opcodes.Checkout{Branch: branch})
 	pullParentBranchOfCurrentFeatureBranchOpcode(pullParentBranchOfCurrentFeatureBranchOpcodeArgs{
 		branch:              branch,
 		parentOtherWorktree: parentOtherWorktree,
 		program:             list,
-		syncStrategy:        args.Config.SyncFeatureStrategy,
+		syncStrategy:        branchSyncStrategy(branch, branchType, args.Config),
 	})
 	list.Value.Add(&opcodes.DeleteBranchIfEmptyAtRuntime{Branch: branch})
 }
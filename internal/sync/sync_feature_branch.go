@@ -39,7 +39,7 @@ type featureBranchArgs struct {
 	program             Mutable[program.Program] // the program to update
 	pushBranches        configdomain.PushBranches
 	remoteName          Option[gitdomain.RemoteBranchName]
-	syncStrategy        configdomain.SyncStrategy // the sync-feature-strategy
+	syncStrategy        configdomain.SyncStrategy // the sync strategy for this branch
 }
 
 func syncFeatureBranchCompressProgram(args syncFeatureBranchProgramArgs) {
@@ -76,6 +76,7 @@ func syncFeatureBranchRebaseProgram(args syncFeatureBranchProgramArgs) {
 			args.program.Value.Add(&opcodes.RebaseFeatureTrackingBranch{RemoteBranch: trackingBranch, PushBranches: args.pushBranches})
 		}
 	}
+	args.program.Value.Add(&opcodes.UpdateParentSHA{Branch: args.localName, ParentActiveInOtherWorktree: args.parentOtherWorktree})
 }
 
 type syncFeatureBranchProgramArgs struct {
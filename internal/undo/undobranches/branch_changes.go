@@ -118,7 +118,9 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 
 	// remove remotely added branches
 	for _, addedRemoteBranch := range self.RemoteAdded {
-		if addedRemoteBranch.Remote() != gitdomain.RemoteUpstream {
+		// Git Town pushes only to origin and the push remote,
+		// branches at other remotes like upstream or forks appear because Git Town fetched them
+		if remote := addedRemoteBranch.Remote(); remote == gitdomain.RemoteOrigin || remote == args.Config.PushRemote {
 			result.Add(&opcodes.DeleteTrackingBranch{
 				Branch: addedRemoteBranch,
 			})
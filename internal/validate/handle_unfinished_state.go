@@ -81,8 +81,8 @@ type UnfinishedStateArgs struct {
 }
 
 func continueRunstate(runState runstate.RunState, args UnfinishedStateArgs) (bool, error) {
-	if args.RepoStatus.Conflicts {
-		return false, errors.New(messages.ContinueUnresolvedConflicts)
+	if err := NoUnresolvedConflicts(args.Backend, args.Git, args.RepoStatus, args.UnvalidatedConfig.Config.Value.ConflictResolutions); err != nil {
+		return false, err
 	}
 	validatedConfig, exit, err := quickValidateConfig(quickValidateConfigArgs{
 		backend:      args.Backend,
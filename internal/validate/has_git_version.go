@@ -6,7 +6,7 @@ import (
 	"github.com/git-town/git-town/v16/internal/messages"
 )
 
-// HasAcceptableGitVersion verifies that the system has Git of version 2.7 or newer installed.
+// HasAcceptableGitVersion verifies that the system has Git of version 2.30 or newer installed.
 func HasAcceptableGitVersion(majorVersion, minorVersion int) error {
 	if !IsAcceptableGitVersion(majorVersion, minorVersion) {
 		return errors.New(messages.GitVersionTooLow)
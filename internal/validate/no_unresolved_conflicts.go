@@ -0,0 +1,28 @@
+package validate
+
+import (
+	"errors"
+
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/git"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+)
+
+// NoUnresolvedConflicts verifies that the repo contains no conflicts
+// except those that the given conflict resolutions resolve automatically.
+func NoUnresolvedConflicts(backend gitdomain.Querier, gitCommands git.Commands, repoStatus gitdomain.RepoStatus, resolutions configdomain.ConflictResolutions) error {
+	if !repoStatus.Conflicts {
+		return nil
+	}
+	if len(resolutions) > 0 {
+		conflictingFiles, err := gitCommands.ConflictingFiles(backend)
+		if err != nil {
+			return err
+		}
+		if resolutions.ForFiles(conflictingFiles).IsSome() {
+			return nil
+		}
+	}
+	return errors.New(messages.ContinueUnresolvedConflicts)
+}
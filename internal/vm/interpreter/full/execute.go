@@ -31,7 +31,7 @@ func Execute(args ExecuteArgs) error {
 			})
 		}
 		stepName := gohacks.TypeName(nextStep)
-		if stepName == "SkipCurrentBranchProgram" {
+		if stepName == "SkipCurrentBranch" {
 			args.RunState.SkipCurrentBranchProgram()
 			continue
 		}
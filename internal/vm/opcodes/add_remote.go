@@ -0,0 +1,17 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/vm/shared"
+)
+
+// AddRemote adds a Git remote with the given name and URL.
+type AddRemote struct {
+	Remote                  gitdomain.Remote
+	URL                     string
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *AddRemote) Run(args shared.RunArgs) error {
+	return args.Git.AddRemote(args.Frontend, self.Remote, self.URL)
+}
@@ -0,0 +1,42 @@
+package opcodes
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+	"github.com/git-town/git-town/v16/internal/vm/shared"
+)
+
+// ConnectorCreateProposal creates a new proposal for the given branch via the API of the hosting platform
+// and prints the URL of the new proposal.
+type ConnectorCreateProposal struct {
+	Branch                  gitdomain.LocalBranchName
+	Metadata                hostingdomain.ProposalMetadata
+	ProposalBody            gitdomain.ProposalBody
+	ProposalTitle           gitdomain.ProposalTitle
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ConnectorCreateProposal) Run(args shared.RunArgs) error {
+	parentBranch, hasParentBranch := args.Config.Config.Lineage.Parent(self.Branch).Get()
+	if !hasParentBranch {
+		return fmt.Errorf(messages.ProposalNoParent, self.Branch)
+	}
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	proposalCreator, canCreateProposals := connector.(hostingdomain.ProposalCreator)
+	if !canCreateProposals {
+		return errors.New(messages.ProposalCreateAPIUnsupported)
+	}
+	proposal, err := proposalCreator.CreateProposal(self.Branch, parentBranch, self.ProposalTitle, self.ProposalBody, self.Metadata)
+	if err != nil {
+		return err
+	}
+	args.FinalMessages.Add(proposal.URL)
+	return nil
+}
@@ -0,0 +1,49 @@
+package opcodes
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+	"github.com/git-town/git-town/v16/internal/vm/shared"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// ConnectorEnableAutoMerge makes the hosting platform merge the proposal of the branch with the given name
+// using the merge method of the given ship strategy once the proposal meets all requirements for merging.
+type ConnectorEnableAutoMerge struct {
+	Branch                  gitdomain.LocalBranchName
+	CommitMessage           Option[gitdomain.CommitMessage]
+	DeleteTrackingBranch    configdomain.ShipDeleteTrackingBranch
+	ProposalNumber          int
+	ShipStrategy            configdomain.ShipStrategy
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ConnectorEnableAutoMerge) Run(args shared.RunArgs) error {
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	autoMerger, canAutoMerge := connector.(hostingdomain.ProposalAutoMerger)
+	if !canAutoMerge {
+		return errors.New(messages.ShipAPIAutoMergeUnsupported)
+	}
+	method := hostingdomain.ProposalMergeMethodSquash
+	switch self.ShipStrategy {
+	case configdomain.ShipStrategyAPIMerge:
+		method = hostingdomain.ProposalMergeMethodMerge
+	case configdomain.ShipStrategyAPIRebase:
+		method = hostingdomain.ProposalMergeMethodRebase
+	case configdomain.ShipStrategyAPI, configdomain.ShipStragegyFastForward, configdomain.ShipStrategySquashMerge:
+	}
+	err := autoMerger.EnableAutoMerge(self.ProposalNumber, method, self.CommitMessage.GetOrDefault(), self.DeleteTrackingBranch.IsTrue())
+	if err != nil {
+		return err
+	}
+	args.FinalMessages.Add(fmt.Sprintf(messages.ShipAPIAutoMergeEnabled, self.Branch))
+	return nil
+}
@@ -0,0 +1,48 @@
+package opcodes
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+	"github.com/git-town/git-town/v16/internal/vm/shared"
+)
+
+// ConnectorListProposals adds a table of the proposals of the given branches to the final messages.
+type ConnectorListProposals struct {
+	Branches                gitdomain.LocalBranchNames
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ConnectorListProposals) Run(args shared.RunArgs) error {
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	table := strings.Builder{}
+	writer := tabwriter.NewWriter(&table, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, messages.ProposalsTableHeader)
+	for _, branch := range self.Branches {
+		parentBranch, hasParentBranch := args.Config.Config.Lineage.Parent(branch).Get()
+		if !hasParentBranch {
+			continue
+		}
+		proposalOpt, err := connector.FindProposal(branch, parentBranch)
+		if err != nil {
+			return err
+		}
+		if proposal, hasProposal := proposalOpt.Get(); hasProposal {
+			fmt.Fprintf(writer, "%s\t#%d\t%s\n", branch, proposal.Number, proposal.URL)
+		} else {
+			fmt.Fprintf(writer, "%s\t%s\n", branch, messages.ProposalsTableNone)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	args.FinalMessages.Add(strings.TrimRight(table.String(), "\n"))
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
 	"github.com/git-town/git-town/v16/internal/git/gitdomain"
 	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
 	"github.com/git-town/git-town/v16/internal/messages"
@@ -11,12 +12,14 @@ import (
 	. "github.com/git-town/git-town/v16/pkg/prelude"
 )
 
-// ConnectorMergeProposal squash merges the branch with the given name into the current branch.
+// ConnectorMergeProposal merges the proposal of the branch with the given name
+// using the merge method of the given ship strategy.
 type ConnectorMergeProposal struct {
 	Branch                    gitdomain.LocalBranchName
 	CommitMessage             Option[gitdomain.CommitMessage]
 	ProposalMessage           string
 	ProposalNumber            int
+	ShipStrategy              configdomain.ShipStrategy
 	enteredEmptyCommitMessage bool
 	mergeError                error
 	undeclaredOpcodeMethods   `exhaustruct:"optional"`
@@ -37,6 +40,24 @@ func (self *ConnectorMergeProposal) CreateAutomaticUndoError() error {
 }
 
 func (self *ConnectorMergeProposal) Run(args shared.RunArgs) error {
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	switch self.ShipStrategy {
+	case configdomain.ShipStrategyAPIMerge, configdomain.ShipStrategyAPIRebase:
+		merger, canMerge := connector.(hostingdomain.ProposalMerger)
+		if !canMerge {
+			return fmt.Errorf(messages.ShipAPIStrategyUnsupported, self.ShipStrategy)
+		}
+		if self.ShipStrategy == configdomain.ShipStrategyAPIRebase {
+			self.mergeError = merger.RebaseMergeProposal(self.ProposalNumber)
+		} else {
+			self.mergeError = merger.MergeProposal(self.ProposalNumber, self.CommitMessage.GetOrDefault())
+		}
+		return self.mergeError
+	case configdomain.ShipStrategyAPI, configdomain.ShipStragegyFastForward, configdomain.ShipStrategySquashMerge:
+	}
 	commitMessage, hasCommitMessage := self.CommitMessage.Get()
 	//nolint:nestif
 	if !hasCommitMessage {
@@ -65,11 +86,7 @@ func (self *ConnectorMergeProposal) Run(args shared.RunArgs) error {
 		}
 		self.enteredEmptyCommitMessage = false
 	}
-	if connector, hasConnector := args.Connector.Get(); hasConnector {
-		self.mergeError = connector.SquashMergeProposal(self.ProposalNumber, commitMessage)
-	} else {
-		return hostingdomain.UnsupportedServiceError()
-	}
+	self.mergeError = connector.SquashMergeProposal(self.ProposalNumber, commitMessage)
 	return self.mergeError
 }
 
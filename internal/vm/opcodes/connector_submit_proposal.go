@@ -0,0 +1,56 @@
+package opcodes
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+	"github.com/git-town/git-town/v16/internal/vm/shared"
+)
+
+// ConnectorSubmitProposal makes sure that the given branch has a proposal
+// that targets the parent of the branch.
+// It creates a new proposal if the branch doesn't have one
+// and updates the target of an existing proposal that doesn't target the parent.
+type ConnectorSubmitProposal struct {
+	Branch                  gitdomain.LocalBranchName
+	Metadata                hostingdomain.ProposalMetadata
+	ProposalBody            gitdomain.ProposalBody
+	ProposalTitle           gitdomain.ProposalTitle
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ConnectorSubmitProposal) Run(args shared.RunArgs) error {
+	parentBranch, hasParentBranch := args.Config.Config.Lineage.Parent(self.Branch).Get()
+	if !hasParentBranch {
+		return fmt.Errorf(messages.ProposalNoParent, self.Branch)
+	}
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	proposalCreator, canCreateProposals := connector.(hostingdomain.ProposalCreator)
+	proposalSearcher, canSearchProposals := connector.(hostingdomain.ProposalSearcher)
+	if !canCreateProposals || !canSearchProposals {
+		return errors.New(messages.ProposalCreateAPIUnsupported)
+	}
+	existingProposal, err := connector.FindProposal(self.Branch, parentBranch)
+	if err != nil {
+		return err
+	}
+	if existingProposal.IsSome() {
+		return nil
+	}
+	// the branch might have a proposal that targets a different branch than its parent
+	mistargetedProposalOpt, err := proposalSearcher.SearchProposal(self.Branch)
+	if err != nil {
+		return err
+	}
+	if mistargetedProposal, hasMistargetedProposal := mistargetedProposalOpt.Get(); hasMistargetedProposal {
+		return connector.UpdateProposalTarget(mistargetedProposal.Number, parentBranch)
+	}
+	_, err = proposalCreator.CreateProposal(self.Branch, parentBranch, self.ProposalTitle, self.ProposalBody, self.Metadata)
+	return err
+}
@@ -0,0 +1,81 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+	"github.com/git-town/git-town/v16/internal/vm/shared"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// ConnectorUpdateStackSections updates the section that lists the stack
+// in the bodies of the proposals of all branches in the stack that the given branch belongs to.
+// Problems talking to the hosting platform don't stop the current Git Town command,
+// they only cause a warning.
+type ConnectorUpdateStackSections struct {
+	Branch                  gitdomain.LocalBranchName
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ConnectorUpdateStackSections) Run(args shared.RunArgs) error {
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector || args.Config.DryRun.IsTrue() {
+		return nil
+	}
+	err := updateStackSections(connector, args.Config.Config.Lineage, self.Branch)
+	if err != nil {
+		args.FinalMessages.Add(fmt.Sprintf(messages.ProposalStackSectionProblem, err))
+	}
+	return nil
+}
+
+func updateStackSections(connector hostingdomain.Connector, lineage configdomain.Lineage, branch gitdomain.LocalBranchName) error {
+	stack := lineage.StackOf(branch)
+	if len(stack) < 2 {
+		return nil
+	}
+	root := lineage.Ancestors(stack[0])
+	if len(root) == 0 {
+		return nil
+	}
+	entries := []hostingdomain.StackSectionEntry{{
+		Branch:   root[0],
+		Depth:    0,
+		Proposal: None[hostingdomain.Proposal](),
+	}}
+	for _, stackBranch := range stack {
+		parent, hasParent := lineage.Parent(stackBranch).Get()
+		if !hasParent {
+			continue
+		}
+		proposal, err := connector.FindProposal(stackBranch, parent)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, hostingdomain.StackSectionEntry{
+			Branch:   stackBranch,
+			Depth:    len(lineage.Ancestors(stackBranch)),
+			Proposal: proposal,
+		})
+	}
+	// several branches can resolve to the same proposal, update each proposal only once
+	updatedProposals := map[int]bool{}
+	for _, entry := range entries {
+		proposal, hasProposal := entry.Proposal.Get()
+		if !hasProposal || updatedProposals[proposal.Number] {
+			continue
+		}
+		updatedProposals[proposal.Number] = true
+		newBody := hostingdomain.WithStackSection(proposal.Body, hostingdomain.StackSection(entries, entry.Branch))
+		if newBody == proposal.Body {
+			continue
+		}
+		if err := connector.UpdateProposalBody(proposal.Number, newBody); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,13 +3,22 @@ package opcodes
 import "github.com/git-town/git-town/v16/internal/vm/shared"
 
 // ContinueMerge finishes an ongoing merge conflict
-// assuming all conflicts have been resolved by the user.
+// assuming all conflicts have been resolved by the user
+// or can be resolved using the conflict resolution rules in the configuration.
 type ContinueMerge struct {
 	undeclaredOpcodeMethods `exhaustruct:"optional"`
 }
 
 func (self *ContinueMerge) Run(args shared.RunArgs) error {
 	if args.Git.HasMergeInProgress(args.Backend) {
+		canResolve, err := canResolveConflicts(args)
+		if err != nil {
+			return err
+		}
+		if canResolve {
+			args.PrependOpcodes(&ResolveMergeConflicts{})
+			return nil
+		}
 		return args.Git.CommitNoEdit(args.Frontend)
 	}
 	return nil
@@ -3,7 +3,8 @@ package opcodes
 import "github.com/git-town/git-town/v16/internal/vm/shared"
 
 // ContinueRebase finishes an ongoing rebase operation
-// assuming all conflicts have been resolved by the user.
+// assuming all conflicts have been resolved by the user
+// or can be resolved using the conflict resolution rules in the configuration.
 type ContinueRebase struct {
 	undeclaredOpcodeMethods `exhaustruct:"optional"`
 }
@@ -20,7 +21,29 @@ func (self *ContinueRebase) Run(args shared.RunArgs) error {
 		return err
 	}
 	if repoStatus.RebaseInProgress {
-		return args.Git.ContinueRebase(args.Frontend)
+		if repoStatus.Conflicts {
+			canResolve, errResolve := canResolveConflicts(args)
+			if errResolve != nil {
+				return errResolve
+			}
+			if canResolve {
+				args.PrependOpcodes(&ResolveRebaseConflicts{})
+				return nil
+			}
+		}
+		err = args.Git.ContinueRebase(args.Frontend)
+		if err != nil {
+			canResolve, errResolve := canResolveConflicts(args)
+			if errResolve != nil {
+				return errResolve
+			}
+			if canResolve {
+				// the next commit of the rebase ran into conflicts that the conflict resolution rules resolve
+				args.PrependOpcodes(&ResolveRebaseConflicts{})
+				return nil
+			}
+		}
+		return err
 	}
 	return nil
 }
@@ -47,6 +47,7 @@ func Types() []shared.Opcode {
 	return []shared.Opcode{
 		&AbortMerge{},
 		&AbortRebase{},
+		&AddRemote{},
 		&AddToContributionBranches{},
 		&AddToObservedBranches{},
 		&AddToParkedBranches{},
@@ -59,7 +60,12 @@ func Types() []shared.Opcode {
 		&CheckoutParent{},
 		&ChangeParent{},
 		&CommitOpenChanges{},
+		&ConnectorCreateProposal{},
+		&ConnectorEnableAutoMerge{},
+		&ConnectorListProposals{},
 		&ConnectorMergeProposal{},
+		&ConnectorSubmitProposal{},
+		&ConnectorUpdateStackSections{},
 		&ContinueMerge{},
 		&ContinueRebase{},
 		&CreateAndCheckoutBranchExistingParent{},
@@ -74,6 +80,7 @@ func Types() []shared.Opcode {
 		&DropStash{},
 		&EndOfBranchProgram{},
 		&EnsureHasShippableChanges{},
+		&FetchRemoteBranch{},
 		&FetchUpstream{},
 		&ForcePushCurrentBranch{},
 		&DeleteBranchIfEmptyAtRuntime{},
@@ -94,10 +101,14 @@ func Types() []shared.Opcode {
 		&RemoveFromPrototypeBranches{},
 		&RemoveGlobalConfig{},
 		&RemoveLocalConfig{},
+		&RemoveRemote{},
+		&RemoveSyncStrategyOverride{},
 		&ResetCurrentBranch{},
 		&ResetCurrentBranchToParent{},
 		&ResetCurrentBranchToSHA{},
 		&ResetRemoteBranchToSHA{},
+		&ResolveMergeConflicts{},
+		&ResolveRebaseConflicts{},
 		&RestoreOpenChanges{},
 		&RevertCommit{},
 		&SetExistingParent{},
@@ -105,11 +116,14 @@ func Types() []shared.Opcode {
 		&SetLocalConfig{},
 		&SetParent{},
 		&SetParentIfBranchExists{},
+		&SetSyncStrategyOverride{},
 		&SkipCurrentBranch{},
 		&StageOpenChanges{},
 		&StashOpenChanges{},
 		&SquashMerge{},
+		&SyncBranchWithoutCheckout{},
 		&UndoLastCommit{},
+		&UpdateParentSHA{},
 		&UpdateProposalTarget{},
 	} //exhaustruct:ignore
 }
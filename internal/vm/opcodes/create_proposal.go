@@ -14,6 +14,7 @@ import (
 type CreateProposal struct {
 	Branch                  gitdomain.LocalBranchName
 	MainBranch              gitdomain.LocalBranchName
+	Metadata                hostingdomain.ProposalMetadata
 	ProposalBody            gitdomain.ProposalBody
 	ProposalTitle           gitdomain.ProposalTitle
 	undeclaredOpcodeMethods `exhaustruct:"optional"`
@@ -28,7 +29,7 @@ func (self *CreateProposal) Run(args shared.RunArgs) error {
 	if !hasConnector {
 		return hostingdomain.UnsupportedServiceError()
 	}
-	prURL, err := connector.NewProposalURL(self.Branch, parentBranch, self.MainBranch, self.ProposalTitle, self.ProposalBody)
+	prURL, err := connector.NewProposalURL(self.Branch, parentBranch, self.MainBranch, self.ProposalTitle, self.ProposalBody, self.Metadata)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,7 @@ import (
 	"github.com/git-town/git-town/v16/internal/vm/shared"
 )
 
-// CreateRemoteBranch pushes the given local branch up to origin.
+// CreateRemoteBranch pushes the given local branch up to the push remote.
 type CreateRemoteBranch struct {
 	Branch                  gitdomain.LocalBranchName
 	SHA                     gitdomain.SHA
@@ -13,5 +13,5 @@ type CreateRemoteBranch struct {
 }
 
 func (self *CreateRemoteBranch) Run(args shared.RunArgs) error {
-	return args.Git.CreateRemoteBranch(args.Frontend, self.SHA, self.Branch, args.Config.Config.NoPushHook())
+	return args.Git.CreateRemoteBranch(args.Frontend, args.Config.Config.PushRemote, self.SHA, self.Branch, args.Config.Config.NoPushHook())
 }
@@ -5,7 +5,7 @@ import (
 	"github.com/git-town/git-town/v16/internal/vm/shared"
 )
 
-// CreateTrackingBranch pushes the given local branch up to origin
+// CreateTrackingBranch pushes the given local branch up to the push remote
 // and marks it as tracking the current branch.
 type CreateTrackingBranch struct {
 	Branch                  gitdomain.LocalBranchName
@@ -13,5 +13,5 @@ type CreateTrackingBranch struct {
 }
 
 func (self *CreateTrackingBranch) Run(args shared.RunArgs) error {
-	return args.Git.CreateTrackingBranch(args.Frontend, self.Branch, gitdomain.RemoteOrigin, args.Config.Config.NoPushHook())
+	return args.Git.CreateTrackingBranch(args.Frontend, self.Branch, args.Config.Config.PushRemote, args.Config.Config.NoPushHook())
 }
@@ -0,0 +1,17 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/vm/shared"
+)
+
+// FetchRemoteBranch fetches the given branch from the given remote.
+type FetchRemoteBranch struct {
+	Branch                  gitdomain.LocalBranchName
+	Remote                  gitdomain.Remote
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *FetchRemoteBranch) Run(args shared.RunArgs) error {
+	return args.Git.FetchRemoteBranch(args.Frontend, self.Remote, self.Branch)
+}
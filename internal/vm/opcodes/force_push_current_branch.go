@@ -15,7 +15,7 @@ func (self *ForcePushCurrentBranch) Run(args shared.RunArgs) error {
 	if err != nil {
 		return err
 	}
-	shouldPush, err := args.Git.ShouldPushBranch(args.Backend, currentBranch)
+	shouldPush, err := args.Git.ShouldPushBranch(args.Backend, currentBranch, args.Git.TrackingBranch(args.Backend, currentBranch, args.Config.Config.PushRemote))
 	if err != nil {
 		return err
 	}
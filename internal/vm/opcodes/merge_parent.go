@@ -31,9 +31,20 @@ func (self *MergeParent) Run(args shared.RunArgs) error {
 	}
 	var branchToMerge gitdomain.BranchName
 	if self.ParentActiveInOtherWorktree {
-		branchToMerge = parent.TrackingBranch().BranchName()
+		branchToMerge = args.Git.TrackingBranch(args.Backend, parent, args.Config.Config.PushRemote).BranchName()
 	} else {
 		branchToMerge = parent.BranchName()
 	}
-	return args.Git.MergeBranchNoEdit(args.Frontend, branchToMerge)
+	err := args.Git.MergeBranchNoEdit(args.Frontend, branchToMerge)
+	if err != nil {
+		canResolve, errResolve := canResolveConflicts(args)
+		if errResolve != nil {
+			return errResolve
+		}
+		if canResolve {
+			args.PrependOpcodes(&ResolveMergeConflicts{})
+			return nil
+		}
+	}
+	return err
 }
@@ -12,7 +12,7 @@ type PushCurrentBranch struct {
 }
 
 func (self *PushCurrentBranch) Run(args shared.RunArgs) error {
-	shouldPush, err := args.Git.ShouldPushBranch(args.Backend, self.CurrentBranch)
+	shouldPush, err := args.Git.ShouldPushBranch(args.Backend, self.CurrentBranch, args.Git.TrackingBranch(args.Backend, self.CurrentBranch, args.Config.Config.PushRemote))
 	if err != nil {
 		return err
 	}
@@ -31,9 +31,33 @@ func (self *RebaseParent) Run(args shared.RunArgs) error {
 	}
 	var branchToRebase gitdomain.BranchName
 	if self.ParentActiveInOtherWorktree {
-		branchToRebase = parent.TrackingBranch().BranchName()
+		branchToRebase = args.Git.TrackingBranch(args.Backend, parent, args.Config.Config.PushRemote).BranchName()
 	} else {
 		branchToRebase = parent.BranchName()
 	}
+	err := self.rebase(args, branchToRebase)
+	if err != nil {
+		canResolve, errResolve := canResolveConflicts(args)
+		if errResolve != nil {
+			return errResolve
+		}
+		if canResolve {
+			args.PrependOpcodes(&ResolveRebaseConflicts{})
+			return nil
+		}
+	}
+	return err
+}
+
+// rebases the current branch against the given parent branch
+func (self *RebaseParent) rebase(args shared.RunArgs, branchToRebase gitdomain.BranchName) error {
+	// If the parent commit that this branch was last synced with is no longer part of the parent,
+	// for example because the previous parent was shipped using a squash-merge,
+	// move only the commits of this branch onto the new parent.
+	if parentSHA, hasParentSHA := args.Config.ParentSHA(self.CurrentBranch).Get(); hasParentSHA {
+		if args.Git.IsAncestor(args.Backend, parentSHA, self.CurrentBranch.Location()) && !args.Git.IsAncestor(args.Backend, parentSHA, branchToRebase.Location()) {
+			return args.Git.RebaseOnto(args.Frontend, branchToRebase, parentSHA)
+		}
+	}
 	return args.Git.Rebase(args.Frontend, branchToRebase)
 }
@@ -23,6 +23,7 @@ func (self *RemoveBranchFromLineage) Run(args shared.RunArgs) error {
 		}
 	}
 	args.Config.RemoveParent(self.Branch)
+	args.Config.RemoveSyncStrategyOverride(self.Branch)
 	args.Config.Config.Lineage.RemoveBranch(self.Branch)
 	return nil
 }
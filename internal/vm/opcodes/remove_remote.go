@@ -0,0 +1,16 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/vm/shared"
+)
+
+// RemoveRemote removes the Git remote with the given name and all its remote-tracking branches.
+type RemoveRemote struct {
+	Remote                  gitdomain.Remote
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *RemoveRemote) Run(args shared.RunArgs) error {
+	return args.Git.RemoveRemote(args.Frontend, self.Remote)
+}
@@ -0,0 +1,17 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/vm/shared"
+)
+
+// RemoveSyncStrategyOverride removes the sync strategy that the local Git configuration configures for the given branch.
+type RemoveSyncStrategyOverride struct {
+	Branch                  gitdomain.LocalBranchName
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *RemoveSyncStrategyOverride) Run(args shared.RunArgs) error {
+	args.Config.RemoveSyncStrategyOverride(self.Branch)
+	return nil
+}
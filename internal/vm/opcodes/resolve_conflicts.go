@@ -0,0 +1,60 @@
+package opcodes
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/messages"
+	"github.com/git-town/git-town/v16/internal/vm/shared"
+)
+
+// indicates whether the conflict resolution rules in the configuration resolve all conflicts of the ongoing merge or rebase
+func canResolveConflicts(args shared.RunArgs) (bool, error) {
+	if len(args.Config.Config.ConflictResolutions) == 0 {
+		return false, nil
+	}
+	files, err := args.Git.ConflictingFiles(args.Backend)
+	if err != nil {
+		return false, err
+	}
+	return args.Config.Config.ConflictResolutions.ForFiles(files).IsSome(), nil
+}
+
+// resolves all conflicts of the ongoing merge or rebase
+// by running the commands of the matching conflict resolution rules in the repository root
+// and staging all changes they make to tracked files, for example to go.mod when running "go mod tidy"
+func resolveConflicts(args shared.RunArgs) error {
+	files, err := args.Git.ConflictingFiles(args.Backend)
+	if err != nil {
+		return err
+	}
+	resolutions, hasResolutions := args.Config.Config.ConflictResolutions.ForFiles(files).Get()
+	if !hasResolutions {
+		return errors.New(messages.ContinueUnresolvedConflicts)
+	}
+	rootDir, hasRootDir := args.Git.RootDirectory(args.Backend).Get()
+	if !hasRootDir {
+		return errors.New(messages.RepoOutside)
+	}
+	for _, resolution := range resolutions {
+		shell, shellArgs := shellCommand(resolution.Command)
+		if err = args.Frontend.RunInDir(rootDir.String(), shell, shellArgs...); err != nil {
+			return fmt.Errorf(messages.ConflictResolutionFailed, resolution.Command, err)
+		}
+	}
+	if err = args.Git.StageTrackedChanges(args.Frontend); err != nil {
+		return err
+	}
+	args.FinalMessages.Add(fmt.Sprintf(messages.ConflictsResolvedAutomatically, strings.Join(files, ", ")))
+	return nil
+}
+
+// provides the executable and arguments that run the given command line in the shell of this platform
+func shellCommand(command string) (string, []string) {
+	if runtime.GOOS == "windows" {
+		return "cmd", []string{"/C", command}
+	}
+	return "sh", []string{"-c", command}
+}
@@ -0,0 +1,28 @@
+package opcodes
+
+import "github.com/git-town/git-town/v16/internal/vm/shared"
+
+// ResolveMergeConflicts resolves the conflicts of the ongoing merge
+// using the conflict resolution rules in the configuration and finishes the merge.
+type ResolveMergeConflicts struct {
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ResolveMergeConflicts) CreateAbortProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&AbortMerge{},
+	}
+}
+
+func (self *ResolveMergeConflicts) CreateContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&ContinueMerge{},
+	}
+}
+
+func (self *ResolveMergeConflicts) Run(args shared.RunArgs) error {
+	if err := resolveConflicts(args); err != nil {
+		return err
+	}
+	return args.Git.CommitNoEdit(args.Frontend)
+}
@@ -0,0 +1,40 @@
+package opcodes
+
+import "github.com/git-town/git-town/v16/internal/vm/shared"
+
+// ResolveRebaseConflicts resolves the conflicts of the ongoing rebase
+// using the conflict resolution rules in the configuration and continues the rebase.
+type ResolveRebaseConflicts struct {
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ResolveRebaseConflicts) CreateAbortProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&AbortRebase{},
+	}
+}
+
+func (self *ResolveRebaseConflicts) CreateContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&ContinueRebase{},
+	}
+}
+
+func (self *ResolveRebaseConflicts) Run(args shared.RunArgs) error {
+	if err := resolveConflicts(args); err != nil {
+		return err
+	}
+	err := args.Git.ContinueRebaseNoEdit(args.Frontend)
+	if err != nil {
+		canResolve, errResolve := canResolveConflicts(args)
+		if errResolve != nil {
+			return errResolve
+		}
+		if canResolve {
+			// the next commit of the rebase ran into conflicts that the conflict resolution rules resolve
+			args.PrependOpcodes(&ResolveRebaseConflicts{})
+			return nil
+		}
+	}
+	return err
+}
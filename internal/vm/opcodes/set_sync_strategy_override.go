@@ -0,0 +1,18 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/vm/shared"
+)
+
+// SetSyncStrategyOverride configures the given sync strategy for the given branch in the local Git configuration.
+type SetSyncStrategyOverride struct {
+	Branch                  gitdomain.LocalBranchName
+	Strategy                configdomain.SyncStrategy
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *SetSyncStrategyOverride) Run(args shared.RunArgs) error {
+	return args.Config.SetSyncStrategyOverride(self.Branch, self.Strategy)
+}
@@ -36,7 +36,7 @@ func (self *SquashMerge) Run(args shared.RunArgs) error {
 	if err != nil {
 		return err
 	}
-	branchAuthors, err := args.Git.BranchAuthors(args.Backend, self.Branch, self.Parent)
+	branchAuthors, err := args.Git.BranchAuthors(args.Backend, self.Branch.BranchName(), self.Parent.BranchName())
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,147 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/messages"
+	"github.com/git-town/git-town/v16/internal/vm/shared"
+	. "github.com/git-town/git-town/v16/pkg/prelude"
+)
+
+// SyncBranchWithoutCheckout syncs the given branch with its tracking and parent branch
+// by updating the branch ref directly instead of checking the branch out,
+// which avoids rewriting the files in the worktree.
+// This works if the branch can be fast-forwarded or, when using the merge sync strategy,
+// merged without conflicts.
+// Merge commits created this way bypass commit signing and Git hooks,
+// so branches that need a merge commit use a checkout if the repo signs commits or has merge hooks.
+// On success it skips the remaining opcodes that sync this branch.
+// Otherwise it does nothing and the regular opcodes that follow it sync the branch using a checkout.
+type SyncBranchWithoutCheckout struct {
+	Branch                      gitdomain.LocalBranchName
+	ParentActiveInOtherWorktree bool
+	PushBranch                  bool // whether to push the synced branch to its tracking branch
+	SyncStrategy                configdomain.SyncStrategy
+	TrackingBranch              Option[gitdomain.RemoteBranchName] // the tracking branch to sync with, None to not sync with the tracking branch
+	undeclaredOpcodeMethods     `exhaustruct:"optional"`
+}
+
+func (self *SyncBranchWithoutCheckout) CreateContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		self,
+	}
+}
+
+func (self *SyncBranchWithoutCheckout) Run(args shared.RunArgs) error {
+	currentBranch, err := args.Git.CurrentBranch(args.Backend)
+	if err != nil {
+		return err
+	}
+	if currentBranch == self.Branch {
+		return nil
+	}
+	initialSHA, err := args.Git.SHAForBranch(args.Backend, self.Branch.BranchName())
+	if err != nil {
+		return err
+	}
+	syncedSHA := initialSHA
+	trackingSHA := None[gitdomain.SHA]()
+	if trackingBranch, hasTrackingBranch := self.TrackingBranch.Get(); hasTrackingBranch {
+		sha, err := args.Git.SHAForBranch(args.Backend, trackingBranch.BranchName())
+		if err != nil {
+			// the tracking branch is missing --> let the regular sync handle this branch
+			return nil //nolint:nilerr
+		}
+		trackingSHA = Some(sha)
+		var canSync bool
+		syncedSHA, canSync, err = self.integrate(args, syncedSHA, trackingBranch.BranchName(), sha)
+		if err != nil || !canSync {
+			return err
+		}
+	}
+	parentSHA := None[gitdomain.SHA]()
+	if parentBranch, hasParentBranch := self.parentBranch(args).Get(); hasParentBranch {
+		sha, err := args.Git.SHAForBranch(args.Backend, parentBranch)
+		if err != nil {
+			// the parent branch is missing --> let the regular sync handle this branch
+			return nil //nolint:nilerr
+		}
+		parentSHA = Some(sha)
+		var canSync bool
+		syncedSHA, canSync, err = self.integrate(args, syncedSHA, parentBranch, sha)
+		if err != nil || !canSync {
+			return err
+		}
+	}
+	if syncedSHA != initialSHA {
+		if err = args.Git.UpdateBranchRef(args.Frontend, self.Branch, syncedSHA, initialSHA); err != nil {
+			return err
+		}
+	}
+	if trackingSHA, hasTrackingSHA := trackingSHA.Get(); self.PushBranch && hasTrackingSHA && syncedSHA != trackingSHA {
+		if err = args.Git.PushBranch(args.Frontend, self.TrackingBranch.GetOrPanic().Remote(), self.Branch, args.Config.Config.NoPushHook()); err != nil {
+			return err
+		}
+	}
+	if parentSHA, hasParentSHA := parentSHA.Get(); hasParentSHA && self.SyncStrategy == configdomain.SyncStrategyRebase {
+		if err = args.Config.SetParentSHA(self.Branch, parentSHA); err != nil {
+			return err
+		}
+	}
+	args.PrependOpcodes(&SkipCurrentBranch{})
+	return nil
+}
+
+// integrate provides the commit that contains the changes of the given commit and the given source branch,
+// and whether it was possible to create it without a checkout.
+func (self *SyncBranchWithoutCheckout) integrate(args shared.RunArgs, sha gitdomain.SHA, source gitdomain.BranchName, sourceSHA gitdomain.SHA) (gitdomain.SHA, bool, error) {
+	if args.Git.IsAncestor(args.Backend, sourceSHA, sha.Location()) {
+		return sha, true, nil
+	}
+	if args.Git.IsAncestor(args.Backend, sha, sourceSHA.Location()) {
+		return sourceSHA, true, nil
+	}
+	if self.SyncStrategy != configdomain.SyncStrategyMerge {
+		return sha, false, nil
+	}
+	if args.Git.CommitSigningEnabled(args.Backend) || args.Git.HasMergeHooks(args.Backend) {
+		// "git commit-tree" neither signs the merge commit nor runs hooks
+		return sha, false, nil
+	}
+	tree, conflictingFiles, err := args.Git.MergeTree(args.Backend, sha, sourceSHA)
+	if err != nil || len(conflictingFiles) > 0 {
+		// the merge needs a human, or this Git version cannot merge without a checkout
+		return sha, false, nil //nolint:nilerr
+	}
+	mergeSHA, err := args.Git.CommitTree(args.Backend, tree, self.mergeMessage(source, args.Config.Config.MainBranch), sha, sourceSHA)
+	return mergeSHA, err == nil, err
+}
+
+// provides the commit message that "git merge" would use to merge the given source branch into this branch
+func (self *SyncBranchWithoutCheckout) mergeMessage(source gitdomain.BranchName, mainBranch gitdomain.LocalBranchName) gitdomain.CommitMessage {
+	var message string
+	trackingBranch, hasTrackingBranch := self.TrackingBranch.Get()
+	if !source.IsLocal() || (hasTrackingBranch && source == trackingBranch.BranchName()) {
+		message = fmt.Sprintf(messages.MergeCommitMessageRemoteBranch, source)
+	} else {
+		message = fmt.Sprintf(messages.MergeCommitMessageBranch, source)
+	}
+	if self.Branch != mainBranch {
+		message = fmt.Sprintf(messages.MergeCommitMessageInto, message, self.Branch)
+	}
+	return gitdomain.CommitMessage(message)
+}
+
+// provides the branch that this branch syncs with at runtime
+func (self *SyncBranchWithoutCheckout) parentBranch(args shared.RunArgs) Option[gitdomain.BranchName] {
+	parent, hasParent := args.Config.Config.Lineage.Parent(self.Branch).Get()
+	if !hasParent {
+		return None[gitdomain.BranchName]()
+	}
+	if self.ParentActiveInOtherWorktree {
+		return Some(args.Git.TrackingBranch(args.Backend, parent, args.Config.Config.PushRemote).BranchName())
+	}
+	return Some(parent.BranchName())
+}
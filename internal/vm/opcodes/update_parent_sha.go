@@ -0,0 +1,32 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/vm/shared"
+)
+
+// UpdateParentSHA records the commit of the parent branch that the given branch is now synced with.
+// This allows syncing the branch correctly after its parent gets shipped using a squash-merge.
+type UpdateParentSHA struct {
+	Branch                      gitdomain.LocalBranchName
+	ParentActiveInOtherWorktree bool
+	undeclaredOpcodeMethods     `exhaustruct:"optional"`
+}
+
+func (self *UpdateParentSHA) Run(args shared.RunArgs) error {
+	parent, hasParent := args.Config.Config.Lineage.Parent(self.Branch).Get()
+	if !hasParent {
+		return nil
+	}
+	var parentBranch gitdomain.BranchName
+	if self.ParentActiveInOtherWorktree {
+		parentBranch = args.Git.TrackingBranch(args.Backend, parent, args.Config.Config.PushRemote).BranchName()
+	} else {
+		parentBranch = parent.BranchName()
+	}
+	parentSHA, err := args.Git.SHAForBranch(args.Backend, parentBranch)
+	if err != nil {
+		return err
+	}
+	return args.Config.SetParentSHA(self.Branch, parentSHA)
+}
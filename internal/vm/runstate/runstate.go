@@ -101,7 +101,7 @@ func (self *RunState) RegisterUndoablePerennialCommit(commit gitdomain.SHA) {
 func (self *RunState) SkipCurrentBranchProgram() {
 	for {
 		opcode := self.RunProgram.Peek()
-		if shared.IsEndOfBranchProgramOpcode(opcode) {
+		if opcode == nil || shared.IsEndOfBranchProgramOpcode(opcode) {
 			break
 		}
 		self.RunProgram.Pop()
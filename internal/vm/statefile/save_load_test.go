@@ -64,6 +64,7 @@ func TestLoadSave(t *testing.T) {
 					CommitMessage:   Some(gitdomain.CommitMessage("commit message")),
 					ProposalMessage: "proposal message",
 					ProposalNumber:  123,
+					ShipStrategy:    configdomain.ShipStrategyAPIMerge,
 				},
 				&opcodes.ContinueMerge{},
 				&opcodes.ContinueRebase{},
@@ -175,6 +176,13 @@ func TestLoadSave(t *testing.T) {
 				},
 				&opcodes.StageOpenChanges{},
 				&opcodes.StashOpenChanges{},
+				&opcodes.SyncBranchWithoutCheckout{
+					Branch:                      gitdomain.NewLocalBranchName("branch"),
+					ParentActiveInOtherWorktree: false,
+					PushBranch:                  true,
+					SyncStrategy:                configdomain.SyncStrategyMerge,
+					TrackingBranch:              Some(gitdomain.NewRemoteBranchName("origin/branch")),
+				},
 				&opcodes.UpdateProposalTarget{
 					ProposalNumber: 123,
 					NewTarget:      gitdomain.NewLocalBranchName("new-target"),
@@ -270,7 +278,8 @@ func TestLoadSave(t *testing.T) {
         "Branch": "branch",
         "CommitMessage": "commit message",
         "ProposalMessage": "proposal message",
-        "ProposalNumber": 123
+        "ProposalNumber": 123,
+        "ShipStrategy": "api-merge"
       },
       "type": "ConnectorMergeProposal"
     },
@@ -293,6 +302,12 @@ func TestLoadSave(t *testing.T) {
       "data": {
         "Branch": "branch",
         "MainBranch": "main",
+        "Metadata": {
+          "Assignees": null,
+          "Draft": false,
+          "Labels": null,
+          "Reviewers": null
+        },
         "ProposalBody": "",
         "ProposalTitle": ""
       },
@@ -523,6 +538,16 @@ func TestLoadSave(t *testing.T) {
       "data": {},
       "type": "StashOpenChanges"
     },
+    {
+      "data": {
+        "Branch": "branch",
+        "ParentActiveInOtherWorktree": false,
+        "PushBranch": true,
+        "SyncStrategy": "merge",
+        "TrackingBranch": "origin/branch"
+      },
+      "type": "SyncBranchWithoutCheckout"
+    },
     {
       "data": {
         "NewTarget": "new-target",
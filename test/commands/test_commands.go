@@ -400,17 +400,23 @@ func (self *TestCommands) SHAsForCommit(name string) gitdomain.SHAs {
 	if output == "" {
 		panic(fmt.Sprintf("cannot find the SHA of commit %q", name))
 	}
-	shasWithMessage := make(gitdomain.SHAs, 0, 1)
-	for _, text := range strings.Split(output, "\n") {
-		shaText, commitMessage, found := strings.Cut(text, " ")
-		if found && commitMessage == name {
-			sha := gitdomain.NewSHA(shaText)
-			shasWithMessage = append(shasWithMessage, sha)
-		}
+	shasWithMessage := findSHAsForCommit(output, name)
+	if len(shasWithMessage) == 0 {
+		// commits on branches that were never checked out aren't in the reflog
+		shasWithMessage = findSHAsForCommit(self.MustQuery("git", "log", "--all", "--format=%h %s"), name)
+	}
+	if len(shasWithMessage) == 0 {
+		// commits that Git Town creates without a branch, for example to simulate merges, are unreachable
+		shasWithMessage = findSHAsForCommit(self.unreachableCommits(), name)
 	}
 	return shasWithMessage
 }
 
+// SHALong provides the full SHA of the given commit.
+func (self *TestCommands) SHALong(sha gitdomain.SHA) gitdomain.SHA {
+	return gitdomain.NewSHA(self.MustQuery("git", "rev-parse", sha.String()))
+}
+
 // SetColorUI configures whether Git output contains color codes.
 func (self *TestCommands) SetColorUI(value string) error {
 	return self.Run("git", "config", "color.ui", value)
@@ -485,3 +491,29 @@ func (self *TestCommands) VerifyNoGitTownConfiguration() error {
 	}
 	return nil
 }
+
+// provides the SHAs of the commits with the given message in the given output of "git log --format=%h %s"
+// provides the SHAs and messages of the unreachable commits in this repo, in the format "<sha> <message>"
+func (self *TestCommands) unreachableCommits() string {
+	args := []string{"log", "--no-walk", "--format=%h %s"}
+	for _, line := range strings.Split(self.MustQuery("git", "fsck", "--unreachable", "--no-reflogs", "--no-progress"), "\n") {
+		if sha, isCommit := strings.CutPrefix(line, "unreachable commit "); isCommit {
+			args = append(args, sha)
+		}
+	}
+	if len(args) == 3 {
+		return ""
+	}
+	return self.MustQuery("git", args...)
+}
+
+func findSHAsForCommit(output, message string) gitdomain.SHAs {
+	result := make(gitdomain.SHAs, 0, 1)
+	for _, text := range strings.Split(output, "\n") {
+		shaText, commitMessage, found := strings.Cut(text, " ")
+		if found && commitMessage == message {
+			result = append(result, gitdomain.NewSHA(shaText))
+		}
+	}
+	return result
+}
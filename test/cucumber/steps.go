@@ -28,6 +28,7 @@ import (
 	"github.com/git-town/git-town/v16/test/fixture"
 	"github.com/git-town/git-town/v16/test/git"
 	"github.com/git-town/git-town/v16/test/helpers"
+	"github.com/git-town/git-town/v16/test/hostingserver"
 	"github.com/git-town/git-town/v16/test/output"
 	"github.com/git-town/git-town/v16/test/subshell"
 	"github.com/git-town/git-town/v16/test/testruntime"
@@ -379,6 +380,12 @@ func defineSteps(sc *godog.ScenarioContext) {
 		devRepo.MockGit(version)
 	})
 
+	sc.Step(`^Git hook "([^"]+)" exists$`, func(ctx context.Context, name string) {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		devRepo.CreateFile(filepath.Join(".git", "hooks", name), "#!/bin/sh\nexit 0\n")
+	})
+
 	sc.Step(`^Git Town is no longer configured$`, func(ctx context.Context) error {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		devRepo := state.fixture.DevRepo.GetOrPanic()
@@ -401,6 +408,38 @@ func defineSteps(sc *godog.ScenarioContext) {
 		return devRepo.Config.GitConfig.SetLocalConfigValue(configKey, value)
 	})
 
+	sc.Step(`^Git Town sync-strategy setting for branch "([^"]*)" is "([^"]*)"$`, func(ctx context.Context, branch, value string) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		branchName := gitdomain.NewLocalBranchName(branch)
+		configKey := configdomain.NewSyncStrategyKeyForBranch(branchName)
+		return devRepo.Config.GitConfig.SetLocalConfigValue(configKey, value)
+	})
+
+	sc.Step(`^Git Town sync-strategy setting for branch "([^"]*)" is (?:now|still) "([^"]*)"$`, func(ctx context.Context, branch, want string) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		configKey := configdomain.NewSyncStrategyKeyForBranch(gitdomain.NewLocalBranchName(branch))
+		have, has := devRepo.TestCommands.LocalGitConfig(configKey).Get()
+		if !has {
+			return fmt.Errorf(`expected sync-strategy setting for branch %q to be %q but doesn't exist`, branch, want)
+		}
+		if have != want {
+			return fmt.Errorf(`expected sync-strategy setting for branch %q to be %q but is %q`, branch, want, have)
+		}
+		return nil
+	})
+
+	sc.Step(`^Git Town sync-strategy setting for branch "([^"]*)" (?:now|still) doesn't exist$`, func(ctx context.Context, branch string) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		configKey := configdomain.NewSyncStrategyKeyForBranch(gitdomain.NewLocalBranchName(branch))
+		if have, has := devRepo.TestCommands.LocalGitConfig(configKey).Get(); has {
+			return fmt.Errorf(`expected no sync-strategy setting for branch %q but it is %q`, branch, have)
+		}
+		return nil
+	})
+
 	sc.Step(`^global Git setting "alias\.(.*?)" is "([^"]*)"$`, func(ctx context.Context, name, value string) error {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		devRepo := state.fixture.DevRepo.GetOrPanic()
@@ -547,6 +586,7 @@ func defineSteps(sc *godog.ScenarioContext) {
 			CoworkerRepo:   NoneP[testruntime.TestRuntime](),
 			DevRepo:        NoneP[testruntime.TestRuntime](),
 			Dir:            envPath,
+			HostingServer:  NoneP[hostingserver.Server](),
 			OriginRepo:     NoneP[testruntime.TestRuntime](),
 			SecondWorktree: NoneP[testruntime.TestRuntime](),
 			SubmoduleRepo:  NoneP[testruntime.TestRuntime](),
@@ -866,6 +906,7 @@ func defineSteps(sc *godog.ScenarioContext) {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		want := fmt.Sprintf("%s called with: %s", tool, url.Content)
 		want = strings.ReplaceAll(want, "?", `\?`)
+		want = strings.ReplaceAll(want, "+", `\+`)
 		regex := regexp.MustCompile(want)
 		have := state.runOutput.GetOrPanic()
 		if !regex.MatchString(have) {
@@ -1042,6 +1083,15 @@ func defineSteps(sc *godog.ScenarioContext) {
 		}
 	})
 
+	sc.Step(`^the CI checks of the proposal of branch "([^"]+)" pass$`, func(ctx context.Context, branch string) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		server, hasServer := state.fixture.HostingServer.Get()
+		if !hasServer {
+			return errors.New("this scenario has no hosting server")
+		}
+		return server.PassChecks(gitdomain.NewLocalBranchName(branch))
+	})
+
 	sc.Step(`^the commits$`, func(ctx context.Context, table *godog.Table) {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		devRepo := state.fixture.DevRepo.GetOrPanic()
@@ -1367,6 +1417,27 @@ func defineSteps(sc *godog.ScenarioContext) {
 		return nil
 	})
 
+	sc.Step(`^the origin has a proposal from "([^"]+)" into "([^"]+)"$`, func(ctx context.Context, source, target string) {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		server := state.fixture.AddHostingServer()
+		server.AddProposal(hostingserver.Proposal{
+			Assignees: []string{},
+			Body:      "",
+			Checks:    hostingdomain.ProposalChecksNone,
+			Draft:     false,
+			Fork:      "",
+			Labels:    []string{},
+			MergedSHA: "",
+			Number:    0,
+			Review:    hostingdomain.ProposalReviewNone,
+			Reviewers: []string{},
+			Source:    gitdomain.NewLocalBranchName(source),
+			State:     hostingserver.ProposalStateOpen,
+			Target:    gitdomain.NewLocalBranchName(target),
+			Title:     source,
+		})
+	})
+
 	sc.Step(`^the origin is "([^"]*)"$`, func(ctx context.Context, origin string) {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		devRepo := state.fixture.DevRepo.GetOrPanic()
@@ -1428,6 +1499,39 @@ func defineSteps(sc *godog.ScenarioContext) {
 		return nil
 	})
 
+	sc.Step(`^the proposal of branch "([^"]+)" gets merged online$`, func(ctx context.Context, branch string) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		server, hasServer := state.fixture.HostingServer.Get()
+		if !hasServer {
+			return errors.New("this scenario has no hosting server")
+		}
+		return server.MergeProposal(gitdomain.NewLocalBranchName(branch), hostingserver.MergeMethodSquash)
+	})
+
+	sc.Step(`^the proposals$`, func(ctx context.Context, table *godog.Table) {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		server := state.fixture.AddHostingServer()
+		for _, proposal := range hostingserver.ParseProposalTable(datatable.FromGherkin(table)) {
+			server.AddProposal(proposal)
+		}
+	})
+
+	sc.Step(`^the proposals are (?:now|still)$`, func(ctx context.Context, table *godog.Table) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		server, hasServer := state.fixture.HostingServer.Get()
+		if !hasServer {
+			return errors.New("this scenario has no hosting server")
+		}
+		proposalTable := server.ProposalTable(helpers.TableFields(table))
+		diff, errorCount := proposalTable.EqualGherkin(table)
+		if errorCount != 0 {
+			fmt.Printf("\nERROR! Found %d differences in the proposals\n\n", errorCount)
+			fmt.Println(diff)
+			return errors.New("mismatching proposals found, see diff above")
+		}
+		return nil
+	})
+
 	sc.Step(`^the prototype branches are (?:now|still) "([^"]+)"$`, func(ctx context.Context, name string) error {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		devRepo := state.fixture.DevRepo.GetOrPanic()
@@ -1491,6 +1595,24 @@ func defineSteps(sc *godog.ScenarioContext) {
 		return nil
 	})
 
+	sc.Step(`^the remotes are (?:now|still) "([^"]+)"$`, func(ctx context.Context, want string) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		remotes, err := devRepo.RemotesUncached(devRepo.TestRunner)
+		if err != nil {
+			return err
+		}
+		names := make([]string, len(remotes))
+		for r, remote := range remotes {
+			names[r] = remote.String()
+		}
+		have := strings.Join(names, ", ")
+		if have != want {
+			return fmt.Errorf("expected remotes %q, got %q", want, have)
+		}
+		return nil
+	})
+
 	sc.Step(`^these branches exist now$`, func(ctx context.Context, input *godog.Table) {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		currentBranches := state.fixture.Branches()
@@ -3,5 +3,6 @@ package datatable
 import "github.com/git-town/git-town/v16/internal/git/gitdomain"
 
 type runner interface {
+	SHALong(sha gitdomain.SHA) gitdomain.SHA
 	SHAsForCommit(name string) gitdomain.SHAs
 }
@@ -75,7 +75,7 @@ func (self *DataTable) Expand(localRepo runner, remoteRepo runner, worktreeRepo
 		var cells []string
 		for col := range self.Cells[row] {
 			cell := self.Cells[row][col]
-			if strings.Contains(cell, "{{") {
+			for strings.Contains(cell, "{{") {
 				templateOnce.Do(func() { templateRE = regexp.MustCompile(`\{\{.*?\}\}`) })
 				match := templateRE.FindString(cell)
 				switch {
@@ -87,6 +87,14 @@ func (self *DataTable) Expand(localRepo runner, remoteRepo runner, worktreeRepo
 					}
 					sha := shas.First()
 					cell = strings.Replace(cell, match, sha.String(), 1)
+				case strings.HasPrefix(match, "{{ sha-long "):
+					commitName := match[13 : len(match)-4]
+					shas := localRepo.SHAsForCommit(commitName)
+					if len(shas) == 0 {
+						panic(fmt.Sprintf("test workspace has no commit %q", commitName))
+					}
+					sha := localRepo.SHALong(shas.First())
+					cell = strings.Replace(cell, match, sha.String(), 1)
 				case strings.HasPrefix(match, "{{ sha-in-origin "):
 					commitName := match[18 : len(match)-4]
 					shas := remoteRepo.SHAsForCommit(commitName)
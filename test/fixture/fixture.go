@@ -7,6 +7,7 @@ import (
 
 	"github.com/cucumber/godog"
 
+	"github.com/git-town/git-town/v16/internal/config/configdomain"
 	"github.com/git-town/git-town/v16/internal/git"
 	"github.com/git-town/git-town/v16/internal/git/gitdomain"
 	"github.com/git-town/git-town/v16/internal/gohacks/cache"
@@ -16,6 +17,7 @@ import (
 	"github.com/git-town/git-town/v16/test/datatable"
 	testgit "github.com/git-town/git-town/v16/test/git"
 	"github.com/git-town/git-town/v16/test/helpers"
+	"github.com/git-town/git-town/v16/test/hostingserver"
 	"github.com/git-town/git-town/v16/test/subshell"
 	"github.com/git-town/git-town/v16/test/testruntime"
 )
@@ -33,6 +35,9 @@ type Fixture struct {
 	// It contains the global Git configuration to use in this test.
 	Dir string
 
+	// HostingServer is the optional fake code hosting server that simulates the API of the platform hosting the origin repo.
+	HostingServer OptionP[hostingserver.Server]
+
 	// OriginRepo is the Git repository that simulates the origin repo (on GitHub).
 	// If this value is nil, the current test setup has no origin.
 	OriginRepo OptionP[testruntime.TestRuntime]
@@ -57,6 +62,19 @@ func (self *Fixture) AddCoworkerRepo() {
 	coworkerRepo.Verbose = self.DevRepo.GetOrPanic().Verbose
 }
 
+// AddHostingServer starts a fake code hosting server and makes the developer repo talk to it.
+// Does nothing if the hosting server is already running.
+func (self *Fixture) AddHostingServer() *hostingserver.Server {
+	if server, hasServer := self.HostingServer.Get(); hasServer {
+		return server
+	}
+	server := hostingserver.Start(self.OriginRepo.GetOrPanic().TestRunner)
+	self.HostingServer = SomeP(server)
+	err := self.DevRepo.GetOrPanic().Config.GitConfig.SetLocalConfigValue(configdomain.KeyHostingAPIURL, server.URL())
+	asserts.NoError(err)
+	return server
+}
+
 func (self *Fixture) AddSecondWorktree(branch gitdomain.LocalBranchName) {
 	workTreePath := filepath.Join(self.Dir, "development_worktree")
 	devRepo := self.DevRepo.GetOrPanic()
@@ -200,6 +218,9 @@ func (self Fixture) CreateTags(table *godog.Table) {
 }
 
 func (self Fixture) Delete() {
+	if server, hasServer := self.HostingServer.Get(); hasServer {
+		server.Close()
+	}
 	os.RemoveAll(self.Dir)
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/git-town/git-town/v16/internal/git/gitdomain"
 	. "github.com/git-town/git-town/v16/pkg/prelude"
 	"github.com/git-town/git-town/v16/test/filesystem"
+	"github.com/git-town/git-town/v16/test/hostingserver"
 	"github.com/git-town/git-town/v16/test/testruntime"
 )
 
@@ -61,6 +62,7 @@ func (self Memoized) AsFixture() Fixture {
 		CoworkerRepo:   NoneP[testruntime.TestRuntime](),
 		DevRepo:        SomeP(&devRepo),
 		Dir:            self.Dir,
+		HostingServer:  NoneP[hostingserver.Server](),
 		OriginRepo:     SomeP(&originRepo),
 		SecondWorktree: NoneP[testruntime.TestRuntime](),
 		SubmoduleRepo:  NoneP[testruntime.TestRuntime](),
@@ -87,6 +89,7 @@ func (self Memoized) CloneInto(dir string) Fixture {
 		CoworkerRepo:   NoneP[testruntime.TestRuntime](),
 		DevRepo:        SomeP(&devRepo),
 		Dir:            dir,
+		HostingServer:  NoneP[hostingserver.Server](),
 		OriginRepo:     SomeP(&originRepo),
 		SecondWorktree: NoneP[testruntime.TestRuntime](),
 		SubmoduleRepo:  NoneP[testruntime.TestRuntime](),
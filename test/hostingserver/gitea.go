@@ -0,0 +1,308 @@
+package hostingserver
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+)
+
+// the Gitea version that the fake server pretends to be
+const giteaVersion = "1.22.0"
+
+// the labels that exist in all Gitea repositories of the fake server, the ID of each label is its position in this list plus one
+var giteaLabels = []string{"bug", "documentation", "enhancement"} //nolint:gochecknoglobals
+
+func (self *Server) giteaCombinedStatus(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	number, err := parseFakeSHA(request.PathValue("sha"))
+	if err != nil {
+		writeError(writer, http.StatusNotFound, "Not Found")
+		return
+	}
+	proposal, hasProposal := self.findProposal(number)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "Not Found")
+		return
+	}
+	result := gitea.CombinedStatus{
+		State:      gitea.StatusPending,
+		TotalCount: 0,
+	}
+	switch proposal.Checks {
+	case hostingdomain.ProposalChecksFailure:
+		result.State, result.TotalCount = gitea.StatusFailure, 1
+	case hostingdomain.ProposalChecksPending:
+		result.State, result.TotalCount = gitea.StatusPending, 1
+	case hostingdomain.ProposalChecksSuccess:
+		result.State, result.TotalCount = gitea.StatusSuccess, 1
+	case hostingdomain.ProposalChecksNone, hostingdomain.ProposalChecksUnknown:
+	}
+	writeJSON(writer, http.StatusOK, result)
+}
+
+func (self *Server) giteaCreatePullRequest(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	var options gitea.CreatePullRequestOption
+	if err := readJSON(request, &options); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+	labels := []string{}
+	for _, labelID := range options.Labels {
+		if labelID < 1 || int(labelID) > len(giteaLabels) {
+			writeError(writer, http.StatusUnprocessableEntity, fmt.Sprintf("label %d does not exist", labelID))
+			return
+		}
+		labels = append(labels, giteaLabels[labelID-1])
+	}
+	proposal := self.addProposal(Proposal{
+		Assignees: append([]string{}, options.Assignees...),
+		Body:      options.Body,
+		Checks:    hostingdomain.ProposalChecksNone,
+		Draft:     strings.HasPrefix(options.Title, "WIP:"),
+		Fork:      "",
+		Labels:    labels,
+		MergedSHA: "",
+		Number:    0,
+		Review:    hostingdomain.ProposalReviewNone,
+		Reviewers: []string{},
+		Source:    gitdomain.NewLocalBranchName(options.Head),
+		State:     ProposalStateOpen,
+		Target:    gitdomain.NewLocalBranchName(options.Base),
+		Title:     options.Title,
+	})
+	writeJSON(writer, http.StatusCreated, giteaPullRequest(proposal, request))
+}
+
+func (self *Server) giteaEditPullRequest(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.giteaFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "Not Found")
+		return
+	}
+	var options gitea.EditPullRequestOption
+	if err := readJSON(request, &options); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+	// Gitea leaves fields that are empty in the request unchanged
+	if options.Body != "" {
+		proposal.Body = options.Body
+	}
+	if options.Title != "" {
+		proposal.Title = options.Title
+	}
+	if options.Base != "" {
+		proposal.Target = gitdomain.NewLocalBranchName(options.Base)
+	}
+	writeJSON(writer, http.StatusCreated, giteaPullRequest(*proposal, request))
+}
+
+func (self *Server) giteaFindProposal(request *http.Request) (*Proposal, bool) {
+	number, err := pathNumber(request, "index")
+	if err != nil {
+		return nil, false
+	}
+	return self.findProposal(number)
+}
+
+func (self *Server) giteaGetPullRequest(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.giteaFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "Not Found")
+		return
+	}
+	writeJSON(writer, http.StatusOK, giteaPullRequest(*proposal, request))
+}
+
+func (self *Server) giteaListLabels(writer http.ResponseWriter, _ *http.Request) {
+	result := make([]*gitea.Label, len(giteaLabels))
+	for l, label := range giteaLabels {
+		result[l] = &gitea.Label{ID: int64(l + 1), Name: label}
+	}
+	writeJSON(writer, http.StatusOK, result)
+}
+
+func (self *Server) giteaListPullRequests(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	state := request.URL.Query().Get("state")
+	result := []*gitea.PullRequest{}
+	for _, proposal := range self.proposals {
+		switch state {
+		case "", string(gitea.StateOpen):
+			if proposal.State != ProposalStateOpen {
+				continue
+			}
+		case string(gitea.StateClosed):
+			if proposal.State == ProposalStateOpen {
+				continue
+			}
+		}
+		result = append(result, giteaPullRequest(proposal, request))
+	}
+	writeJSON(writer, http.StatusOK, result)
+}
+
+func (self *Server) giteaMergePullRequest(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.giteaFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "Not Found")
+		return
+	}
+	var options gitea.MergePullRequestOption
+	if err := readJSON(request, &options); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+	message := options.Title
+	if options.Message != "" {
+		message += "\n\n" + options.Message
+	}
+	method := MergeMethodMerge
+	switch options.Style {
+	case gitea.MergeStyleRebase, gitea.MergeStyleRebaseMerge:
+		method = MergeMethodRebase
+	case gitea.MergeStyleSquash:
+		method = MergeMethodSquash
+	case gitea.MergeStyleMerge:
+	}
+	var err error
+	if options.MergeWhenChecksSucceed {
+		err = self.enableAutoMerge(proposal, method, message, options.DeleteBranchAfterMerge)
+	} else {
+		err = self.merge(proposal, method, message, options.DeleteBranchAfterMerge)
+	}
+	if err != nil {
+		writeError(writer, http.StatusMethodNotAllowed, err.Error())
+		return
+	}
+	writer.WriteHeader(http.StatusOK)
+}
+
+func (self *Server) giteaRequestReviews(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.giteaFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "Not Found")
+		return
+	}
+	var options gitea.PullReviewRequestOptions
+	if err := readJSON(request, &options); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+	proposal.Reviewers = append(proposal.Reviewers, options.Reviewers...)
+	result := []*gitea.PullReview{}
+	for range options.Reviewers {
+		result = append(result, giteaReview(gitea.ReviewStateRequestReview))
+	}
+	writeJSON(writer, http.StatusCreated, result)
+}
+
+func (self *Server) giteaReviews(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.giteaFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "Not Found")
+		return
+	}
+	result := []*gitea.PullReview{}
+	switch proposal.Review {
+	case hostingdomain.ProposalReviewApproved:
+		result = append(result, giteaReview(gitea.ReviewStateApproved))
+	case hostingdomain.ProposalReviewChangesRequested:
+		result = append(result, giteaReview(gitea.ReviewStateRequestChanges))
+	case hostingdomain.ProposalReviewRequired:
+		result = append(result, giteaReview(gitea.ReviewStateRequestReview))
+	case hostingdomain.ProposalReviewNone, hostingdomain.ProposalReviewUnknown:
+	}
+	writeJSON(writer, http.StatusOK, result)
+}
+
+func (self *Server) giteaVersion(writer http.ResponseWriter, _ *http.Request) {
+	writeJSON(writer, http.StatusOK, map[string]string{"version": giteaVersion})
+}
+
+func (self *Server) registerGitea(mux *http.ServeMux) {
+	prefix := "/api/v1/repos/{org}/{repo}"
+	mux.HandleFunc("GET /api/v1/version", self.giteaVersion)
+	mux.HandleFunc("GET "+prefix+"/commits/{sha}/status", self.giteaCombinedStatus)
+	mux.HandleFunc("GET "+prefix+"/labels", self.giteaListLabels)
+	mux.HandleFunc("GET "+prefix+"/pulls", self.giteaListPullRequests)
+	mux.HandleFunc("POST "+prefix+"/pulls", self.giteaCreatePullRequest)
+	mux.HandleFunc("GET "+prefix+"/pulls/{index}", self.giteaGetPullRequest)
+	mux.HandleFunc("PATCH "+prefix+"/pulls/{index}", self.giteaEditPullRequest)
+	mux.HandleFunc("POST "+prefix+"/pulls/{index}/merge", self.giteaMergePullRequest)
+	mux.HandleFunc("POST "+prefix+"/pulls/{index}/requested_reviewers", self.giteaRequestReviews)
+	mux.HandleFunc("GET "+prefix+"/pulls/{index}/reviews", self.giteaReviews)
+}
+
+// giteaPullRequest provides the Gitea API representation of the given proposal.
+func giteaPullRequest(proposal Proposal, request *http.Request) *gitea.PullRequest {
+	org := request.PathValue("org")
+	state := gitea.StateOpen
+	var mergedAt *time.Time
+	if proposal.State != ProposalStateOpen {
+		state = gitea.StateClosed
+	}
+	if proposal.State == ProposalStateMerged {
+		now := time.Now()
+		mergedAt = &now
+	}
+	baseRepo := &gitea.Repository{ID: 1}
+	headRepo := baseRepo
+	if proposal.Fork != "" {
+		headRepo = &gitea.Repository{
+			CloneURL: forkCloneURL,
+			ID:       2,
+			Owner:    &gitea.User{UserName: proposal.Fork},
+		}
+	}
+	return &gitea.PullRequest{
+		Base: &gitea.PRBranchInfo{
+			Name:       proposal.Target.String(),
+			Ref:        proposal.Target.String(),
+			RepoID:     baseRepo.ID,
+			Repository: baseRepo,
+		},
+		Body:      proposal.Body,
+		HasMerged: proposal.State == ProposalStateMerged,
+		Head: &gitea.PRBranchInfo{
+			Name:       org + "/" + proposal.Source.String(),
+			Ref:        proposal.Source.String(),
+			RepoID:     headRepo.ID,
+			Repository: headRepo,
+			Sha:        headSHA(proposal),
+		},
+		HTMLURL:   fmt.Sprintf("https://gitea.com/%s/%s/pulls/%d", org, request.PathValue("repo"), proposal.Number),
+		Index:     int64(proposal.Number),
+		Mergeable: true,
+		Merged:    mergedAt,
+		State:     state,
+		Title:     proposal.Title,
+	}
+}
+
+// giteaReview provides a Gitea review with the given state.
+func giteaReview(state gitea.ReviewStateType) *gitea.PullReview {
+	return &gitea.PullReview{
+		Reviewer: &gitea.User{ID: 1, UserName: "reviewer"},
+		State:    state,
+	}
+}
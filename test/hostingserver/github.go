@@ -0,0 +1,436 @@
+package hostingserver
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	githubconnector "github.com/git-town/git-town/v16/internal/hosting/github"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	"github.com/google/go-github/v58/github"
+)
+
+// the body of requests to assign people to a GitHub issue or pull request
+type githubAssigneesRequest struct {
+	Assignees []string `json:"assignees"`
+}
+
+// the body of requests to edit a GitHub pull request
+type githubEditRequest struct {
+	Base  *string `json:"base,omitempty"`
+	Body  *string `json:"body,omitempty"`
+	Title *string `json:"title,omitempty"`
+}
+
+// the body of requests to merge a GitHub pull request
+type githubMergeRequest struct {
+	CommitMessage string `json:"commit_message"`
+	CommitTitle   string `json:"commit_title"`
+	MergeMethod   string `json:"merge_method"`
+}
+
+func (self *Server) githubAddAssignees(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.githubFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "Not Found")
+		return
+	}
+	var assignees githubAssigneesRequest
+	if err := readJSON(request, &assignees); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+	proposal.Assignees = append(proposal.Assignees, assignees.Assignees...)
+	writeJSON(writer, http.StatusCreated, github.Issue{
+		Number: github.Int(proposal.Number),
+	})
+}
+
+func (self *Server) githubAddLabels(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.githubFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "Not Found")
+		return
+	}
+	var labels []string
+	if err := readJSON(request, &labels); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+	proposal.Labels = append(proposal.Labels, labels...)
+	result := make([]*github.Label, len(proposal.Labels))
+	for l, label := range proposal.Labels {
+		result[l] = &github.Label{Name: github.String(label)}
+	}
+	writeJSON(writer, http.StatusOK, result)
+}
+
+func (self *Server) githubCheckRuns(writer http.ResponseWriter, _ *http.Request) {
+	// the fake server reports CI checks only through commit statuses
+	total := 0
+	writeJSON(writer, http.StatusOK, github.ListCheckRunsResults{
+		Total:     &total,
+		CheckRuns: []*github.CheckRun{},
+	})
+}
+
+func (self *Server) githubCombinedStatus(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	number, err := parseFakeSHA(request.PathValue("ref"))
+	if err != nil {
+		writeError(writer, http.StatusNotFound, "Not Found")
+		return
+	}
+	proposal, hasProposal := self.findProposal(number)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "Not Found")
+		return
+	}
+	state, total := "", 0
+	switch proposal.Checks {
+	case hostingdomain.ProposalChecksFailure:
+		state, total = "failure", 1
+	case hostingdomain.ProposalChecksPending:
+		state, total = "pending", 1
+	case hostingdomain.ProposalChecksSuccess:
+		state, total = "success", 1
+	case hostingdomain.ProposalChecksNone, hostingdomain.ProposalChecksUnknown:
+		state, total = "pending", 0
+	}
+	writeJSON(writer, http.StatusOK, github.CombinedStatus{
+		State:      &state,
+		TotalCount: &total,
+	})
+}
+
+func (self *Server) githubCreatePullRequest(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	var newPullRequest github.NewPullRequest
+	if err := readJSON(request, &newPullRequest); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+	proposal := self.addProposal(Proposal{
+		Assignees: []string{},
+		Body:      newPullRequest.GetBody(),
+		Checks:    hostingdomain.ProposalChecksNone,
+		Draft:     newPullRequest.GetDraft(),
+		Fork:      "",
+		Labels:    []string{},
+		MergedSHA: "",
+		Number:    0,
+		Review:    hostingdomain.ProposalReviewNone,
+		Reviewers: []string{},
+		Source:    gitdomain.NewLocalBranchName(newPullRequest.GetHead()),
+		State:     ProposalStateOpen,
+		Target:    gitdomain.NewLocalBranchName(newPullRequest.GetBase()),
+		Title:     newPullRequest.GetTitle(),
+	})
+	writeJSON(writer, http.StatusCreated, githubPullRequest(proposal, request))
+}
+
+func (self *Server) githubEditPullRequest(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.githubFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "Not Found")
+		return
+	}
+	var changes githubEditRequest
+	if err := readJSON(request, &changes); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+	if changes.Body != nil {
+		proposal.Body = *changes.Body
+	}
+	if changes.Title != nil {
+		proposal.Title = *changes.Title
+	}
+	if changes.Base != nil {
+		proposal.Target = gitdomain.NewLocalBranchName(*changes.Base)
+	}
+	writeJSON(writer, http.StatusOK, githubPullRequest(*proposal, request))
+}
+
+func (self *Server) githubFindProposal(request *http.Request) (*Proposal, bool) {
+	number, err := pathNumber(request, "number")
+	if err != nil {
+		return nil, false
+	}
+	return self.findProposal(number)
+}
+
+func (self *Server) githubGetPullRequest(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.githubFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "Not Found")
+		return
+	}
+	writeJSON(writer, http.StatusOK, githubPullRequest(*proposal, request))
+}
+
+func (self *Server) githubGraphQL(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	var graphQLRequest githubconnector.GraphQLRequest
+	if err := readJSON(request, &graphQLRequest); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+	switch {
+	case strings.Contains(graphQLRequest.Query, "enablePullRequestAutoMerge"):
+		self.githubEnableAutoMerge(writer, graphQLRequest.Variables)
+	case strings.Contains(graphQLRequest.Query, "reviewDecision"):
+		self.githubReviewState(writer, graphQLRequest.Variables)
+	default:
+		githubGraphQLError(writer, "the fake server supports only the enablePullRequestAutoMerge mutation and the reviewDecision query")
+	}
+}
+
+func (self *Server) githubEnableAutoMerge(writer http.ResponseWriter, variables map[string]any) {
+	nodeID := graphQLString(variables, "pullRequestId")
+	var number int
+	if _, err := fmt.Sscanf(nodeID, githubNodeIDFormat, &number); err != nil {
+		githubGraphQLError(writer, "Could not resolve to a node with the global id of "+nodeID)
+		return
+	}
+	proposal, hasProposal := self.findProposal(number)
+	if !hasProposal {
+		githubGraphQLError(writer, "Could not resolve to a node with the global id of "+nodeID)
+		return
+	}
+	message := graphQLString(variables, "commitHeadline")
+	if body := graphQLString(variables, "commitBody"); body != "" {
+		message += "\n\n" + body
+	}
+	method := MergeMethod(strings.ToLower(graphQLString(variables, "mergeMethod")))
+	// GitHub deletes merged branches only if the repository is configured to do so
+	if err := self.enableAutoMerge(proposal, method, message, false); err != nil {
+		githubGraphQLError(writer, err.Error())
+		return
+	}
+	writeJSON(writer, http.StatusOK, map[string]any{
+		"data": map[string]any{
+			"enablePullRequestAutoMerge": map[string]any{"clientMutationId": nil},
+		},
+	})
+}
+
+func (self *Server) githubReviewState(writer http.ResponseWriter, variables map[string]any) {
+	// JSON numbers arrive as float64
+	number, _ := variables["number"].(float64)
+	proposal, hasProposal := self.findProposal(int(number))
+	if !hasProposal {
+		githubGraphQLError(writer, fmt.Sprintf("Could not resolve to a PullRequest with the number of %d.", int(number)))
+		return
+	}
+	pullRequest := githubconnector.GraphQLPullRequest{
+		LatestReviews:  githubconnector.GraphQLReviews{Nodes: []githubconnector.GraphQLReview{}},
+		ReviewDecision: "",
+	}
+	switch proposal.Review {
+	case hostingdomain.ProposalReviewApproved:
+		pullRequest.ReviewDecision = "APPROVED"
+		pullRequest.LatestReviews.Nodes = append(pullRequest.LatestReviews.Nodes, githubconnector.GraphQLReview{State: "APPROVED"})
+	case hostingdomain.ProposalReviewChangesRequested:
+		pullRequest.ReviewDecision = "CHANGES_REQUESTED"
+		pullRequest.LatestReviews.Nodes = append(pullRequest.LatestReviews.Nodes, githubconnector.GraphQLReview{State: "CHANGES_REQUESTED"})
+	case hostingdomain.ProposalReviewRequired:
+		pullRequest.ReviewDecision = "REVIEW_REQUIRED"
+	case hostingdomain.ProposalReviewNone, hostingdomain.ProposalReviewUnknown:
+	}
+	writeJSON(writer, http.StatusOK, githubconnector.GraphQLResponse{
+		Data: githubconnector.GraphQLData{
+			Repository: githubconnector.GraphQLRepository{PullRequest: pullRequest},
+		},
+		Errors: nil,
+	})
+}
+
+func (self *Server) githubListPullRequests(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	query := request.URL.Query()
+	head := query.Get("head")
+	if _, branch, hasOrg := strings.Cut(head, ":"); hasOrg {
+		head = branch
+	}
+	base := query.Get("base")
+	state := query.Get("state")
+	result := []*github.PullRequest{}
+	for _, proposal := range self.proposals {
+		if head != "" && proposal.Source.String() != head {
+			continue
+		}
+		if base != "" && proposal.Target.String() != base {
+			continue
+		}
+		switch state {
+		case "", "open":
+			if proposal.State != ProposalStateOpen {
+				continue
+			}
+		case "closed":
+			if proposal.State == ProposalStateOpen {
+				continue
+			}
+		}
+		result = append(result, githubPullRequest(proposal, request))
+	}
+	writeJSON(writer, http.StatusOK, result)
+}
+
+func (self *Server) githubMergePullRequest(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.githubFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "Not Found")
+		return
+	}
+	var mergeRequest githubMergeRequest
+	if err := readJSON(request, &mergeRequest); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+	message := mergeRequest.CommitTitle
+	if mergeRequest.CommitMessage != "" {
+		message += "\n\n" + mergeRequest.CommitMessage
+	}
+	method := MergeMethod(mergeRequest.MergeMethod)
+	if method == "" {
+		method = MergeMethodMerge
+	}
+	if err := self.merge(proposal, method, message, false); err != nil {
+		writeError(writer, http.StatusMethodNotAllowed, err.Error())
+		return
+	}
+	merged := true
+	writeJSON(writer, http.StatusOK, github.PullRequestMergeResult{
+		Merged: &merged,
+	})
+}
+
+func (self *Server) githubRequestReviewers(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.githubFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "Not Found")
+		return
+	}
+	var reviewers github.ReviewersRequest
+	if err := readJSON(request, &reviewers); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+	proposal.Reviewers = append(proposal.Reviewers, reviewers.Reviewers...)
+	for _, team := range reviewers.TeamReviewers {
+		proposal.Reviewers = append(proposal.Reviewers, request.PathValue("org")+"/"+team)
+	}
+	writeJSON(writer, http.StatusCreated, githubPullRequest(*proposal, request))
+}
+
+func (self *Server) registerGitHub(mux *http.ServeMux) {
+	prefix := "/api/v3/repos/{org}/{repo}"
+	mux.HandleFunc("POST /api/graphql", self.githubGraphQL)
+	mux.HandleFunc("GET "+prefix+"/commits/{ref}/check-runs", self.githubCheckRuns)
+	mux.HandleFunc("GET "+prefix+"/commits/{ref}/status", self.githubCombinedStatus)
+	mux.HandleFunc("POST "+prefix+"/issues/{number}/assignees", self.githubAddAssignees)
+	mux.HandleFunc("POST "+prefix+"/issues/{number}/labels", self.githubAddLabels)
+	mux.HandleFunc("GET "+prefix+"/pulls", self.githubListPullRequests)
+	mux.HandleFunc("POST "+prefix+"/pulls", self.githubCreatePullRequest)
+	mux.HandleFunc("GET "+prefix+"/pulls/{number}", self.githubGetPullRequest)
+	mux.HandleFunc("PATCH "+prefix+"/pulls/{number}", self.githubEditPullRequest)
+	mux.HandleFunc("PUT "+prefix+"/pulls/{number}/merge", self.githubMergePullRequest)
+	mux.HandleFunc("POST "+prefix+"/pulls/{number}/requested_reviewers", self.githubRequestReviewers)
+}
+
+// the format of the GraphQL node IDs of the pull requests that the fake server provides
+const githubNodeIDFormat = "PR_%d"
+
+// githubGraphQLError responds with the given GraphQL error, which GitHub reports with a successful status code.
+func githubGraphQLError(writer http.ResponseWriter, message string) {
+	writeJSON(writer, http.StatusOK, githubconnector.GraphQLResponse{
+		Data:   githubconnector.GraphQLData{},
+		Errors: []githubconnector.GraphQLError{{Message: message}},
+	})
+}
+
+// graphQLString provides the GraphQL variable with the given name as a string.
+func graphQLString(variables map[string]any, name string) string {
+	value, _ := variables[name].(string)
+	return value
+}
+
+// githubPullRequest provides the GitHub API representation of the given proposal.
+func githubPullRequest(proposal Proposal, request *http.Request) *github.PullRequest {
+	state := "open"
+	var mergedAt *github.Timestamp
+	switch proposal.State {
+	case ProposalStateClosed:
+		state = "closed"
+	case ProposalStateMerged:
+		state = "closed"
+		mergedAt = &github.Timestamp{Time: time.Now()}
+	case ProposalStateOpen:
+	}
+	requestedReviewers := []*github.User{}
+	if proposal.Review == hostingdomain.ProposalReviewRequired {
+		requestedReviewers = append(requestedReviewers, githubUser())
+	}
+	url := fmt.Sprintf("https://github.com/%s/%s/pull/%d", request.PathValue("org"), request.PathValue("repo"), proposal.Number)
+	baseRepo := &github.Repository{
+		FullName: github.String(request.PathValue("org") + "/" + request.PathValue("repo")),
+	}
+	headRepo := baseRepo
+	if proposal.Fork != "" {
+		headRepo = &github.Repository{
+			CloneURL: github.String(forkCloneURL),
+			FullName: github.String(proposal.Fork + "/" + request.PathValue("repo")),
+			Owner:    &github.User{Login: github.String(proposal.Fork)},
+		}
+	}
+	return &github.PullRequest{
+		Base: &github.PullRequestBranch{
+			Ref:  github.String(proposal.Target.String()),
+			Repo: baseRepo,
+		},
+		Body:  github.String(proposal.Body),
+		Draft: github.Bool(proposal.Draft),
+		Head: &github.PullRequestBranch{
+			Ref:  github.String(proposal.Source.String()),
+			Repo: headRepo,
+			SHA:  github.String(headSHA(proposal)),
+		},
+		HTMLURL:            github.String(url),
+		Mergeable:          github.Bool(true),
+		MergeableState:     github.String("clean"),
+		MergedAt:           mergedAt,
+		NodeID:             github.String(fmt.Sprintf(githubNodeIDFormat, proposal.Number)),
+		Number:             github.Int(proposal.Number),
+		RequestedReviewers: requestedReviewers,
+		State:              github.String(state),
+		Title:              github.String(proposal.Title),
+	}
+}
+
+// githubUser provides the user that reviews all pull requests.
+func githubUser() *github.User {
+	return &github.User{
+		ID:    github.Int64(1),
+		Login: github.String("reviewer"),
+	}
+}
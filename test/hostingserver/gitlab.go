@@ -0,0 +1,260 @@
+package hostingserver
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	"github.com/xanzy/go-gitlab"
+)
+
+func (self *Server) gitlabAcceptMergeRequest(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.gitlabFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "404 Not found")
+		return
+	}
+	var options gitlab.AcceptMergeRequestOptions
+	if err := readJSON(request, &options); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+	method := MergeMethodMerge
+	message := ""
+	if options.Squash != nil && *options.Squash {
+		method = MergeMethodSquash
+		if options.SquashCommitMessage != nil {
+			message = *options.SquashCommitMessage
+		}
+	} else if options.MergeCommitMessage != nil {
+		message = *options.MergeCommitMessage
+	}
+	deleteSource := options.ShouldRemoveSourceBranch != nil && *options.ShouldRemoveSourceBranch
+	var err error
+	if options.MergeWhenPipelineSucceeds != nil && *options.MergeWhenPipelineSucceeds {
+		err = self.enableAutoMerge(proposal, method, message, deleteSource)
+	} else {
+		err = self.merge(proposal, method, message, deleteSource)
+	}
+	if err != nil {
+		writeError(writer, http.StatusMethodNotAllowed, err.Error())
+		return
+	}
+	writeJSON(writer, http.StatusOK, gitlabMergeRequest(*proposal, request))
+}
+
+func (self *Server) gitlabApprovals(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.gitlabFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "404 Not found")
+		return
+	}
+	result := gitlab.MergeRequestApprovals{
+		ApprovalsLeft: 0,
+		ApprovedBy:    []*gitlab.MergeRequestApproverUser{},
+	}
+	switch proposal.Review {
+	case hostingdomain.ProposalReviewApproved:
+		result.ApprovedBy = append(result.ApprovedBy, &gitlab.MergeRequestApproverUser{
+			User: &gitlab.BasicUser{ID: 1, Username: "reviewer"},
+		})
+	case hostingdomain.ProposalReviewRequired:
+		result.ApprovalsLeft = 1
+	case hostingdomain.ProposalReviewChangesRequested, hostingdomain.ProposalReviewNone, hostingdomain.ProposalReviewUnknown:
+	}
+	writeJSON(writer, http.StatusOK, result)
+}
+
+func (self *Server) gitlabCreateMergeRequest(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	var options gitlab.CreateMergeRequestOptions
+	if err := readJSON(request, &options); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+	labels := []string{}
+	if options.Labels != nil {
+		labels = append(labels, *options.Labels...)
+	}
+	title := valueOf(options.Title)
+	proposal := self.addProposal(Proposal{
+		Assignees: self.usernames(options.AssigneeIDs),
+		Body:      valueOf(options.Description),
+		Checks:    hostingdomain.ProposalChecksNone,
+		Draft:     strings.HasPrefix(title, "Draft:"),
+		Fork:      "",
+		Labels:    labels,
+		MergedSHA: "",
+		Number:    0,
+		Review:    hostingdomain.ProposalReviewNone,
+		Reviewers: self.usernames(options.ReviewerIDs),
+		Source:    gitdomain.NewLocalBranchName(valueOf(options.SourceBranch)),
+		State:     ProposalStateOpen,
+		Target:    gitdomain.NewLocalBranchName(valueOf(options.TargetBranch)),
+		Title:     title,
+	})
+	writeJSON(writer, http.StatusCreated, gitlabMergeRequest(proposal, request))
+}
+
+func (self *Server) gitlabFindProposal(request *http.Request) (*Proposal, bool) {
+	number, err := pathNumber(request, "iid")
+	if err != nil {
+		return nil, false
+	}
+	return self.findProposal(number)
+}
+
+func (self *Server) gitlabGetMergeRequest(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.gitlabFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "404 Not found")
+		return
+	}
+	writeJSON(writer, http.StatusOK, gitlabMergeRequest(*proposal, request))
+}
+
+func (self *Server) gitlabListMergeRequests(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	query := request.URL.Query()
+	source := query.Get("source_branch")
+	target := query.Get("target_branch")
+	state := query.Get("state")
+	result := []*gitlab.MergeRequest{}
+	for _, proposal := range self.proposals {
+		if source != "" && proposal.Source.String() != source {
+			continue
+		}
+		if target != "" && proposal.Target.String() != target {
+			continue
+		}
+		if state != "" && state != "all" && gitlabState(proposal.State) != state {
+			continue
+		}
+		result = append(result, gitlabMergeRequest(proposal, request))
+	}
+	writeJSON(writer, http.StatusOK, result)
+}
+
+func (self *Server) gitlabRebaseMergeRequest(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.gitlabFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "404 Not found")
+		return
+	}
+	// the fake server rebases synchronously, so the rebase is never in progress afterwards
+	if err := self.rebase(proposal); err != nil {
+		writeError(writer, http.StatusConflict, err.Error())
+		return
+	}
+	writeJSON(writer, http.StatusAccepted, map[string]bool{"rebase_in_progress": false})
+}
+
+func (self *Server) gitlabUpdateMergeRequest(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	proposal, hasProposal := self.gitlabFindProposal(request)
+	if !hasProposal {
+		writeError(writer, http.StatusNotFound, "404 Not found")
+		return
+	}
+	var options gitlab.UpdateMergeRequestOptions
+	if err := readJSON(request, &options); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+	if options.Description != nil {
+		proposal.Body = *options.Description
+	}
+	if options.Title != nil {
+		proposal.Title = *options.Title
+	}
+	if options.TargetBranch != nil {
+		proposal.Target = gitdomain.NewLocalBranchName(*options.TargetBranch)
+	}
+	writeJSON(writer, http.StatusOK, gitlabMergeRequest(*proposal, request))
+}
+
+func (self *Server) gitlabListUsers(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	username := request.URL.Query().Get("username")
+	writeJSON(writer, http.StatusOK, []*gitlab.User{
+		{ID: self.userID(username), Username: username},
+	})
+}
+
+func (self *Server) registerGitLab(mux *http.ServeMux) {
+	prefix := "/api/v4/projects/{project}/merge_requests"
+	mux.HandleFunc("GET "+prefix, self.gitlabListMergeRequests)
+	mux.HandleFunc("POST "+prefix, self.gitlabCreateMergeRequest)
+	mux.HandleFunc("GET "+prefix+"/{iid}", self.gitlabGetMergeRequest)
+	mux.HandleFunc("PUT "+prefix+"/{iid}", self.gitlabUpdateMergeRequest)
+	mux.HandleFunc("GET "+prefix+"/{iid}/approvals", self.gitlabApprovals)
+	mux.HandleFunc("PUT "+prefix+"/{iid}/merge", self.gitlabAcceptMergeRequest)
+	mux.HandleFunc("PUT "+prefix+"/{iid}/rebase", self.gitlabRebaseMergeRequest)
+	mux.HandleFunc("GET /api/v4/users", self.gitlabListUsers)
+}
+
+// gitlabMergeRequest provides the GitLab API representation of the given proposal.
+func gitlabMergeRequest(proposal Proposal, request *http.Request) *gitlab.MergeRequest {
+	detailedMergeStatus := "mergeable"
+	if proposal.Review == hostingdomain.ProposalReviewChangesRequested {
+		detailedMergeStatus = "requested_changes"
+	}
+	var pipeline *gitlab.Pipeline
+	switch proposal.Checks {
+	case hostingdomain.ProposalChecksFailure:
+		pipeline = &gitlab.Pipeline{Status: "failed"}
+	case hostingdomain.ProposalChecksPending:
+		pipeline = &gitlab.Pipeline{Status: "running"}
+	case hostingdomain.ProposalChecksSuccess:
+		pipeline = &gitlab.Pipeline{Status: "success"}
+	case hostingdomain.ProposalChecksNone, hostingdomain.ProposalChecksUnknown:
+	}
+	return &gitlab.MergeRequest{
+		Description:         proposal.Body,
+		DetailedMergeStatus: detailedMergeStatus,
+		Draft:               proposal.Draft,
+		HasConflicts:        false,
+		HeadPipeline:        pipeline,
+		IID:                 proposal.Number,
+		SHA:                 headSHA(proposal),
+		SourceBranch:        proposal.Source.String(),
+		State:               gitlabState(proposal.State),
+		TargetBranch:        proposal.Target.String(),
+		Title:               proposal.Title,
+		WebURL:              fmt.Sprintf("https://gitlab.com/%s/-/merge_requests/%d", request.PathValue("project"), proposal.Number),
+	}
+}
+
+// gitlabState provides the name that the GitLab API uses for the given proposal state.
+func gitlabState(state ProposalState) string {
+	switch state {
+	case ProposalStateClosed:
+		return "closed"
+	case ProposalStateMerged:
+		return "merged"
+	case ProposalStateOpen:
+	}
+	return "opened"
+}
+
+// valueOf provides the value of the given optional string.
+func valueOf(text *string) string {
+	if text == nil {
+		return ""
+	}
+	return *text
+}
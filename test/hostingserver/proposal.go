@@ -0,0 +1,90 @@
+package hostingserver
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+)
+
+// Proposal is a proposal stored in the fake hosting server.
+type Proposal struct {
+	// usernames of the people assigned to the proposal
+	Assignees []string
+
+	// the body of the proposal
+	Body string
+
+	// the state of the CI checks of the proposal
+	Checks hostingdomain.ProposalChecks
+
+	// whether the proposal is a draft
+	Draft bool
+
+	// name of the account whose fork contains the source branch,
+	// empty if the source branch is in the origin repository.
+	// The fake server simulates forks only for GitHub and Gitea.
+	Fork string
+
+	// names of the labels of the proposal
+	Labels []string
+
+	// the SHA of the source branch when the proposal got merged, empty for unmerged proposals
+	MergedSHA string
+
+	// the number under which the hosting platform knows this proposal
+	Number int
+
+	// the review state of the proposal
+	Review hostingdomain.ProposalReview
+
+	// usernames of the people from whom the proposal requests reviews
+	Reviewers []string
+
+	// the branch that the proposal merges
+	Source gitdomain.LocalBranchName
+
+	// whether the proposal is open, merged, or closed
+	State ProposalState
+
+	// the branch into which the proposal merges
+	Target gitdomain.LocalBranchName
+
+	// the title of the proposal
+	Title string
+}
+
+// the URL from which clients fetch the forks that proposals come from.
+// End-to-end tests simulate all forks through the upstream repository, which is next to the developer repository.
+const forkCloneURL = "../upstream"
+
+// ProposalState describes whether a proposal is open, merged, or closed.
+type ProposalState string
+
+const (
+	ProposalStateClosed ProposalState = "closed"
+	ProposalStateMerged ProposalState = "merged"
+	ProposalStateOpen   ProposalState = "open"
+)
+
+// headSHA provides the SHA of the head commit of the given proposal.
+// Merged proposals report the commit they merged, all others a fake SHA.
+func headSHA(proposal Proposal) string {
+	if proposal.MergedSHA != "" {
+		return proposal.MergedSHA
+	}
+	return fakeSHA(proposal.Number)
+}
+
+// fakeSHA provides the fake SHA of the head commit of the proposal with the given number.
+// The fake server uses it to find the proposal when looking up the status of a commit.
+func fakeSHA(number int) string {
+	return fmt.Sprintf("%040x", number)
+}
+
+// parseFakeSHA provides the number of the proposal whose head commit has the given fake SHA.
+func parseFakeSHA(sha string) (int, error) {
+	number, err := strconv.ParseInt(sha, 16, 64)
+	return int(number), err
+}
@@ -0,0 +1,415 @@
+package hostingserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v16/test/datatable"
+)
+
+// Server is a fake code hosting server for end-to-end tests.
+// It speaks the subset of the GitHub, GitLab, and Gitea APIs that Git Town uses,
+// stores the proposals in memory, and performs the merges of proposals in the origin repository.
+type Server struct {
+	// how to merge the proposals that get merged once their CI checks pass, by proposal number
+	autoMerges map[int]autoMerge
+
+	// the underlying HTTP server
+	httpServer *httptest.Server
+
+	// guards the proposals and the origin repository
+	mutex sync.Mutex
+
+	// runs Git commands in the origin repository
+	origin Runner
+
+	// the proposals that this server knows about
+	proposals []Proposal
+
+	// the usernames that this server knows about, the ID of each user is its position in this list plus one
+	users []string
+}
+
+// Runner runs shell commands.
+type Runner interface {
+	Query(name string, arguments ...string) (string, error)
+}
+
+// MergeMethod describes how the fake server merges a proposal.
+type MergeMethod string
+
+const (
+	MergeMethodMerge  MergeMethod = "merge"
+	MergeMethodRebase MergeMethod = "rebase"
+	MergeMethodSquash MergeMethod = "squash"
+)
+
+// Start provides a running fake hosting server that merges proposals in the origin repository
+// that the given runner operates in.
+func Start(origin Runner) *Server {
+	result := Server{
+		autoMerges: map[int]autoMerge{},
+		httpServer: nil,
+		mutex:      sync.Mutex{},
+		origin:     origin,
+		proposals:  []Proposal{},
+		users:      []string{},
+	}
+	mux := http.NewServeMux()
+	result.registerGitea(mux)
+	result.registerGitHub(mux)
+	result.registerGitLab(mux)
+	result.httpServer = httptest.NewServer(mux)
+	return &result
+}
+
+// AddProposal stores the given proposal in this server.
+// Assigns the next free number to proposals without a number.
+func (self *Server) AddProposal(proposal Proposal) Proposal {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.addProposal(proposal)
+}
+
+// PassChecks makes the CI checks of the open proposal of the given branch pass.
+// Merges the proposal if it has auto-merge enabled.
+func (self *Server) PassChecks(source gitdomain.LocalBranchName) error {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	for p := range self.proposals {
+		proposal := &self.proposals[p]
+		if proposal.Source != source || proposal.State != ProposalStateOpen {
+			continue
+		}
+		proposal.Checks = hostingdomain.ProposalChecksSuccess
+		autoMerge, hasAutoMerge := self.autoMerges[proposal.Number]
+		if !hasAutoMerge {
+			return nil
+		}
+		delete(self.autoMerges, proposal.Number)
+		return self.merge(proposal, autoMerge.method, autoMerge.message, autoMerge.deleteSource)
+	}
+	return fmt.Errorf("no open proposal for branch %q", source)
+}
+
+// Close shuts down this server.
+func (self *Server) Close() {
+	self.httpServer.Close()
+}
+
+// MergeProposal merges the open proposal of the given branch using the given method.
+// Keeps the merged branch at the origin, like hosting services do when they don't delete merged branches.
+func (self *Server) MergeProposal(source gitdomain.LocalBranchName, method MergeMethod) error {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	for p := range self.proposals {
+		if self.proposals[p].Source == source && self.proposals[p].State == ProposalStateOpen {
+			return self.merge(&self.proposals[p], method, "", false)
+		}
+	}
+	return fmt.Errorf("no open proposal for branch %q", source)
+}
+
+// ProposalTable provides a table with the given fields for all proposals in this server.
+func (self *Server) ProposalTable(fields []string) datatable.DataTable {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	result := datatable.DataTable{}
+	result.AddRow(fields...)
+	for _, proposal := range self.proposals {
+		row := make([]string, len(fields))
+		for f, field := range fields {
+			switch field {
+			case "ASSIGNEES":
+				row[f] = strings.Join(proposal.Assignees, ", ")
+			case "AUTO-MERGE":
+				row[f] = string(self.autoMerges[proposal.Number].method)
+			case "BODY":
+				row[f] = proposal.Body
+			case "CHECKS":
+				row[f] = string(proposal.Checks)
+			case "DRAFT":
+				row[f] = strconv.FormatBool(proposal.Draft)
+			case "FORK":
+				row[f] = proposal.Fork
+			case "FROM":
+				row[f] = proposal.Source.String()
+			case "LABELS":
+				row[f] = strings.Join(proposal.Labels, ", ")
+			case "NUMBER":
+				row[f] = strconv.Itoa(proposal.Number)
+			case "REVIEW":
+				row[f] = string(proposal.Review)
+			case "REVIEWERS":
+				row[f] = strings.Join(proposal.Reviewers, ", ")
+			case "STATE":
+				row[f] = string(proposal.State)
+			case "TITLE":
+				row[f] = proposal.Title
+			case "TO":
+				row[f] = proposal.Target.String()
+			default:
+				panic("unknown proposal table field: " + field)
+			}
+		}
+		result.AddRow(row...)
+	}
+	return result
+}
+
+// URL provides the base URL of the APIs that this server simulates.
+func (self *Server) URL() string {
+	return self.httpServer.URL
+}
+
+func (self *Server) addProposal(proposal Proposal) Proposal {
+	if proposal.Number == 0 {
+		proposal.Number = len(self.proposals) + 1
+	}
+	if proposal.State == "" {
+		proposal.State = ProposalStateOpen
+	}
+	self.proposals = append(self.proposals, proposal)
+	return proposal
+}
+
+// enableAutoMerge makes this server merge the given proposal using the given method once its CI checks pass.
+// Merges the proposal right away if its checks have passed already.
+func (self *Server) enableAutoMerge(proposal *Proposal, method MergeMethod, message string, deleteSource bool) error {
+	if proposal.State != ProposalStateOpen {
+		return fmt.Errorf("proposal %d is %s", proposal.Number, proposal.State)
+	}
+	if proposal.Checks == hostingdomain.ProposalChecksSuccess {
+		return self.merge(proposal, method, message, deleteSource)
+	}
+	self.autoMerges[proposal.Number] = autoMerge{
+		deleteSource: deleteSource,
+		message:      message,
+		method:       method,
+	}
+	return nil
+}
+
+// findProposal provides the proposal with the given number.
+func (self *Server) findProposal(number int) (*Proposal, bool) {
+	for p := range self.proposals {
+		if self.proposals[p].Number == number {
+			return &self.proposals[p], true
+		}
+	}
+	return nil, false
+}
+
+// git runs the given Git command in the origin repository.
+func (self *Server) git(arguments ...string) (string, error) {
+	output, err := self.origin.Query("git", arguments...)
+	return strings.TrimSpace(output), err
+}
+
+// merge merges the given proposal in the origin repository using the given method.
+// Deletes the source branch of the proposal afterwards if deleteSource is true.
+func (self *Server) merge(proposal *Proposal, method MergeMethod, message string, deleteSource bool) error {
+	if proposal.State != ProposalStateOpen {
+		return fmt.Errorf("proposal %d is %s", proposal.Number, proposal.State)
+	}
+	if message == "" {
+		message = fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
+	}
+	source := proposal.Source.String()
+	target := proposal.Target.String()
+	sourceSHA, err := self.git("rev-parse", source)
+	if err != nil {
+		return fmt.Errorf("cannot merge proposal %d: %w\n%s", proposal.Number, err, sourceSHA)
+	}
+	var commands [][]string
+	switch method {
+	case MergeMethodMerge:
+		commands = [][]string{
+			{"checkout", target},
+			{"merge", "--no-ff", "-m", message, source},
+		}
+	case MergeMethodRebase:
+		commands = [][]string{
+			{"checkout", "--detach", source},
+			{"rebase", target},
+			{"branch", "--force", target, "HEAD"},
+		}
+	case MergeMethodSquash:
+		commands = [][]string{
+			{"checkout", target},
+			{"merge", "--squash", source},
+			{"commit", "-m", message},
+		}
+	}
+	// the origin repo keeps the "initial" branch checked out so that tests can push to all other branches
+	commands = append(commands, []string{"checkout", "initial"})
+	if deleteSource {
+		commands = append(commands, []string{"branch", "-D", source})
+	}
+	for _, command := range commands {
+		if output, err := self.git(command...); err != nil {
+			return fmt.Errorf("cannot merge proposal %d: %w\n%s", proposal.Number, err, output)
+		}
+	}
+	proposal.MergedSHA = sourceSHA
+	proposal.State = ProposalStateMerged
+	return nil
+}
+
+// rebase rebases the source branch of the given proposal onto its target branch in the origin repository.
+func (self *Server) rebase(proposal *Proposal) error {
+	commands := [][]string{
+		{"checkout", proposal.Source.String()},
+		{"rebase", proposal.Target.String()},
+		{"checkout", "initial"},
+	}
+	for _, command := range commands {
+		if output, err := self.git(command...); err != nil {
+			return fmt.Errorf("cannot rebase proposal %d: %w\n%s", proposal.Number, err, output)
+		}
+	}
+	return nil
+}
+
+// userID provides the ID of the user with the given name.
+// All users exist in this server, it registers unknown users on first use.
+func (self *Server) userID(username string) int {
+	if index := slices.Index(self.users, username); index >= 0 {
+		return index + 1
+	}
+	self.users = append(self.users, username)
+	return len(self.users)
+}
+
+// username provides the name of the user with the given ID.
+func (self *Server) username(id int) string {
+	if id < 1 || id > len(self.users) {
+		return ""
+	}
+	return self.users[id-1]
+}
+
+// usernames provides the names of the users with the given IDs.
+func (self *Server) usernames(ids *[]int) []string {
+	result := []string{}
+	if ids == nil {
+		return result
+	}
+	for _, id := range *ids {
+		result = append(result, self.username(id))
+	}
+	return result
+}
+
+// autoMerge describes how the fake server merges a proposal once its CI checks pass.
+type autoMerge struct {
+	deleteSource bool
+	message      string
+	method       MergeMethod
+}
+
+// ParseProposalTable provides the proposals described by the given Gherkin table.
+func ParseProposalTable(table datatable.DataTable) []Proposal {
+	result := make([]Proposal, 0, len(table.Cells)-1)
+	headers := table.Cells[0]
+	for _, row := range table.Cells[1:] {
+		proposal := Proposal{
+			Assignees: []string{},
+			Body:      "",
+			Checks:    hostingdomain.ProposalChecksNone,
+			Draft:     false,
+			Fork:      "",
+			Labels:    []string{},
+			MergedSHA: "",
+			Number:    0,
+			Review:    hostingdomain.ProposalReviewNone,
+			Reviewers: []string{},
+			Source:    "",
+			State:     ProposalStateOpen,
+			Target:    "",
+			Title:     "",
+		}
+		for c, cell := range row {
+			switch headers[c] {
+			case "ASSIGNEES":
+				proposal.Assignees = parseNames(cell)
+			case "BODY":
+				proposal.Body = cell
+			case "CHECKS":
+				proposal.Checks = hostingdomain.ProposalChecks(cell)
+			case "DRAFT":
+				proposal.Draft = cell == "true"
+			case "FORK":
+				proposal.Fork = cell
+			case "FROM":
+				proposal.Source = gitdomain.NewLocalBranchName(cell)
+			case "LABELS":
+				proposal.Labels = parseNames(cell)
+			case "NUMBER":
+				number, err := strconv.Atoi(cell)
+				if err != nil {
+					panic(fmt.Sprintf("invalid proposal number %q", cell))
+				}
+				proposal.Number = number
+			case "REVIEW":
+				proposal.Review = hostingdomain.ProposalReview(cell)
+			case "REVIEWERS":
+				proposal.Reviewers = parseNames(cell)
+			case "STATE":
+				proposal.State = ProposalState(cell)
+			case "TITLE":
+				proposal.Title = cell
+			case "TO":
+				proposal.Target = gitdomain.NewLocalBranchName(cell)
+			default:
+				panic("unknown proposal table header: " + headers[c])
+			}
+		}
+		if proposal.Title == "" {
+			proposal.Title = proposal.Source.String()
+		}
+		result = append(result, proposal)
+	}
+	return result
+}
+
+// parseNames provides the names in the given comma-separated list.
+func parseNames(text string) []string {
+	result := []string{}
+	for _, name := range strings.Split(text, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// pathNumber provides the proposal number contained in the path of the given request.
+func pathNumber(request *http.Request, name string) (int, error) {
+	return strconv.Atoi(request.PathValue(name))
+}
+
+// readJSON parses the JSON body of the given request into the given target.
+func readJSON(request *http.Request, target any) error {
+	return json.NewDecoder(request.Body).Decode(target)
+}
+
+// writeError responds with the given status code and error message.
+func writeError(writer http.ResponseWriter, status int, message string) {
+	writeJSON(writer, status, map[string]string{"message": message})
+}
+
+// writeJSON responds with the given status code and the given value encoded as JSON.
+func writeJSON(writer http.ResponseWriter, status int, value any) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	_ = json.NewEncoder(writer).Encode(value)
+}
@@ -0,0 +1,90 @@
+package hostingserver_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v16/internal/git/gitdomain"
+	"github.com/git-town/git-town/v16/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v16/test/datatable"
+	"github.com/git-town/git-town/v16/test/hostingserver"
+	"github.com/shoenig/test/must"
+)
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ParseProposalTable", func(t *testing.T) {
+		t.Parallel()
+		t.Run("all fields given", func(t *testing.T) {
+			t.Parallel()
+			table := datatable.DataTable{}
+			table.AddRow("NUMBER", "FROM", "TO", "TITLE", "BODY", "STATE", "CHECKS", "REVIEW", "DRAFT", "LABELS", "ASSIGNEES", "REVIEWERS")
+			table.AddRow("3", "feature", "main", "title", "body", "merged", "failure", "approved", "true", "bug, docs", "alice", "bob, org/team")
+			have := hostingserver.ParseProposalTable(table)
+			want := []hostingserver.Proposal{
+				{
+					Assignees: []string{"alice"},
+					Body:      "body",
+					Checks:    hostingdomain.ProposalChecksFailure,
+					Draft:     true,
+					Fork:      "",
+					Labels:    []string{"bug", "docs"},
+					MergedSHA: "",
+					Number:    3,
+					Review:    hostingdomain.ProposalReviewApproved,
+					Reviewers: []string{"bob", "org/team"},
+					Source:    gitdomain.NewLocalBranchName("feature"),
+					State:     hostingserver.ProposalStateMerged,
+					Target:    gitdomain.NewLocalBranchName("main"),
+					Title:     "title",
+				},
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("defaults", func(t *testing.T) {
+			t.Parallel()
+			table := datatable.DataTable{}
+			table.AddRow("FROM", "TO")
+			table.AddRow("feature", "main")
+			have := hostingserver.ParseProposalTable(table)
+			want := []hostingserver.Proposal{
+				{
+					Assignees: []string{},
+					Body:      "",
+					Checks:    hostingdomain.ProposalChecksNone,
+					Draft:     false,
+					Fork:      "",
+					Labels:    []string{},
+					MergedSHA: "",
+					Number:    0,
+					Review:    hostingdomain.ProposalReviewNone,
+					Reviewers: []string{},
+					Source:    gitdomain.NewLocalBranchName("feature"),
+					State:     hostingserver.ProposalStateOpen,
+					Target:    gitdomain.NewLocalBranchName("main"),
+					Title:     "feature",
+				},
+			}
+			must.Eq(t, want, have)
+		})
+	})
+
+	t.Run("ProposalTable", func(t *testing.T) {
+		t.Parallel()
+		server := hostingserver.Start(nil)
+		defer server.Close()
+		table := datatable.DataTable{}
+		table.AddRow("FROM", "TO")
+		table.AddRow("alpha", "main")
+		table.AddRow("beta", "alpha")
+		for _, proposal := range hostingserver.ParseProposalTable(table) {
+			server.AddProposal(proposal)
+		}
+		have := server.ProposalTable([]string{"NUMBER", "FROM", "TO", "STATE"})
+		want := datatable.DataTable{}
+		want.AddRow("NUMBER", "FROM", "TO", "STATE")
+		want.AddRow("1", "alpha", "main", "open")
+		want.AddRow("2", "beta", "alpha", "open")
+		must.Eq(t, want, have)
+	})
+}
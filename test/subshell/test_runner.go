@@ -271,6 +271,11 @@ func (self *TestRunner) QueryWithCode(opts *Options, cmd string, args ...string)
 	return strings.TrimSpace(outputBuf.String()), exitCode, err
 }
 
+// QueryWithInput provides the output of the given command, which receives the given input via STDIN.
+func (self *TestRunner) QueryWithInput(input string, name string, arguments ...string) (output string, err error) {
+	return self.QueryWith(&Options{Input: Some(input)}, name, arguments...)
+}
+
 // Run runs the given command with the given arguments.
 // Overrides will be used and removed when done.
 func (self *TestRunner) Run(name string, arguments ...string) error {
@@ -278,6 +283,16 @@ func (self *TestRunner) Run(name string, arguments ...string) error {
 	return err
 }
 
+// RunInDir runs the given command with the given arguments in the given directory.
+func (self *TestRunner) RunInDir(dir string, name string, arguments ...string) error {
+	relDir, err := filepath.Rel(self.WorkingDir, dir)
+	if err != nil {
+		return err
+	}
+	_, err = self.QueryWith(&Options{Dir: relDir, IgnoreOutput: true}, name, arguments...)
+	return err
+}
+
 // SetTestOrigin adds the given environment variable to subsequent runs of commands.
 func (self *TestRunner) SetProposalOverride(content string) {
 	self.ProposalOverride = Some(content)